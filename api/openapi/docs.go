@@ -24,8 +24,13 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/captcha": {
-            "post": {
+        "/v1/admin/audit_logs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -33,19 +38,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "captcha"
+                    "admin"
                 ],
-                "summary": "生成验证码",
+                "summary": "平台审计日志",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "name": "admin_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "next_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "prev_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "target_id",
+                        "in": "query"
+                    },
                     {
                         "enum": [
-                            "image:click"
+                            "user",
+                            "tenant"
                         ],
                         "type": "string",
-                        "x-enum-varnames": [
-                            "WayImageClick"
-                        ],
-                        "name": "way",
+                        "name": "target_type",
                         "in": "query"
                     }
                 ],
@@ -61,7 +91,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.CaptchaResponse"
+                                            "$ref": "#/definitions/handler.ListAuditLogsResponse"
                                         }
                                     }
                                 }
@@ -74,6 +104,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -83,9 +119,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/captcha/with-answer": {
+        "/v1/admin/stats": {
             "get": {
-                "description": "创建新的验证码并返回答案（仅用于测试或开发环境）",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -93,22 +133,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "captcha"
-                ],
-                "summary": "生成带答案的验证码",
-                "parameters": [
-                    {
-                        "enum": [
-                            "image:click"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "WayImageClick"
-                        ],
-                        "name": "way",
-                        "in": "query"
-                    }
+                    "admin"
                 ],
+                "summary": "平台全局统计",
                 "responses": {
                     "200": {
                         "description": "请求成功",
@@ -121,17 +148,17 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.CaptchaAnswerResponse"
+                                            "$ref": "#/definitions/handler.StatsResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "参数错误",
+                    "403": {
+                        "description": "需要平台管理员权限",
                         "schema": {
-                            "$ref": "#/definitions/response.invalidParamsResponse"
+                            "$ref": "#/definitions/response.errorResponse"
                         }
                     },
                     "500": {
@@ -143,13 +170,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/audit": {
+        "/v1/admin/tenants": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "按名称或id搜索租户 可按创建者、状态、计划过滤",
                 "consumes": [
                     "application/json"
                 ],
@@ -157,21 +185,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "admin"
                 ],
-                "summary": "获取未审核的评论",
+                "summary": "搜索租户",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
-                        "required": true
+                        "name": "creator_id",
+                        "in": "query"
                     },
                     {
                         "type": "string",
                         "name": "keyword",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "next_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "free",
+                            "care",
+                            "pro"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "PlanFreeType",
+                            "PlanCareType",
+                            "PlanProType"
+                        ],
+                        "name": "plan_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "prev_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "inactive",
+                            "suspended"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "TenantActiveStatus",
+                            "TenantInactiveStatus",
+                            "TenantSuspendedStatus"
+                        ],
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -186,10 +259,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/handler.CommentNoAuditResponse"
-                                            }
+                                            "$ref": "#/definitions/handler.SearchTenantsResponse"
                                         }
                                     }
                                 }
@@ -202,69 +272,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
-                    "500": {
-                        "description": "服务器错误",
+                    "403": {
+                        "description": "需要平台管理员权限",
                         "schema": {
                             "$ref": "#/definitions/response.errorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/v1/comment/{tenant_id}/audit/{id}": {
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "comment"
-                ],
-                "summary": "评论审计",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "评论id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "请求参数",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.AuditRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "请求成功",
-                        "schema": {
-                            "$ref": "#/definitions/response.successResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "参数错误",
-                        "schema": {
-                            "$ref": "#/definitions/response.invalidParamsResponse"
-                        }
                     },
                     "500": {
                         "description": "服务器错误",
@@ -275,7 +287,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/config": {
+        "/v1/admin/tenants/{id}": {
             "get": {
                 "security": [
                     {
@@ -289,14 +301,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "admin"
                 ],
-                "summary": "获取租户级别的评论系统配置",
+                "summary": "获取租户详情",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "租户id",
-                        "name": "tenant_id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -313,7 +325,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.TenantConfigResponse"
+                                            "$ref": "#/definitions/saas_internal_admin_handler.TenantResponse"
                                         }
                                     }
                                 }
@@ -326,6 +338,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "租户不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -333,7 +357,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/admin/tenants/{id}/plan": {
             "put": {
                 "security": [
                     {
@@ -347,14 +373,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "admin"
                 ],
-                "summary": "设置租户级别的评论系统配置",
+                "summary": "调整租户计划",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "租户id",
-                        "name": "tenant_id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
@@ -364,7 +390,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.SetTenantConfigRequest"
+                            "$ref": "#/definitions/handler.ChangePlanRequest"
                         }
                     }
                 ],
@@ -381,6 +407,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "租户不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "该用户已拥有此类型的计划",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -390,7 +434,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/like/{id}": {
+        "/v1/admin/tenants/{id}/reactivate": {
             "put": {
                 "security": [
                     {
@@ -404,20 +448,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "admin"
                 ],
-                "summary": "点赞/取消点赞评论",
+                "summary": "解封租户",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "评论id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -436,6 +473,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "租户不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "租户未处于封禁状态",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -445,13 +500,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/plate": {
-            "get": {
+        "/v1/admin/tenants/{id}/suspend": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "封禁后租户创建者无法再操作该租户下的资源",
                 "consumes": [
                     "application/json"
                 ],
@@ -459,34 +515,107 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "admin"
                 ],
-                "summary": "评论板块列表",
+                "summary": "封禁租户",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "租户id",
-                        "name": "tenant_id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "keyword",
-                        "in": "query"
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuspendTenantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
                     },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "租户不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "租户已处于封禁状态",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/admin/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "按邮箱、昵称或用户id搜索平台用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "搜索用户",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "keyword",
                         "in": "query"
                     },
                     {
-                        "maximum": 20,
+                        "type": "string",
+                        "name": "next_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
                         "minimum": 5,
                         "type": "integer",
                         "name": "page_size",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "prev_cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -501,7 +630,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.PlateListResponse"
+                                            "$ref": "#/definitions/handler.SearchUsersResponse"
                                         }
                                     }
                                 }
@@ -514,6 +643,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -521,8 +656,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/admin/users/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -535,32 +672,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "admin"
                 ],
-                "summary": "新增评论板块",
+                "summary": "获取用户详情",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
+                        "description": "用户id",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "请求参数",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.CreatePlateRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "$ref": "#/definitions/response.successResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/saas_internal_admin_handler.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -569,6 +709,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "用户不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -578,13 +730,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/plate/:id": {
-            "put": {
+        "/v1/admin/users/{id}/impersonate": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "为技术支持签发目标用户的短期只读访问令牌 签发及后续每个请求均写入审计日志",
                 "consumes": [
                     "application/json"
                 ],
@@ -592,20 +745,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "admin"
                 ],
-                "summary": "修改评论板块",
+                "summary": "模拟登录用户",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "板块id",
+                        "description": "用户id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -616,7 +762,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreatePlateRequest"
+                            "$ref": "#/definitions/handler.ImpersonateRequest"
                         }
                     }
                 ],
@@ -624,7 +770,19 @@ const docTemplate = `{
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "$ref": "#/definitions/response.successResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImpersonateResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -633,6 +791,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
+                    "403": {
+                        "description": "需要平台管理员权限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "用户不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -642,13 +812,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/plate/check_name": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
+        "/v1/captcha": {
+            "post": {
                 "consumes": [
                     "application/json"
                 ],
@@ -656,23 +821,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "captcha"
                 ],
-                "summary": "检测是否有相同的板块名",
+                "summary": "生成验证码",
                 "parameters": [
                     {
+                        "enum": [
+                            "image:click"
+                        ],
                         "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
-                        "required": true
+                        "x-enum-varnames": [
+                            "WayImageClick"
+                        ],
+                        "name": "way",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "$ref": "#/definitions/response.successResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.CaptchaResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -690,13 +871,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/plate/config/{belong_key}": {
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
+        "/v1/captcha/with-answer": {
+            "get": {
+                "description": "创建新的验证码并返回答案（仅用于测试或开发环境）",
                 "consumes": [
                     "application/json"
                 ],
@@ -704,39 +881,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "captcha"
                 ],
-                "summary": "设置板块级别的评论系统配置",
+                "summary": "生成带答案的验证码",
                 "parameters": [
                     {
+                        "enum": [
+                            "image:click"
+                        ],
                         "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "板块key",
-                        "name": "belong_key",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "请求参数",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.SetPlateConfigRequest"
-                        }
+                        "x-enum-varnames": [
+                            "WayImageClick"
+                        ],
+                        "name": "way",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "$ref": "#/definitions/response.successResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.CaptchaAnswerResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -754,7 +931,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/plate/config/{id}": {
+        "/v1/comment/{tenant_id}/audit": {
             "get": {
                 "security": [
                     {
@@ -770,7 +947,7 @@ const docTemplate = `{
                 "tags": [
                     "comment"
                 ],
-                "summary": "获取板块级别的评论系统配置",
+                "summary": "获取未审核的评论",
                 "parameters": [
                     {
                         "type": "string",
@@ -781,10 +958,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "板块id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "keyword",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -799,7 +974,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.PlateConfigResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.CommentNoAuditResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -821,8 +999,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/plate/{id}": {
-            "delete": {
+        "/v1/comment/{tenant_id}/audit/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -837,7 +1015,7 @@ const docTemplate = `{
                 "tags": [
                     "comment"
                 ],
-                "summary": "删除评论板块",
+                "summary": "评论审计",
                 "parameters": [
                     {
                         "type": "string",
@@ -848,65 +1026,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "板块id",
+                        "description": "评论id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "请求成功",
-                        "schema": {
-                            "$ref": "#/definitions/response.successResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "参数错误",
-                        "schema": {
-                            "$ref": "#/definitions/response.invalidParamsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "服务器错误",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/comment/{tenant_id}/{belong_key}": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "comment"
-                ],
-                "summary": "创建/回复评论",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "板块key",
-                        "name": "belong_key",
-                        "in": "path",
-                        "required": true
                     },
                     {
                         "description": "请求参数",
@@ -914,7 +1037,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/saas_internal_comment_handler.CreateRequest"
+                            "$ref": "#/definitions/handler.AuditRequest"
                         }
                     }
                 ],
@@ -940,7 +1063,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/{belong_key}/roots": {
+        "/v1/comment/{tenant_id}/config": {
             "get": {
                 "security": [
                     {
@@ -954,9 +1077,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "tenant"
                 ],
-                "summary": "获取根级评论列表",
+                "summary": "获取租户级别的评论系统配置",
                 "parameters": [
                     {
                         "type": "string",
@@ -964,13 +1087,6 @@ const docTemplate = `{
                         "name": "tenant_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "评论板块",
-                        "name": "belong_key",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -985,10 +1101,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/handler.CommentRootResponse"
-                                            }
+                                            "$ref": "#/definitions/handler.TenantConfigResponse"
                                         }
                                     }
                                 }
@@ -1008,10 +1121,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/comment/{tenant_id}/{belong_key}/{root_id}/replies": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1024,9 +1135,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "comment"
+                    "tenant"
                 ],
-                "summary": "获取根树下的回复评论列表",
+                "summary": "设置租户级别的评论系统配置",
                 "parameters": [
                     {
                         "type": "string",
@@ -1036,40 +1147,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "评论板块",
-                        "name": "belong_key",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "根评论id",
-                        "name": "root_id",
-                        "in": "path",
-                        "required": true
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetTenantConfigRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.successResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/handler.CommentReplyResponse"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.successResponse"
                         }
                     },
                     "400": {
@@ -1087,8 +1178,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/comment/{tenant_id}/{id}": {
-            "delete": {
+        "/v1/comment/{tenant_id}/like/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1103,7 +1194,7 @@ const docTemplate = `{
                 "tags": [
                     "comment"
                 ],
-                "summary": "删除评论",
+                "summary": "点赞/取消点赞评论",
                 "parameters": [
                     {
                         "type": "string",
@@ -1142,7 +1233,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}": {
+        "/v1/comment/{tenant_id}/plate": {
             "get": {
                 "security": [
                     {
@@ -1156,9 +1247,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "img"
+                    "comment"
                 ],
-                "summary": "图片列表",
+                "summary": "评论板块列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -1167,24 +1258,15 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "name": "deleted",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
                         "name": "keyword",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "next_cursor",
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
                         "in": "query"
                     },
                     {
@@ -1193,11 +1275,6 @@ const docTemplate = `{
                         "type": "integer",
                         "name": "page_size",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "prev_cursor",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1212,7 +1289,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/saas_internal_img_handler.ListByKeysetResponse"
+                                            "$ref": "#/definitions/handler.PlateListResponse"
                                         }
                                     }
                                 }
@@ -1232,10 +1309,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/img/{tenant_id}/categories": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1248,9 +1323,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "img-category"
+                    "comment"
                 ],
-                "summary": "获取全部图库分类",
+                "summary": "新增评论板块",
                 "parameters": [
                     {
                         "type": "string",
@@ -1258,28 +1333,28 @@ const docTemplate = `{
                         "name": "tenant_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreatePlateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.successResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/handler.CategoryResponse"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
                     "500": {
@@ -1291,8 +1366,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}/category": {
-            "post": {
+        "/v1/comment/{tenant_id}/plate/:id": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1305,9 +1380,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "img-category"
+                    "comment"
                 ],
-                "summary": "创建图片分类",
+                "summary": "修改评论板块",
                 "parameters": [
                     {
                         "type": "string",
@@ -1316,13 +1391,20 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "板块id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "请求参数",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateCategoryRequest"
+                            "$ref": "#/definitions/handler.CreatePlateRequest"
                         }
                     }
                 ],
@@ -1348,8 +1430,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}/category/{id}": {
-            "put": {
+        "/v1/comment/{tenant_id}/plate/check_name": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1362,32 +1444,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "img-category"
+                    "comment"
                 ],
-                "summary": "更新图片分类",
+                "summary": "检测是否有相同的板块名",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "分类id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "租户id",
                         "name": "tenant_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "请求参数",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.UpdateCategoryRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -1410,8 +1476,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/comment/{tenant_id}/plate/config/{belong_key}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1424,28 +1492,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "img-category"
+                    "comment"
                 ],
-                "summary": "删除图片分类",
+                "summary": "设置板块级别的评论系统配置",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "分类id",
-                        "name": "id",
+                        "description": "租户id",
+                        "name": "tenant_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
-                        "in": "path",
+                        "description": "板块key",
+                        "name": "belong_key",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetPlateConfigRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除成功",
+                        "description": "请求成功",
                         "schema": {
                             "$ref": "#/definitions/response.successResponse"
                         }
@@ -1465,7 +1542,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}/r2_config": {
+        "/v1/comment/{tenant_id}/plate/config/{id}": {
             "get": {
                 "security": [
                     {
@@ -1479,9 +1556,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "comment"
                 ],
-                "summary": "获取配置图库R2配置",
+                "summary": "获取板块级别的评论系统配置",
                 "parameters": [
                     {
                         "type": "string",
@@ -1489,6 +1566,13 @@ const docTemplate = `{
                         "name": "tenant_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "板块id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1503,13 +1587,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.R2ConfigResponse"
+                                            "$ref": "#/definitions/handler.PlateConfigResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1517,8 +1607,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/comment/{tenant_id}/plate/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1531,9 +1623,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "comment"
                 ],
-                "summary": "配置图库R2配置(不包含密钥)",
+                "summary": "删除评论板块",
                 "parameters": [
                     {
                         "type": "string",
@@ -1543,13 +1635,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "请求参数",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.SetR2ConfigRequest"
-                        }
+                        "type": "string",
+                        "description": "板块id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1559,6 +1649,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.successResponse"
                         }
                     },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1568,8 +1664,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}/r2_config/secret": {
-            "get": {
+        "/v1/comment/{tenant_id}/{belong_key}": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1582,9 +1678,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "comment"
                 ],
-                "summary": "是否已配置图库R2密钥",
+                "summary": "创建/回复评论",
                 "parameters": [
                     {
                         "type": "string",
@@ -1592,56 +1688,11 @@ const docTemplate = `{
                         "name": "tenant_id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "请求成功",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.successResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/handler.IsSetR2SecretResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
-                    "500": {
-                        "description": "服务器错误",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorResponse"
-                        }
-                    }
-                }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tenant"
-                ],
-                "summary": "配置图库R2密钥",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "租户id",
-                        "name": "tenant_id",
+                        "description": "板块key",
+                        "name": "belong_key",
                         "in": "path",
                         "required": true
                     },
@@ -1651,7 +1702,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.SetR2SecretAccessKeyRequest"
+                            "$ref": "#/definitions/saas_internal_comment_handler.CreateRequest"
                         }
                     }
                 ],
@@ -1662,6 +1713,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.successResponse"
                         }
                     },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
                     "500": {
                         "description": "服务器错误",
                         "schema": {
@@ -1671,8 +1728,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}/recycle/{id}": {
-            "put": {
+        "/v1/comment/{tenant_id}/{belong_key}/roots": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1685,21 +1742,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "img"
+                    "comment"
                 ],
-                "summary": "恢复回收站图片",
+                "summary": "获取根级评论列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "图片id",
-                        "name": "id",
+                        "description": "租户id",
+                        "name": "tenant_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "图片id",
-                        "name": "id",
+                        "description": "评论板块",
+                        "name": "belong_key",
                         "in": "path",
                         "required": true
                     }
@@ -1708,7 +1765,22 @@ const docTemplate = `{
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "$ref": "#/definitions/response.successResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.CommentRootResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1724,8 +1796,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/comment/{tenant_id}/{belong_key}/{root_id}/replies": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1738,9 +1812,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "img"
+                    "comment"
                 ],
-                "summary": "移除回收站图片",
+                "summary": "获取根树下的回复评论列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -1751,8 +1825,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "图片id",
-                        "name": "id",
+                        "description": "评论板块",
+                        "name": "belong_key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "根评论id",
+                        "name": "root_id",
                         "in": "path",
                         "required": true
                     }
@@ -1761,7 +1842,22 @@ const docTemplate = `{
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "$ref": "#/definitions/response.successResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.CommentReplyResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1779,49 +1875,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}/upload": {
-            "post": {
+        "/v1/comment/{tenant_id}/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "上传单张图片（支持 jpeg/png/gif/webp/avif/bmp/svg）",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "img"
+                    "comment"
                 ],
-                "summary": "上传图片",
+                "summary": "删除评论",
                 "parameters": [
-                    {
-                        "type": "file",
-                        "description": "图片文件",
-                        "name": "object",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "自定义图片路径",
-                        "name": "path",
-                        "in": "formData"
-                    },
                     {
                         "type": "string",
-                        "description": "图片描述",
-                        "name": "description",
-                        "in": "formData"
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "分类id",
-                        "name": "category_id",
-                        "in": "formData"
+                        "description": "评论id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1846,8 +1930,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/img/{tenant_id}/{id}": {
-            "delete": {
+        "/v1/img/{tenant_id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1862,7 +1946,7 @@ const docTemplate = `{
                 "tags": [
                     "img"
                 ],
-                "summary": "删除图片",
+                "summary": "图片列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -1873,66 +1957,53 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "图片id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "created_to",
+                        "in": "query"
                     },
                     {
                         "type": "boolean",
-                        "name": "hard",
+                        "name": "deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "IncludeSubcategories 同时列出 category_id 子孙分类下的图片",
+                        "name": "include_subcategories",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "请求成功",
-                        "schema": {
-                            "$ref": "#/definitions/response.successResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "参数错误",
-                        "schema": {
-                            "$ref": "#/definitions/response.invalidParamsResponse"
-                        }
+                        "name": "keyword",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "服务器错误",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/tenant": {
-            "get": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tenant"
-                ],
-                "summary": "获取用户的租户分页",
-                "parameters": [
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "max_size",
+                        "in": "query"
+                    },
                     {
+                        "maxLength": 32,
                         "type": "string",
-                        "name": "keyword",
+                        "name": "mime",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "min_size",
                         "in": "query"
                     },
                     {
@@ -1951,6 +2022,33 @@ const docTemplate = `{
                         "type": "string",
                         "name": "prev_cursor",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at_desc",
+                            "created_at_asc",
+                            "size_desc",
+                            "size_asc"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "ImgSortCreatedAtDesc",
+                            "ImgSortCreatedAtAsc",
+                            "ImgSortSizeDesc",
+                            "ImgSortSizeAsc"
+                        ],
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "maxItems": 10,
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "tag_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1965,7 +2063,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/saas_internal_tenant_handler.ListByKeysetResponse"
+                                            "$ref": "#/definitions/saas_internal_img_handler.ListByKeysetResponse"
                                         }
                                     }
                                 }
@@ -1985,7 +2083,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/img/{tenant_id}/album": {
             "post": {
                 "security": [
                     {
@@ -1999,17 +2099,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "img-album"
                 ],
-                "summary": "创建",
+                "summary": "创建相册",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "请求参数",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/saas_internal_tenant_handler.CreateRequest"
+                            "$ref": "#/definitions/handler.CreateAlbumRequest"
                         }
                     }
                 ],
@@ -2017,7 +2124,19 @@ const docTemplate = `{
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "$ref": "#/definitions/response.successResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.AlbumResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2035,8 +2154,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tenant/check_name": {
-            "get": {
+        "/v1/img/{tenant_id}/album/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -2049,9 +2168,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "img-album"
+                ],
+                "summary": "更新相册",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "相册id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateAlbumRequest"
+                        }
+                    }
                 ],
-                "summary": "检测是否有相同的租户名",
                 "responses": {
                     "200": {
                         "description": "请求成功",
@@ -2072,15 +2216,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/tenant/upgrade/{id}": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "相册中的图片不受影响",
                 "consumes": [
                     "application/json"
                 ],
@@ -2088,30 +2231,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "img-album"
                 ],
-                "summary": "升级租户",
+                "summary": "删除相册",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "id",
+                        "description": "相册id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "请求参数",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.UpgradeRequest"
-                        }
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "请求成功",
+                        "description": "删除成功",
                         "schema": {
                             "$ref": "#/definitions/response.successResponse"
                         }
@@ -2131,13 +2272,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tenant/{id}": {
+        "/v1/img/{tenant_id}/album/{id}/imgs": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "按相册顺序分页 回收站中的图片不展示",
                 "consumes": [
                     "application/json"
                 ],
@@ -2145,16 +2287,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "img-album"
                 ],
-                "summary": "查询单条租户信息",
+                "summary": "相册图片列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租户id",
+                        "description": "相册id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "next_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "prev_cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2169,7 +2335,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.TenantResponse"
+                                            "$ref": "#/definitions/saas_internal_img_handler.ListByKeysetResponse"
                                         }
                                     }
                                 }
@@ -2190,12 +2356,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "按请求顺序追加到相册末尾 已在相册中的图片保持原位置",
                 "consumes": [
                     "application/json"
                 ],
@@ -2203,24 +2370,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "img-album"
                 ],
-                "summary": "更新",
+                "summary": "添加图片到相册",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租户id",
+                        "description": "相册id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "请求参数",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UpdateRequest"
+                            "$ref": "#/definitions/handler.AlbumImgsRequest"
                         }
                     }
                 ],
@@ -2244,10 +2418,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/tenant/{id}/plan": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -2260,35 +2432,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tenant"
+                    "img-album"
                 ],
-                "summary": "获取租户计划",
+                "summary": "从相册移除图片",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "租户id",
+                        "description": "相册id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AlbumImgsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "请求成功",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.successResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/handler.PlanResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/response.successResponse"
                         }
                     },
                     "400": {
@@ -2306,14 +2482,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user/auth": {
-            "post": {
+        "/v1/img/{tenant_id}/album/{id}/imgs/{img_id}/position": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "校验当前访问令牌是否有效",
                 "consumes": [
                     "application/json"
                 ],
@@ -2321,9 +2496,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "img-album"
+                ],
+                "summary": "调整图片在相册中的顺序",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "相册id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "图片id",
+                        "name": "img_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MoveAlbumImgRequest"
+                        }
+                    }
                 ],
-                "summary": "校验令牌",
                 "responses": {
                     "200": {
                         "description": "请求成功",
@@ -2331,18 +2538,86 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.successResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
                             "$ref": "#/definitions/response.errorResponse"
                         }
                     }
                 }
             }
         },
-        "/v1/user/auth/github": {
+        "/v1/img/{tenant_id}/albums": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-album"
+                ],
+                "summary": "获取全部相册",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.AlbumResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/bulk": {
             "post": {
-                "description": "使用 GitHub OAuth 登录，返回用户信息和令牌",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "对多张图片执行同一操作: delete 移入回收站, hard_delete 直接删除, restore 从回收站恢复, clear 移除回收站图片, move 移动到 category_id 指定的分类(为空则移出分类)；单张失败不影响其他图片，逐张返回结果",
                 "consumes": [
                     "application/json"
                 ],
@@ -2350,17 +2625,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "img"
                 ],
-                "summary": "GitHub 授权登录",
+                "summary": "批量操作图片",
                 "parameters": [
                     {
-                        "description": "GitHub 授权码",
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.GithubAuthRequest"
+                            "$ref": "#/definitions/handler.BulkRequest"
                         }
                     }
                 ],
@@ -2376,7 +2658,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.AuthResponse"
+                                            "$ref": "#/definitions/handler.BulkResponse"
                                         }
                                     }
                                 }
@@ -2398,14 +2680,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user/profile": {
+        "/v1/img/{tenant_id}/categories": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "获取当前登录用户的详细信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -2413,9 +2694,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "img-category"
+                ],
+                "summary": "获取全部图库分类",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "获取用户信息",
                 "responses": {
                     "200": {
                         "description": "请求成功",
@@ -2428,18 +2718,72 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.UserResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.CategoryResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "服务器错误",
                         "schema": {
                             "$ref": "#/definitions/response.errorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/category": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-category"
+                ],
+                "summary": "创建图片分类",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
                     },
                     "500": {
                         "description": "服务器错误",
@@ -2450,9 +2794,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user/refresh_token": {
-            "post": {
-                "description": "使用刷新令牌获取新的访问令牌",
+        "/v1/img/{tenant_id}/category/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
@@ -2460,21 +2808,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "img-category"
                 ],
-                "summary": "刷新令牌",
+                "summary": "更新图片分类",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "refresh_token刷新令牌",
-                        "name": "X-Refresh-Token",
-                        "in": "header",
+                        "description": "分类id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "请求成功",
+                        "description": "请求成功 修改前缀且分类下存在图片时返回迁移任务",
                         "schema": {
                             "allOf": [
                                 {
@@ -2484,7 +2848,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.RefreshTokenResponse"
+                                            "$ref": "#/definitions/handler.CategoryJobResponse"
                                         }
                                     }
                                 }
@@ -2494,13 +2858,7 @@ const docTemplate = `{
                     "400": {
                         "description": "参数错误",
                         "schema": {
-                            "$ref": "#/definitions/response.errorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/response.errorResponse"
+                            "$ref": "#/definitions/response.invalidParamsResponse"
                         }
                     },
                     "500": {
@@ -2510,385 +2868,5753 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "domain.PlanBillingCycle": {
-            "type": "string",
-            "enum": [
-                "active",
-                "yearly",
-                "lifetime"
-            ],
-            "x-enum-varnames": [
-                "PlanMonthlyBillingCycle",
-                "PlanYearlyBillingCycle",
-                "PlanLifetimeBillingCycle"
-            ]
-        },
-        "domain.PlanStatus": {
-            "type": "string",
-            "enum": [
-                "active",
-                "inactive"
-            ],
-            "x-enum-varnames": [
-                "PlanActiveStatus",
-                "PlanInactiveStatus"
-            ]
-        },
-        "domain.PlanType": {
-            "type": "string",
-            "enum": [
-                "free",
-                "care",
-                "pro"
-            ],
-            "x-enum-varnames": [
-                "PlanFreeType",
-                "PlanCareType",
-                "PlanProType"
-            ]
-        },
-        "domain.VerifyWay": {
-            "type": "string",
-            "enum": [
-                "image:click"
-            ],
-            "x-enum-varnames": [
-                "WayImageClick"
-            ]
-        },
-        "handler.AuditAction": {
-            "type": "string",
-            "enum": [
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-category"
+                ],
+                "summary": "删除图片分类",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "分类id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "将图片移动到 move_to 分类后删除 默认要求分类下无图片",
+                        "name": "move_images",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "目标分类id 为空表示移出分类",
+                        "name": "move_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除成功 移动图片时返回迁移任务",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.CategoryJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/category/{id}/parent": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "连同子分类一起移动 分类的存储前缀不变 图片无需迁移",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-category"
+                ],
+                "summary": "移动图片分类",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "分类id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MoveCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/category_job/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-category"
+                ],
+                "summary": "获取分类迁移任务进度",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "任务id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.CategoryJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/category_job/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "从剩余未迁移的图片继续执行",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-category"
+                ],
+                "summary": "重试失败的分类迁移任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "任务id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.CategoryJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/category_jobs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-category"
+                ],
+                "summary": "获取最近的分类迁移任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.CategoryJobResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/export": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "异步将筛选的图片打包为 ZIP，图库图片位于 images/ 目录，回收站图片位于 recycle/ 目录，元数据清单为 manifest.json；每次最多导出 10000 张，压缩包保留 24 小时，完成后通过任务详情获取下载链接",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-export"
+                ],
+                "summary": "导出图片为 ZIP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateExportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ExportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/export/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "导出完成且未过期时返回预签名下载链接，链接有效期最长 1 小时，过期后重新获取即可",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-export"
+                ],
+                "summary": "获取导出任务进度",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "任务id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ExportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/exports": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-export"
+                ],
+                "summary": "获取最近的导出任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.ExportJobResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/import/urls": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "异步逐个下载并按普通上传流程导入到指定分类，仅允许 http/https 公网地址，单个文件最大 50MB，每个任务最多 500 个地址，重复地址只导入一次；图片路径取自文件名，与已有路径重复时追加随机后缀",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-import"
+                ],
+                "summary": "从远程地址导入图片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateURLImportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/import/zip": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "上传 ZIP 压缩包（最大 500MB）后异步解压导入，目录、隐藏文件与 __MACOSX 被忽略，每个压缩包最多 500 个文件，单个文件最大 50MB",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-import"
+                ],
+                "summary": "从压缩包导入图片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "ZIP 压缩包",
+                        "name": "archive",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "分类id",
+                        "name": "category_id",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/import/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-import"
+                ],
+                "summary": "获取导入任务进度与逐个文件的结果",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "任务id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/import/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "仅重新导入失败的文件；压缩包导入失败后压缩包保留 7 天，过期后无法重试",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-import"
+                ],
+                "summary": "重试失败的导入任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "任务id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/imports": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-import"
+                ],
+                "summary": "获取最近的导入任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.ImportJobResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/reconcile": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "找出桶中无记录引用的孤立对象与对象缺失的图片记录 最近一小时内变更的对象与记录不参与比对；apply 为 false 时仅报告，为 true 时删除孤立对象，缺失对象在另一个桶中存在时复制回来，否则删除原图缺失的记录或缺失的缩放版本记录",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "比对存储桶与图片记录",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ReconcileObjectsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ReconcileReportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/recycle/failed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "到期后多次重试仍未能彻底删除的图片 可调用移除回收站图片接口手动重试",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "回收站删除失败列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.DeleteDeadLetterResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/recycle/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "恢复回收站图片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "图片id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "图片id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "移除回收站图片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "图片id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/setting": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "获取图片处理配置",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImgSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "output_format 为 original 时保持原格式；quality 仅对 JPEG 生效，WebP 使用无损编码，转换后体积大于原图时保留原图；variant_widths 为上传时生成的缩放宽度，不会放大小于该宽度的图片；dedup_mode 为上传内容重复时的处理方式：off 不去重，reuse 返回已有图片，link 以新路径共享已有图片的存储对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "配置图片处理",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetImgSettingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/storage_config": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "获取图库对象存储配置",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.StorageConfigResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "provider 为 r2 时需 account_id；为 s3 时需 region，endpoint 为空则使用 AWS 默认端点；为 local 时无需凭证与公共访问前缀",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "配置图库对象存储(不包含密钥)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetStorageConfigRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/storage_config/secret": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "是否已配置图库对象存储密钥",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.IsSetStorageSecretResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "配置图库对象存储密钥",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetStorageSecretAccessKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/storage_config/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "写入探测对象检查 public_bucket 与 delete_bucket 的写入、读取、删除权限，并通过 public_url_prefix 访问公共桶中的探测对象；不填写 provider 时测试已保存的配置，未填写密钥时沿用已保存的密钥；连接与权限问题记录在报告中，不作为请求错误返回",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "测试图库对象存储连接",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "待测试的配置",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TestStorageConfigRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.StorageDiagnosticResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/storage_migration": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "异步将当前存储中的全部对象复制到目标存储，逐个校验大小与内容哈希后切换存储配置；切换前失败会删除已复制的对象并保持当前配置，原存储中的对象始终保留；迁移期间无法修改存储配置，删除、恢复、移动图片与修复对象会被拒绝，到期的回收站图片延后删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "迁移图库对象存储",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateStorageMigrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.StorageMigrationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/storage_migration/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "获取存储迁移任务进度",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "任务id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.StorageMigrationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/storage_migrations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "获取最近的存储迁移任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.StorageMigrationResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/tag": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-tag"
+                ],
+                "summary": "创建图片标签",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.TagResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/tag/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "同时解除该标签与图片的关联",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-tag"
+                ],
+                "summary": "删除图片标签",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "标签id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-tag"
+                ],
+                "summary": "获取全部图片标签",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.TagResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/transform_url": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "返回带签名的变换链接，匿名用户只能访问已签名的参数组合；w/h 最大 4096，不会放大原图；fit 默认 contain；format 为空时保持原格式；WebP 使用无损编码，format 为 webp 时不可指定 q",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "生成图片变换链接",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateTransformURLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.TransformURLResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "上传单张图片（支持 jpeg/png/gif/webp/avif/bmp/svg），按租户图片处理配置转码，svg 会移除脚本、事件属性与外部链接，动图与透明通道会被保留，路径扩展名与实际格式一致；同时按配置宽度生成缩放版本，保存在原图旁的 {path}@{width}w 路径下；内容与已有图片重复时按 dedup_mode 返回已有图片或共享其存储对象，响应中 deduplicated 为 true",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "上传图片",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "图片文件",
+                        "name": "object",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "自定义图片路径",
+                        "name": "path",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "图片描述",
+                        "name": "description",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分类id",
+                        "name": "category_id",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImgResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/upload_slot": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "校验路径、分类、大小与格式后返回预签名 PUT 链接，客户端直接上传到对象存储后调用确认接口入库；直传图片不经过服务端转码，也不生成缩放版本；svg 须经上传接口清理脚本，不支持直传；分类需要加水印时不允许直传，确认时水印配置已生效的凭证会被作废",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "申请直传凭证",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateUploadSlotRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.UploadSlotResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/upload_slot/{id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "校验对象已上传、大小与文件头一致后入库；分类已需要加水印时删除暂存对象并拒绝入库",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "确认直传",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "直传凭证id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ImgResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "凭证不存在或已过期",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/usage": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "按桶汇总的字节数与对象数 以及按分类的明细 分类id为空表示未分类 不含变换缓存与直传暂存对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "获取存储用量",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.StorageUsageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/usage/reconcile": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列举公共桶与回收站桶中的实际对象并更新用量记录 校准期间的用量变化会保留 对象较多时耗时较长",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "校准存储用量",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.StorageUsageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/watermark": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "获取水印配置",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.WatermarkSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "启用后对服务端处理的上传（含导入）加水印，生效分类内的 SVG、AVIF 与动图上传会被拒绝，也不允许申请直传，已上传的图片不受影响；kind 为 text 时使用 text 与 text_color（#RRGGBB，默认 #FFFFFF），为 image 时须先上传水印图片；scale 为水印宽度占图片宽度的百分比；category_ids 为空时对全部图片生效，否则仅对这些分类及其子分类生效；keep_original 为 true 时在回收站桶中保留未加水印的图片，图片彻底删除时一并删除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "配置水印",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetWatermarkSettingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/watermark/image": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "支持 jpeg/png/gif/webp/bmp，最大 1MB，建议使用带透明通道的 PNG；替换后旧图片被删除",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "上传水印图片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "水印图片",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.WatermarkSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "删除图片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "图片id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "hard",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img/{tenant_id}/{id}/tags": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "覆盖图片的全部标签 传空数组清除标签",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img-tag"
+                ],
+                "summary": "设置图片标签",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "图片id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetImgTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "图片或标签不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img_local/{tenant_id}/{bucket}/{key}": {
+            "get": {
+                "description": "仅 provider 为 local 的租户可用；公共桶可直接访问，其余桶需携带预签名参数",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "读取本地存储中的图片",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "桶",
+                        "name": "bucket",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象路径",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "图片内容",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "签名无效或租户已被封禁",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "对象不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "仅 provider 为 local 的租户可用；需携带申请直传凭证时返回的签名，Content-Type 与 Content-Length 须与申请一致",
+                "consumes": [
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "本地存储直传",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "桶",
+                        "name": "bucket",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象路径",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "签名无效或租户已被封禁",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/img_transform/{tenant_id}/{path}": {
+            "get": {
+                "description": "按 URL 参数缩放、裁剪与转换格式，结果会被缓存；链接需由 transform_url 接口签名",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "img"
+                ],
+                "summary": "图片变换",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "tenant_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "图片路径",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "图片内容",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "签名无效或租户已被封禁",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "图片不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tenant": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "获取用户的租户分页",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "next_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 20,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "prev_cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/saas_internal_tenant_handler.ListByKeysetResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "创建",
+                "parameters": [
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/saas_internal_tenant_handler.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tenant/check_name": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "检测是否有相同的租户名",
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tenant/upgrade/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "升级租户",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpgradeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tenant/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "查询单条租户信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/saas_internal_tenant_handler.TenantResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "更新",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "请求参数",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tenant/{id}/plan": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenant"
+                ],
+                "summary": "获取租户计划",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "租户id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.PlanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/auth": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "校验当前访问令牌是否有效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "校验令牌",
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/auth/github": {
+            "post": {
+                "description": "使用 GitHub OAuth 登录，返回用户信息和令牌",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "GitHub 授权登录",
+                "parameters": [
+                    {
+                        "description": "GitHub 授权码",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.GithubAuthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.AuthResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/identities": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "获取当前用户已绑定的第三方登录身份列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取已绑定的登录方式",
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.IdentityResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/identities/github": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "为当前用户绑定 GitHub 登录身份",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "绑定 GitHub",
+                "parameters": [
+                    {
+                        "description": "GitHub 授权码",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.LinkGithubRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.IdentityResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "该身份已被绑定",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/identities/{provider}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "解绑当前用户的第三方登录身份,至少需保留一种登录方式",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "解绑登录方式",
+                "parameters": [
+                    {
+                        "enum": [
+                            "github"
+                        ],
+                        "type": "string",
+                        "description": "第三方提供方",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "未绑定该登录方式",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "不能解绑最后一种登录方式",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/login_events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "分页获取当前用户的登录记录 包含时间、方式、IP、设备及是否成功",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取登录记录",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "next_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 5,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "prev_cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.ListLoginEventsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "获取当前登录用户的详细信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取用户信息",
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/saas_internal_user_handler.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/refresh_token": {
+            "post": {
+                "description": "使用刷新令牌获取新的访问令牌",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "刷新令牌",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "refresh_token刷新令牌",
+                        "name": "X-Refresh-Token",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.RefreshTokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/tokens": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "获取当前用户的个人访问令牌 不包含明文令牌",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取个人访问令牌列表",
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.PATResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "不支持访问令牌调用",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "创建用于 CLI/CI 的个人访问令牌 明文令牌仅在此接口返回一次 使用方式: Authorization: Bearer saas_pat_xxx",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "创建个人访问令牌",
+                "parameters": [
+                    {
+                        "description": "令牌信息",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreatePATRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.successResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.CreatePATResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "不支持访问令牌调用",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "令牌数量已达上限",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/tokens/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "吊销后该令牌立即失效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "吊销个人访问令牌",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "令牌ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "请求成功",
+                        "schema": {
+                            "$ref": "#/definitions/response.successResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.invalidParamsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "不支持访问令牌调用",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "令牌不存在",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器错误",
+                        "schema": {
+                            "$ref": "#/definitions/response.errorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.BillingCycle": {
+            "type": "string",
+            "enum": [
+                "monthly",
+                "yearly",
+                "lifetime"
+            ],
+            "x-enum-varnames": [
+                "MonthlyBillingCycle",
+                "YearlyBillingCycle",
+                "LifetimeBillingCycle"
+            ]
+        },
+        "domain.BulkAction": {
+            "type": "string",
+            "enum": [
+                "delete",
+                "hard_delete",
+                "restore",
+                "clear",
+                "move"
+            ],
+            "x-enum-varnames": [
+                "BulkActionDelete",
+                "BulkActionHardDelete",
+                "BulkActionRestore",
+                "BulkActionClear",
+                "BulkActionMove"
+            ]
+        },
+        "domain.CategoryJobKind": {
+            "type": "string",
+            "enum": [
+                "rename",
+                "delete_move"
+            ],
+            "x-enum-varnames": [
+                "CategoryJobKindRename",
+                "CategoryJobKindDeleteMove"
+            ]
+        },
+        "domain.DedupMode": {
+            "type": "string",
+            "enum": [
+                "off",
+                "reuse",
+                "link",
+                "reuse"
+            ],
+            "x-enum-varnames": [
+                "DedupModeOff",
+                "DedupModeReuse",
+                "DedupModeLink",
+                "DefaultDedupMode"
+            ]
+        },
+        "domain.ImageFormat": {
+            "type": "string",
+            "enum": [
+                "",
+                "jpeg",
+                "png",
+                "gif",
+                "webp",
+                "avif",
+                "bmp",
+                "svg"
+            ],
+            "x-enum-varnames": [
+                "ImageFormatUnknown",
+                "ImageFormatJPEG",
+                "ImageFormatPNG",
+                "ImageFormatGIF",
+                "ImageFormatWebP",
+                "ImageFormatAVIF",
+                "ImageFormatBMP",
+                "ImageFormatSVG"
+            ]
+        },
+        "domain.ImgSort": {
+            "type": "string",
+            "enum": [
+                "created_at_desc",
+                "created_at_asc",
+                "size_desc",
+                "size_asc"
+            ],
+            "x-enum-varnames": [
+                "ImgSortCreatedAtDesc",
+                "ImgSortCreatedAtAsc",
+                "ImgSortSizeDesc",
+                "ImgSortSizeAsc"
+            ]
+        },
+        "domain.ImportSource": {
+            "type": "string",
+            "enum": [
+                "urls",
+                "zip"
+            ],
+            "x-enum-varnames": [
+                "ImportSourceURLs",
+                "ImportSourceZip"
+            ]
+        },
+        "domain.JobStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "JobStatusPending",
+                "JobStatusRunning",
+                "JobStatusSucceeded",
+                "JobStatusFailed"
+            ]
+        },
+        "domain.OutputFormat": {
+            "type": "string",
+            "enum": [
+                "original",
+                "webp",
+                "jpeg",
+                "original"
+            ],
+            "x-enum-varnames": [
+                "OutputFormatOriginal",
+                "OutputFormatWebP",
+                "OutputFormatJPEG",
+                "DefaultOutputFormat"
+            ]
+        },
+        "domain.PlanBillingCycle": {
+            "type": "string",
+            "enum": [
+                "active",
+                "yearly",
+                "lifetime"
+            ],
+            "x-enum-varnames": [
+                "PlanMonthlyBillingCycle",
+                "PlanYearlyBillingCycle",
+                "PlanLifetimeBillingCycle"
+            ]
+        },
+        "domain.PlanStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "inactive"
+            ],
+            "x-enum-varnames": [
+                "PlanActiveStatus",
+                "PlanInactiveStatus"
+            ]
+        },
+        "domain.StorageBucketKind": {
+            "type": "string",
+            "enum": [
+                "public",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "StorageBucketPublic",
+                "StorageBucketDelete"
+            ]
+        },
+        "domain.StorageCheckName": {
+            "type": "string",
+            "enum": [
+                "client",
+                "write",
+                "read",
+                "delete",
+                "public_url"
+            ],
+            "x-enum-varnames": [
+                "StorageCheckClient",
+                "StorageCheckWrite",
+                "StorageCheckRead",
+                "StorageCheckDelete",
+                "StorageCheckPublicURL"
+            ]
+        },
+        "domain.StorageCheckStatus": {
+            "type": "string",
+            "enum": [
+                "passed",
+                "failed",
+                "skipped"
+            ],
+            "x-enum-varnames": [
+                "StorageCheckPassed",
+                "StorageCheckFailed",
+                "StorageCheckSkipped"
+            ]
+        },
+        "domain.StorageProvider": {
+            "type": "string",
+            "enum": [
+                "r2",
+                "s3",
+                "local"
+            ],
+            "x-enum-varnames": [
+                "StorageProviderR2",
+                "StorageProviderS3",
+                "StorageProviderLocal"
+            ]
+        },
+        "domain.TenantStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "inactive",
+                "suspended"
+            ],
+            "x-enum-varnames": [
+                "TenantActiveStatus",
+                "TenantInactiveStatus",
+                "TenantSuspendedStatus"
+            ]
+        },
+        "domain.TransformFit": {
+            "type": "string",
+            "enum": [
+                "contain",
+                "cover",
+                "fill"
+            ],
+            "x-enum-varnames": [
+                "TransformFitContain",
+                "TransformFitCover",
+                "TransformFitFill"
+            ]
+        },
+        "domain.VerifyWay": {
+            "type": "string",
+            "enum": [
+                "image:click"
+            ],
+            "x-enum-varnames": [
+                "WayImageClick"
+            ]
+        },
+        "domain.WatermarkKind": {
+            "type": "string",
+            "enum": [
+                "text",
+                "image"
+            ],
+            "x-enum-varnames": [
+                "WatermarkKindText",
+                "WatermarkKindImage"
+            ]
+        },
+        "domain.WatermarkPosition": {
+            "type": "string",
+            "enum": [
+                "top_left",
+                "top_right",
+                "bottom_left",
+                "bottom_right",
+                "center"
+            ],
+            "x-enum-varnames": [
+                "WatermarkTopLeft",
+                "WatermarkTopRight",
+                "WatermarkBottomLeft",
+                "WatermarkBottomRight",
+                "WatermarkCenter"
+            ]
+        },
+        "handler.AlbumImgsRequest": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.AlbumResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.AuditAction": {
+            "type": "string",
+            "enum": [
                 "accept",
                 "reject"
             ],
-            "x-enum-varnames": [
-                "auditAccept",
-                "auditReject"
-            ]
+            "x-enum-varnames": [
+                "auditAccept",
+                "auditReject"
+            ]
+        },
+        "handler.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "admin_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "detail": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AuditRequest": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/handler.AuditAction"
+                }
+            }
+        },
+        "handler.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/saas_internal_user_handler.UserResponse"
+                }
+            }
+        },
+        "handler.BulkItemResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "ok": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.BulkRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "ids"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "delete",
+                        "hard_delete",
+                        "restore",
+                        "clear",
+                        "move"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BulkAction"
+                        }
+                    ]
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.BulkResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BulkItemResponse"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CaptchaAnswerResponse": {
+            "type": "object",
+            "properties": {
+                "audio": {
+                    "description": "音频验证码",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "image": {
+                    "description": "主图片",
+                    "type": "string"
+                },
+                "thumb": {
+                    "description": "缩略图",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "way": {
+                    "$ref": "#/definitions/domain.VerifyWay"
+                }
+            }
+        },
+        "handler.CaptchaResponse": {
+            "type": "object",
+            "properties": {
+                "audio": {
+                    "description": "音频验证码",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "image": {
+                    "description": "主图片",
+                    "type": "string"
+                },
+                "thumb": {
+                    "description": "缩略图",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CategoryJobResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/domain.CategoryJobKind"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "processed": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.JobStatus"
+                },
+                "target_category_id": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "depth": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CategoryStorageUsageResponse": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "$ref": "#/definitions/domain.StorageBucketKind"
+                },
+                "bytes": {
+                    "type": "integer"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "objects": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ChangePlanRequest": {
+            "type": "object",
+            "required": [
+                "billing_cycle",
+                "plan_type"
+            ],
+            "properties": {
+                "billing_cycle": {
+                    "enum": [
+                        "monthly",
+                        "yearly",
+                        "lifetime"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BillingCycle"
+                        }
+                    ]
+                },
+                "end_at": {
+                    "description": "unix秒 不传表示不设结束时间",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "plan_type": {
+                    "enum": [
+                        "free",
+                        "care",
+                        "pro"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/saas_internal_admin_domain.PlanType"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.CommentNoAuditResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/handler.UserInfo"
+                }
+            }
+        },
+        "handler.CommentReplyResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_liked": {
+                    "type": "boolean"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "root_id": {
+                    "type": "string"
+                },
+                "to_user": {
+                    "$ref": "#/definitions/handler.UserInfo"
+                },
+                "user": {
+                    "$ref": "#/definitions/handler.UserInfo"
+                }
+            }
+        },
+        "handler.CommentRootResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_liked": {
+                    "type": "boolean"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "replies_count": {
+                    "type": "integer"
+                },
+                "user": {
+                    "$ref": "#/definitions/handler.UserInfo"
+                }
+            }
+        },
+        "handler.CreateAlbumRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "handler.CreateCategoryRequest": {
+            "type": "object",
+            "required": [
+                "prefix",
+                "title"
+            ],
+            "properties": {
+                "parent_id": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "handler.CreateExportRequest": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "include_deleted": {
+                    "type": "boolean"
+                },
+                "include_subcategories": {
+                    "type": "boolean"
+                },
+                "tag_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.CreatePATRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_in_days": {
+                    "description": "不传表示永不过期",
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.CreatePATResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "token": {
+                    "description": "明文令牌 仅在创建时返回一次",
+                    "type": "string"
+                },
+                "token_prefix": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreatePlateRequest": {
+            "type": "object",
+            "required": [
+                "belong_key",
+                "related_url",
+                "summary"
+            ],
+            "properties": {
+                "belong_key": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "related_url": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "summary": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "handler.CreateStorageMigrationRequest": {
+            "type": "object",
+            "required": [
+                "delete_bucket",
+                "provider",
+                "public_bucket"
+            ],
+            "properties": {
+                "access_key_id": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "account_id": {
+                    "type": "string"
+                },
+                "delete_bucket": {
+                    "type": "string",
+                    "maxLength": 63
+                },
+                "endpoint": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "provider": {
+                    "enum": [
+                        "r2",
+                        "s3",
+                        "local"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.StorageProvider"
+                        }
+                    ]
+                },
+                "public_bucket": {
+                    "type": "string",
+                    "maxLength": 63
+                },
+                "public_url_prefix": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "secret_access_key": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "use_path_style": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.CreateTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "handler.CreateTransformURLRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "fit": {
+                    "enum": [
+                        "contain",
+                        "cover",
+                        "fill"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.TransformFit"
+                        }
+                    ]
+                },
+                "format": {
+                    "enum": [
+                        "jpeg",
+                        "png",
+                        "webp"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ImageFormat"
+                        }
+                    ]
+                },
+                "h": {
+                    "type": "integer",
+                    "maximum": 4096,
+                    "minimum": 0
+                },
+                "id": {
+                    "type": "string"
+                },
+                "q": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "w": {
+                    "type": "integer",
+                    "maximum": 4096,
+                    "minimum": 0
+                }
+            }
+        },
+        "handler.CreateURLImportRequest": {
+            "type": "object",
+            "required": [
+                "urls"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "urls": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.CreateUploadSlotRequest": {
+            "type": "object",
+            "required": [
+                "content_type",
+                "size"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "content_type": {
+                    "type": "string",
+                    "enum": [
+                        "image/jpeg",
+                        "image/jpg",
+                        "image/png",
+                        "image/gif",
+                        "image/webp",
+                        "image/avif",
+                        "image/bmp"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 120
+                },
+                "filename": {
+                    "type": "string",
+                    "maxLength": 1024
+                },
+                "path": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "size": {
+                    "type": "integer",
+                    "maximum": 52428800,
+                    "minimum": 1
+                }
+            }
+        },
+        "handler.DanglingImgResponse": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "$ref": "#/definitions/domain.StorageBucketKind"
+                },
+                "found_in_other": {
+                    "type": "boolean"
+                },
+                "img_id": {
+                    "type": "string"
+                },
+                "missing_original": {
+                    "type": "boolean"
+                },
+                "missing_paths": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.DeleteDeadLetterResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "failed_at": {
+                    "type": "integer"
+                },
+                "img_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ExportJobResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "download_url": {
+                    "type": "string"
+                },
+                "expired": {
+                    "description": "Expired 压缩包已过期删除 需重新导出",
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "integer"
+                },
+                "exported": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "include_deleted": {
+                    "type": "boolean"
+                },
+                "include_subcategories": {
+                    "type": "boolean"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "missing": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.JobStatus"
+                },
+                "tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.GithubAuthRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
         },
-        "handler.AuditRequest": {
+        "handler.IdentityResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_verified": {
+                    "type": "boolean"
+                },
+                "last_used_at": {
+                    "type": "integer"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "provider_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ImgResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "deduplicated": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string"
+                },
+                "original_filename": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TagResponse"
+                    }
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ImgVariantResponse"
+                    }
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ImgSettingResponse": {
+            "type": "object",
+            "properties": {
+                "dedup_mode": {
+                    "$ref": "#/definitions/domain.DedupMode"
+                },
+                "output_format": {
+                    "$ref": "#/definitions/domain.OutputFormat"
+                },
+                "quality": {
+                    "type": "integer"
+                },
+                "variant_widths": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handler.ImgVariantResponse": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ImpersonateRequest": {
             "type": "object",
             "required": [
-                "action"
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "handler.ImpersonateResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "integer"
+                },
+                "read_only": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.ImportItemResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "error_code": {
+                    "type": "integer"
+                },
+                "img_id": {
+                    "type": "string"
+                },
+                "seq": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.JobStatus"
+                }
+            }
+        },
+        "handler.ImportJobResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ImportItemResponse"
+                    }
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/domain.ImportSource"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.JobStatus"
+                },
+                "succeeded": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.IsSetStorageSecretResponse": {
+            "type": "object",
+            "properties": {
+                "is_set": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.LinkGithubRequest": {
+            "type": "object",
+            "required": [
+                "code"
             ],
             "properties": {
-                "action": {
-                    "$ref": "#/definitions/handler.AuditAction"
+                "code": {
+                    "type": "string"
                 }
             }
         },
-        "handler.AuthResponse": {
+        "handler.ListAuditLogsResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AuditLogResponse"
+                    }
+                },
+                "next_cursor": {
                     "type": "string"
                 },
-                "refresh_token": {
+                "prev_cursor": {
                     "type": "string"
+                }
+            }
+        },
+        "handler.ListLoginEventsResponse": {
+            "type": "object",
+            "properties": {
+                "has_next": {
+                    "type": "boolean"
                 },
-                "user": {
-                    "$ref": "#/definitions/handler.UserResponse"
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LoginEventResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "prev_cursor": {
+                    "type": "string"
                 }
             }
         },
-        "handler.CaptchaAnswerResponse": {
+        "handler.LoginEventResponse": {
             "type": "object",
             "properties": {
-                "audio": {
-                    "description": "音频验证码",
+                "created_at": {
+                    "type": "integer"
+                },
+                "failure_reason": {
                     "type": "string"
                 },
                 "id": {
-                    "type": "string",
-                    "example": "0"
-                },
-                "image": {
-                    "description": "主图片",
                     "type": "string"
                 },
-                "thumb": {
-                    "description": "缩略图",
+                "ip": {
                     "type": "string"
                 },
-                "value": {
+                "method": {
                     "type": "string"
                 },
-                "way": {
-                    "$ref": "#/definitions/domain.VerifyWay"
+                "success": {
+                    "type": "boolean"
+                },
+                "user_agent": {
+                    "type": "string"
                 }
             }
         },
-        "handler.CaptchaResponse": {
+        "handler.MoveAlbumImgRequest": {
             "type": "object",
             "properties": {
-                "audio": {
-                    "description": "音频验证码",
+                "before_id": {
+                    "description": "BeforeID 移动到该图片之前 为空表示移动到末尾",
                     "type": "string"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "0"
-                },
-                "image": {
-                    "description": "主图片",
+                }
+            }
+        },
+        "handler.MoveCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "parent_id": {
+                    "description": "ParentID 为空表示移动为顶级分类",
                     "type": "string"
+                }
+            }
+        },
+        "handler.OrphanObjectResponse": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "$ref": "#/definitions/domain.StorageBucketKind"
                 },
-                "thumb": {
-                    "description": "缩略图",
+                "key": {
                     "type": "string"
+                },
+                "last_modified": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.CategoryResponse": {
+        "handler.PATResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "integer"
                 },
+                "expires_at": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
-                "prefix": {
+                "last_used_at": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "title": {
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "token_prefix": {
                     "type": "string"
                 }
             }
         },
-        "handler.CommentNoAuditResponse": {
+        "handler.PlanResponse": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string"
+                "billing_cycle": {
+                    "$ref": "#/definitions/domain.PlanBillingCycle"
                 },
-                "created_at": {
+                "can_upgrade": {
+                    "type": "boolean"
+                },
+                "end_time": {
                     "type": "integer"
                 },
-                "id": {
+                "plan_type": {
+                    "$ref": "#/definitions/saas_internal_tenant_domain.PlanType"
+                },
+                "start_time": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.PlanStatus"
+                },
+                "tenant_id": {
                     "type": "string"
+                }
+            }
+        },
+        "handler.PlateConfigResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
                 },
-                "user": {
-                    "$ref": "#/definitions/handler.UserInfo"
+                "if_audit": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.CommentReplyResponse": {
+        "handler.PlateListResponse": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string"
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.PlateResponse"
+                    }
                 },
-                "created_at": {
+                "total": {
                     "type": "integer"
+                }
+            }
+        },
+        "handler.PlateResponse": {
+            "type": "object",
+            "properties": {
+                "belong_key": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "is_liked": {
+                "related_url": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ReconcileObjectsRequest": {
+            "type": "object",
+            "properties": {
+                "apply": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.ReconcileReportResponse": {
+            "type": "object",
+            "properties": {
+                "apply": {
                     "type": "boolean"
                 },
-                "like_count": {
+                "dangling": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.DanglingImgResponse"
+                    }
+                },
+                "dangling_count": {
                     "type": "integer"
                 },
-                "parent_id": {
-                    "type": "string"
+                "finished_at": {
+                    "type": "integer"
                 },
-                "root_id": {
-                    "type": "string"
+                "orphan_count": {
+                    "type": "integer"
                 },
-                "to_user": {
-                    "$ref": "#/definitions/handler.UserInfo"
+                "orphans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.OrphanObjectResponse"
+                    }
                 },
-                "user": {
-                    "$ref": "#/definitions/handler.UserInfo"
+                "repaired_dangling": {
+                    "type": "integer"
+                },
+                "repaired_orphans": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.CommentRootResponse": {
+        "handler.RefreshTokenResponse": {
             "type": "object",
             "properties": {
-                "content": {
+                "access_token": {
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "integer"
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SearchTenantsResponse": {
+            "type": "object",
+            "properties": {
+                "has_next": {
+                    "type": "boolean"
                 },
-                "id": {
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/saas_internal_admin_handler.TenantResponse"
+                    }
+                },
+                "next_cursor": {
                     "type": "string"
                 },
-                "is_liked": {
+                "prev_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SearchUsersResponse": {
+            "type": "object",
+            "properties": {
+                "has_next": {
                     "type": "boolean"
                 },
-                "like_count": {
-                    "type": "integer"
+                "has_prev": {
+                    "type": "boolean"
                 },
-                "parent_id": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/saas_internal_admin_handler.UserResponse"
+                    }
+                },
+                "next_cursor": {
                     "type": "string"
                 },
-                "replies_count": {
-                    "type": "integer"
+                "prev_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SetImgSettingRequest": {
+            "type": "object",
+            "required": [
+                "output_format",
+                "quality"
+            ],
+            "properties": {
+                "dedup_mode": {
+                    "enum": [
+                        "off",
+                        "reuse",
+                        "link"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.DedupMode"
+                        }
+                    ]
+                },
+                "output_format": {
+                    "enum": [
+                        "original",
+                        "webp",
+                        "jpeg"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.OutputFormat"
+                        }
+                    ]
                 },
-                "user": {
-                    "$ref": "#/definitions/handler.UserInfo"
+                "quality": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "variant_widths": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "handler.CreateCategoryRequest": {
+        "handler.SetImgTagsRequest": {
+            "type": "object",
+            "properties": {
+                "tag_ids": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.SetPlateConfigRequest": {
             "type": "object",
             "required": [
-                "prefix",
-                "title"
+                "if_audit"
             ],
             "properties": {
-                "prefix": {
-                    "type": "string",
-                    "maxLength": 20
-                },
-                "title": {
-                    "type": "string",
-                    "maxLength": 10
+                "if_audit": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.CreatePlateRequest": {
+        "handler.SetStorageConfigRequest": {
             "type": "object",
             "required": [
-                "belong_key",
-                "related_url",
-                "summary"
+                "delete_bucket",
+                "public_bucket"
             ],
             "properties": {
-                "belong_key": {
+                "access_key_id": {
                     "type": "string",
-                    "maxLength": 50
+                    "maxLength": 128
                 },
-                "related_url": {
+                "account_id": {
+                    "type": "string"
+                },
+                "delete_bucket": {
+                    "type": "string",
+                    "maxLength": 63
+                },
+                "endpoint": {
                     "type": "string",
                     "maxLength": 255
                 },
-                "summary": {
+                "provider": {
+                    "enum": [
+                        "r2",
+                        "s3",
+                        "local"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.StorageProvider"
+                        }
+                    ]
+                },
+                "public_bucket": {
                     "type": "string",
-                    "maxLength": 500
+                    "maxLength": 63
+                },
+                "public_url_prefix": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "use_path_style": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.GithubAuthRequest": {
+        "handler.SetStorageSecretAccessKeyRequest": {
             "type": "object",
             "required": [
-                "code"
+                "secret_access_key"
             ],
             "properties": {
-                "code": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.ImgResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "integer"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "integer"
-                },
-                "url": {
-                    "type": "string"
+                "secret_access_key": {
+                    "type": "string",
+                    "maxLength": 128
                 }
             }
         },
-        "handler.IsSetR2SecretResponse": {
+        "handler.SetTenantConfigRequest": {
             "type": "object",
+            "required": [
+                "if_audit"
+            ],
             "properties": {
-                "is_set": {
+                "if_audit": {
                     "type": "boolean"
                 }
             }
         },
-        "handler.PlanResponse": {
+        "handler.SetWatermarkSettingRequest": {
             "type": "object",
+            "required": [
+                "kind",
+                "opacity",
+                "position",
+                "scale"
+            ],
             "properties": {
-                "billing_cycle": {
-                    "$ref": "#/definitions/domain.PlanBillingCycle"
+                "category_ids": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "can_upgrade": {
+                "enabled": {
                     "type": "boolean"
                 },
-                "end_time": {
-                    "type": "integer"
+                "keep_original": {
+                    "type": "boolean"
                 },
-                "plan_type": {
-                    "$ref": "#/definitions/domain.PlanType"
+                "kind": {
+                    "enum": [
+                        "text",
+                        "image"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.WatermarkKind"
+                        }
+                    ]
                 },
-                "start_time": {
-                    "type": "integer"
+                "opacity": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
                 },
-                "status": {
-                    "$ref": "#/definitions/domain.PlanStatus"
+                "position": {
+                    "enum": [
+                        "top_left",
+                        "top_right",
+                        "bottom_left",
+                        "bottom_right",
+                        "center"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.WatermarkPosition"
+                        }
+                    ]
                 },
-                "tenant_id": {
+                "scale": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "text_color": {
                     "type": "string"
                 }
             }
         },
-        "handler.PlateConfigResponse": {
+        "handler.StatsResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "comment_count": {
                     "type": "integer"
                 },
-                "if_audit": {
-                    "type": "boolean"
+                "img_count": {
+                    "type": "integer"
                 },
-                "updated_at": {
+                "login_count_24h": {
                     "type": "integer"
-                }
-            }
-        },
-        "handler.PlateListResponse": {
-            "type": "object",
-            "properties": {
-                "list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.PlateResponse"
+                },
+                "new_user_count_7d": {
+                    "type": "integer"
+                },
+                "platform_admin_count": {
+                    "type": "integer"
+                },
+                "tenant_by_plan": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
                     }
                 },
-                "total": {
+                "tenant_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "tenant_count": {
+                    "type": "integer"
+                },
+                "user_count": {
                     "type": "integer"
                 }
             }
         },
-        "handler.PlateResponse": {
+        "handler.StorageCheckResponse": {
             "type": "object",
             "properties": {
-                "belong_key": {
+                "bucket": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "duration_ms": {
+                    "description": "DurationMs 耗时 毫秒",
+                    "type": "integer"
                 },
-                "related_url": {
+                "error": {
                     "type": "string"
                 },
-                "summary": {
-                    "type": "string"
+                "name": {
+                    "$ref": "#/definitions/domain.StorageCheckName"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.StorageCheckStatus"
                 }
             }
         },
-        "handler.R2ConfigResponse": {
+        "handler.StorageConfigResponse": {
             "type": "object",
             "properties": {
                 "access_key_id": {
@@ -2900,85 +8626,144 @@ const docTemplate = `{
                 "delete_bucket": {
                     "type": "string"
                 },
+                "endpoint": {
+                    "type": "string"
+                },
+                "provider": {
+                    "$ref": "#/definitions/domain.StorageProvider"
+                },
                 "public_bucket": {
                     "type": "string"
                 },
                 "public_url_prefix": {
                     "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "use_path_style": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.RefreshTokenResponse": {
+        "handler.StorageDiagnosticResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
-                    "type": "string"
+                "checked_at": {
+                    "type": "integer"
                 },
-                "refresh_token": {
-                    "type": "string"
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.StorageCheckResponse"
+                    }
+                },
+                "ok": {
+                    "description": "OK 全部检查通过或跳过",
+                    "type": "boolean"
+                },
+                "provider": {
+                    "$ref": "#/definitions/domain.StorageProvider"
+                },
+                "stored": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.SetPlateConfigRequest": {
+        "handler.StorageMigrationResponse": {
             "type": "object",
-            "required": [
-                "if_audit"
-            ],
             "properties": {
-                "if_audit": {
-                    "type": "boolean"
+                "copied": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.JobStatus"
+                },
+                "switched_at": {
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "Target 目标存储配置 不包含密钥",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.StorageConfigResponse"
+                        }
+                    ]
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "verified": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.SetR2ConfigRequest": {
+        "handler.StorageUsageResponse": {
             "type": "object",
-            "required": [
-                "access_key_id",
-                "account_id",
-                "delete_bucket",
-                "public_bucket",
-                "public_url_prefix"
-            ],
             "properties": {
-                "access_key_id": {
-                    "type": "string"
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CategoryStorageUsageResponse"
+                    }
                 },
-                "account_id": {
-                    "type": "string"
+                "delete": {
+                    "$ref": "#/definitions/handler.StorageUsageTotalResponse"
                 },
-                "delete_bucket": {
-                    "type": "string",
-                    "maxLength": 32
+                "public": {
+                    "$ref": "#/definitions/handler.StorageUsageTotalResponse"
                 },
-                "public_bucket": {
-                    "type": "string",
-                    "maxLength": 32
+                "reconciled_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.StorageUsageTotalResponse": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "type": "integer"
                 },
-                "public_url_prefix": {
-                    "type": "string",
-                    "maxLength": 128
+                "objects": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.SetR2SecretAccessKeyRequest": {
+        "handler.SuspendTenantRequest": {
             "type": "object",
             "required": [
-                "secret_access_key"
+                "reason"
             ],
             "properties": {
-                "secret_access_key": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 200
                 }
             }
         },
-        "handler.SetTenantConfigRequest": {
+        "handler.TagResponse": {
             "type": "object",
-            "required": [
-                "if_audit"
-            ],
             "properties": {
-                "if_audit": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
@@ -2996,29 +8781,78 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.TenantResponse": {
+        "handler.TestStorageConfigRequest": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "integer"
+                "access_key_id": {
+                    "type": "string",
+                    "maxLength": 128
                 },
-                "creator_id": {
+                "account_id": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "delete_bucket": {
+                    "type": "string",
+                    "maxLength": 63
                 },
-                "id": {
-                    "type": "string"
+                "endpoint": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "name": {
-                    "type": "string"
+                "provider": {
+                    "enum": [
+                        "r2",
+                        "s3",
+                        "local"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.StorageProvider"
+                        }
+                    ]
                 },
-                "plan_type": {
-                    "$ref": "#/definitions/domain.PlanType"
+                "public_bucket": {
+                    "type": "string",
+                    "maxLength": 63
                 },
-                "updated_at": {
-                    "type": "integer"
+                "public_url_prefix": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "secret_access_key": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "use_path_style": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.TransformURLResponse": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.UpdateAlbumRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 32
                 }
             }
         },
@@ -3035,7 +8869,7 @@ const docTemplate = `{
                 },
                 "title": {
                     "type": "string",
-                    "maxLength": 10
+                    "maxLength": 32
                 }
             }
         },
@@ -3062,7 +8896,33 @@ const docTemplate = `{
             ],
             "properties": {
                 "plan_type": {
-                    "$ref": "#/definitions/domain.PlanType"
+                    "$ref": "#/definitions/saas_internal_tenant_domain.PlanType"
+                }
+            }
+        },
+        "handler.UploadSlotResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "integer"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
@@ -3080,28 +8940,40 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.UserResponse": {
+        "handler.WatermarkSettingResponse": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string"
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "created_at": {
-                    "type": "integer"
+                "enabled": {
+                    "type": "boolean"
                 },
-                "email": {
-                    "type": "string"
+                "has_image": {
+                    "type": "boolean"
                 },
-                "id": {
-                    "type": "string"
+                "keep_original": {
+                    "type": "boolean"
                 },
-                "last_login_at": {
+                "kind": {
+                    "$ref": "#/definitions/domain.WatermarkKind"
+                },
+                "opacity": {
                     "type": "integer"
                 },
-                "updated_at": {
+                "position": {
+                    "$ref": "#/definitions/domain.WatermarkPosition"
+                },
+                "scale": {
                     "type": "integer"
                 },
-                "username": {
+                "text": {
+                    "type": "string"
+                },
+                "text_color": {
                     "type": "string"
                 }
             }
@@ -3152,6 +9024,86 @@ const docTemplate = `{
                 }
             }
         },
+        "saas_internal_admin_domain.PlanType": {
+            "type": "string",
+            "enum": [
+                "free",
+                "care",
+                "pro"
+            ],
+            "x-enum-varnames": [
+                "PlanFreeType",
+                "PlanCareType",
+                "PlanProType"
+            ]
+        },
+        "saas_internal_admin_handler.TenantResponse": {
+            "type": "object",
+            "properties": {
+                "billing_cycle": {
+                    "$ref": "#/definitions/domain.BillingCycle"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "creator_email": {
+                    "type": "string"
+                },
+                "creator_id": {
+                    "type": "string"
+                },
+                "end_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "plan_type": {
+                    "$ref": "#/definitions/saas_internal_admin_domain.PlanType"
+                },
+                "start_at": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.TenantStatus"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "saas_internal_admin_handler.UserResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_platform_admin": {
+                    "type": "boolean"
+                },
+                "last_login_at": {
+                    "type": "integer"
+                },
+                "nickname": {
+                    "type": "string"
+                },
+                "tenant_count": {
+                    "type": "integer"
+                }
+            }
+        },
         "saas_internal_comment_handler.CreateRequest": {
             "type": "object",
             "required": [
@@ -3192,6 +9144,19 @@ const docTemplate = `{
                 }
             }
         },
+        "saas_internal_tenant_domain.PlanType": {
+            "type": "string",
+            "enum": [
+                "free",
+                "care",
+                "pro"
+            ],
+            "x-enum-varnames": [
+                "PlanFreeType",
+                "PlanCareType",
+                "PlanProType"
+            ]
+        },
         "saas_internal_tenant_handler.CreateRequest": {
             "type": "object",
             "required": [
@@ -3224,7 +9189,7 @@ const docTemplate = `{
                     ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/domain.PlanType"
+                            "$ref": "#/definitions/saas_internal_tenant_domain.PlanType"
                         }
                     ]
                 }
@@ -3242,7 +9207,7 @@ const docTemplate = `{
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handler.TenantResponse"
+                        "$ref": "#/definitions/saas_internal_tenant_handler.TenantResponse"
                     }
                 },
                 "next_cursor": {
@@ -3252,6 +9217,58 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "saas_internal_tenant_handler.TenantResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "creator_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "plan_type": {
+                    "$ref": "#/definitions/saas_internal_tenant_domain.PlanType"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "saas_internal_user_handler.UserResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_login_at": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -8,9 +8,8 @@ CREATE TABLE public.users
     nickname      varchar(20)    NOT NULL,
     email         varchar(80)    NOT NULL UNIQUE,
     avatar        varchar(255)   NOT NULL DEFAULT 'https://picsum.photos/300/300',
-    github_id     varchar(60)    NULL UNIQUE,
-    google_id     varchar(60)    NULL UNIQUE,
     password_hash text           NULL,
+    is_platform_admin boolean    NOT NULL DEFAULT false,
     last_login_at timestamptz(6) NOT NULL,
     created_at    timestamptz(6) NOT NULL DEFAULT now(),
     updated_at    timestamptz(6) NOT NULL DEFAULT now()
@@ -21,9 +20,64 @@ CREATE INDEX IF NOT EXISTS idx_users_nickname ON public.users (nickname);
 
 
 
+-- 用户第三方登录身份表
+CREATE TABLE public.user_identities
+(
+    id               UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id          UUID           NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    provider         varchar(20)    NOT NULL,
+    provider_user_id varchar(60)    NOT NULL,
+    email            varchar(80)    NULL,
+    email_verified   boolean        NOT NULL DEFAULT false,
+    last_used_at     timestamptz(6) NOT NULL DEFAULT now(),
+    created_at       timestamptz(6) NOT NULL DEFAULT now(),
+    UNIQUE (provider, provider_user_id),
+    UNIQUE (user_id, provider)
+);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON public.user_identities (user_id);
+
+
+
+-- 个人访问令牌表 (仅存储令牌哈希)
+CREATE TABLE public.personal_access_tokens
+(
+    id           UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id      UUID           NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    name         varchar(50)    NOT NULL,
+    token_prefix varchar(20)    NOT NULL,
+    token_hash   char(64)       NOT NULL UNIQUE,
+    scopes       text[]         NOT NULL,
+    expires_at   timestamptz(6) NULL,
+    last_used_at timestamptz(6) NULL,
+    created_at   timestamptz(6) NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON public.personal_access_tokens (user_id);
+
+
+
+-- 用户登录记录表
+CREATE TABLE public.user_login_events
+(
+    id             UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id        UUID           NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    method         varchar(20)    NOT NULL,
+    ip             varchar(45)    NOT NULL,
+    ip_range       varchar(50)    NOT NULL,
+    user_agent     varchar(255)   NOT NULL DEFAULT '',
+    device_hash    char(64)       NOT NULL,
+    success        boolean        NOT NULL,
+    failure_reason varchar(100)   NULL,
+    created_at     timestamptz(6) NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_user_login_events_user_created ON public.user_login_events (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_user_login_events_user_device ON public.user_login_events (user_id, device_hash);
+CREATE INDEX IF NOT EXISTS idx_user_login_events_user_ip_range ON public.user_login_events (user_id, ip_range);
+
+
+
 -- 租户表
 CREATE TYPE tenant_plan_type AS ENUM ('free', 'care','pro');
-CREATE TYPE tenant_status AS ENUM ('active', 'inactive');
+CREATE TYPE tenant_status AS ENUM ('active', 'inactive', 'suspended');
 CREATE TYPE tnant_plan_billing_cycle AS ENUM ('monthly', 'yearly', 'lifetime');
 CREATE TABLE public.tenants
 (
@@ -47,6 +101,23 @@ CREATE UNIQUE INDEX IF NOT EXISTS ux_user_one_care_plan ON public.tenants (creat
 
 
 
+-- 平台管理审计日志表
+CREATE TABLE public.platform_audit_logs
+(
+    id          UUID PRIMARY KEY DEFAULT uuidv7(),
+    admin_id    UUID           NOT NULL REFERENCES public.users (id) ON DELETE RESTRICT,
+    action      varchar(50)    NOT NULL,
+    target_type varchar(20)    NOT NULL,
+    target_id   UUID           NOT NULL,
+    detail      jsonb          NULL,
+    ip          varchar(45)    NOT NULL DEFAULT '',
+    created_at  timestamptz(6) NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_platform_audit_logs_created_at ON public.platform_audit_logs (created_at);
+CREATE INDEX IF NOT EXISTS idx_platform_audit_logs_target ON public.platform_audit_logs (target_type, target_id);
+
+
+
 -- -- 租户计划历史表
 -- CREATE TABLE public.tenant_plan_history
 -- (
@@ -74,11 +145,43 @@ CREATE TABLE public.img_categories
 (
     id UUID PRIMARY KEY DEFAULT uuidv7(),
     tenant_id  UUID         NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
-    title      varchar(10)    NOT NULL,
-    prefix     varchar(20)    NOT NULL,
+    parent_id  UUID REFERENCES public.img_categories (id),  -- 为空表示顶级分类 存在子分类时不能删除
+    tree_path  text           NOT NULL DEFAULT '/',  -- 物化路径 祖先分类id 如 /a/b/ 顶级分类为 /
+    depth      integer        NOT NULL DEFAULT 0,  -- 顶级分类为 0
+    title      varchar(32)    NOT NULL,
+    prefix     varchar(20)    NOT NULL,  -- 存储路径前缀 不随层级拼接 移动子树无需迁移对象
     created_at timestamptz(6) NOT NULL DEFAULT now(),
-    UNIQUE (tenant_id, title)
+    UNIQUE NULLS NOT DISTINCT (tenant_id, parent_id, title)
 );
+CREATE INDEX idx_img_category_tree_path ON public.img_categories (tenant_id, tree_path text_pattern_ops);
+
+
+
+-- 后台任务状态
+CREATE TYPE img_job_status AS ENUM ('pending', 'running', 'succeeded', 'failed');
+
+-- 分类迁移任务类型 rename: 修改前缀后迁移对象 delete_move: 图片移至其他分类后删除分类
+CREATE TYPE img_category_job_kind AS ENUM ('rename', 'delete_move');
+
+-- 分类迁移任务表 逐张迁移 中断后从剩余图片继续
+CREATE TABLE public.img_category_jobs
+(
+    id                 UUID PRIMARY KEY DEFAULT uuidv7(),
+    tenant_id          UUID                  NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
+    category_id        UUID                  NOT NULL,  -- 不设外键 delete_move 完成后分类被删除
+    kind               img_category_job_kind NOT NULL,
+    target_category_id UUID,  -- delete_move 的目标分类 为空表示移出分类
+    status             img_job_status        NOT NULL DEFAULT 'pending',
+    total              integer               NOT NULL DEFAULT 0,
+    processed          integer               NOT NULL DEFAULT 0,
+    failed             integer               NOT NULL DEFAULT 0,
+    last_error         text,
+    created_at         timestamptz(6)        NOT NULL DEFAULT now(),
+    updated_at         timestamptz(6)        NOT NULL DEFAULT now(),  -- 运行中作为心跳 超时后可被其他实例接管
+    finished_at        timestamptz(6)
+);
+CREATE INDEX idx_img_category_job_category ON public.img_category_jobs (tenant_id, category_id);
+CREATE INDEX idx_img_category_job_status ON public.img_category_jobs (status, updated_at);
 
 
 
@@ -89,26 +192,262 @@ CREATE TABLE public.imgs
     tenant_id   UUID         NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
     category_id UUID REFERENCES img_categories (id),
     path        text   NOT NULL,
+    object_path text,  -- 去重关联时指向共享的存储对象 为空则与 path 相同
+    content_hash char(64),  -- 处理后内容的 sha256 直传图片为上传内容的 sha256
+    width       integer        NOT NULL DEFAULT 0,  -- svg/avif 等无法解码的格式为 0
+    height      integer        NOT NULL DEFAULT 0,
+    size        bigint         NOT NULL DEFAULT 0,  -- 存储对象字节数
+    mime_type   varchar(32),
+    original_filename varchar(255),
     description varchar(60),
     created_at  timestamptz(6) NOT NULL DEFAULT now(),
     updated_at  timestamptz(6) NOT NULL DEFAULT now(),
     deleted_at  timestamptz(6),
+    lock_token  bigint         NOT NULL DEFAULT 0,  -- 最近一次加锁写入的 fencing token 更小的令牌写入被拒绝
     UNIQUE (tenant_id, path)
 );
 CREATE INDEX idx_img_deleted_at ON public.imgs (deleted_at);
+CREATE INDEX idx_img_content_hash ON public.imgs (tenant_id, content_hash);
+CREATE INDEX idx_img_object_path ON public.imgs (tenant_id, object_path);
+CREATE INDEX idx_img_size ON public.imgs (tenant_id, size);
+
+
+
+-- 图片标签表 租户内唯一
+CREATE TABLE public.img_tags
+(
+    id         UUID PRIMARY KEY DEFAULT uuidv7(),
+    tenant_id  UUID           NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
+    name       varchar(32)    NOT NULL,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    UNIQUE (tenant_id, name)
+);
+
+-- 图片与标签多对多关联表
+CREATE TABLE public.img_tag_assignments
+(
+    img_id     UUID           NOT NULL REFERENCES public.imgs (id) ON DELETE CASCADE,
+    tag_id     UUID           NOT NULL REFERENCES public.img_tags (id) ON DELETE CASCADE,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    PRIMARY KEY (img_id, tag_id)
+);
+CREATE INDEX idx_img_tag_assignment_tag_id ON public.img_tag_assignments (tag_id);
 CREATE INDEX idx_img_description_trgm ON public.imgs USING gin (description gin_trgm_ops);
 
 
 
--- 租户r2配置表
-CREATE TABLE public.tenant_r2_configs (
-    tenant_id UUID NOT NULL REFERENCES public.tenants(id) ON DELETE CASCADE PRIMARY KEY,  
-    account_id varchar(32) NOT NULL,
-    access_key_id varchar(32) NOT NULL,
+-- 相册表 相册可引用任意分类下的图片
+CREATE TABLE public.img_albums
+(
+    id          UUID PRIMARY KEY DEFAULT uuidv7(),
+    tenant_id   UUID           NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
+    title       varchar(32)    NOT NULL,
+    description varchar(200),
+    created_at  timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at  timestamptz(6) NOT NULL DEFAULT now(),
+    UNIQUE (tenant_id, title)
+);
+
+-- 相册图片表 position 按间隔递增 调整顺序时取相邻位置的中间值 间隔用尽时整体重排
+CREATE TABLE public.img_album_items
+(
+    album_id UUID           NOT NULL REFERENCES public.img_albums (id) ON DELETE CASCADE,
+    img_id   UUID           NOT NULL REFERENCES public.imgs (id) ON DELETE CASCADE,
+    position bigint         NOT NULL,
+    added_at timestamptz(6) NOT NULL DEFAULT now(),
+    PRIMARY KEY (album_id, img_id)
+);
+CREATE INDEX idx_img_album_item_position ON public.img_album_items (album_id, position, img_id);
+CREATE INDEX idx_img_album_item_img_id ON public.img_album_items (img_id);
+
+
+
+-- 导入来源
+CREATE TYPE img_import_source AS ENUM ('urls', 'zip');
+
+-- 图片导入任务表 逐个文件走普通上传流程 中断或失败后可继续
+CREATE TABLE public.img_import_jobs
+(
+    id          UUID PRIMARY KEY DEFAULT uuidv7(),
+    tenant_id   UUID              NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
+    category_id UUID,  -- 不设外键 执行时分类已删除则任务失败
+    source      img_import_source NOT NULL,
+    archive_key text,  -- 压缩包在回收站桶中的暂存路径 任务成功后删除
+    status      img_job_status    NOT NULL DEFAULT 'pending',
+    total       integer           NOT NULL DEFAULT 0,
+    succeeded   integer           NOT NULL DEFAULT 0,
+    failed      integer           NOT NULL DEFAULT 0,
+    last_error  text,
+    created_at  timestamptz(6)    NOT NULL DEFAULT now(),
+    updated_at  timestamptz(6)    NOT NULL DEFAULT now(),  -- 运行中作为心跳 超时后可被其他实例接管
+    finished_at timestamptz(6)
+);
+CREATE INDEX idx_img_import_job_tenant ON public.img_import_jobs (tenant_id, created_at);
+CREATE INDEX idx_img_import_job_status ON public.img_import_jobs (status, updated_at);
+
+-- 导入任务的逐个文件结果 status 不使用 running
+CREATE TABLE public.img_import_items
+(
+    id         UUID PRIMARY KEY DEFAULT uuidv7(),
+    job_id     UUID           NOT NULL REFERENCES public.img_import_jobs (id) ON DELETE CASCADE,
+    seq        integer        NOT NULL,
+    source     text           NOT NULL,  -- URL 或压缩包内的文件路径
+    status     img_job_status NOT NULL DEFAULT 'pending',
+    img_id     UUID REFERENCES public.imgs (id) ON DELETE SET NULL,
+    error_code integer,
+    error      text,
+    updated_at timestamptz(6) NOT NULL DEFAULT now(),
+    UNIQUE (job_id, seq)
+);
+
+-- 图片导出任务表 打包为 ZIP 暂存于回收站桶 过期后删除
+CREATE TABLE public.img_export_jobs
+(
+    id                    UUID PRIMARY KEY DEFAULT uuidv7(),
+    tenant_id             UUID           NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
+    category_id           UUID,  -- 为空表示不按分类筛选
+    include_subcategories boolean        NOT NULL DEFAULT false,
+    tag_ids               text[]         NOT NULL DEFAULT '{}',  -- 须同时带有全部标签
+    include_deleted       boolean        NOT NULL DEFAULT false, -- 是否包含回收站中的图片
+    status                img_job_status NOT NULL DEFAULT 'pending',
+    total                 integer        NOT NULL DEFAULT 0,
+    exported              integer        NOT NULL DEFAULT 0,
+    missing               integer        NOT NULL DEFAULT 0,     -- 存储对象缺失的图片 仅记录在清单中
+    size                  bigint         NOT NULL DEFAULT 0,     -- 压缩包字节数
+    archive_key           text,  -- 压缩包在回收站桶中的路径 过期删除后置空
+    last_error            text,
+    created_at            timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at            timestamptz(6) NOT NULL DEFAULT now(),  -- 运行中作为心跳 超时后可被其他实例接管
+    finished_at           timestamptz(6),
+    expires_at            timestamptz(6)
+);
+CREATE INDEX idx_img_export_job_tenant ON public.img_export_jobs (tenant_id, created_at);
+CREATE INDEX idx_img_export_job_status ON public.img_export_jobs (status, updated_at);
+CREATE INDEX idx_img_export_job_expires ON public.img_export_jobs (expires_at) WHERE archive_key IS NOT NULL;
+
+
+
+-- 图片响应式缩放版本表
+CREATE TABLE public.img_variants
+(
+    id         UUID PRIMARY KEY DEFAULT uuidv7(),
+    img_id     UUID           NOT NULL REFERENCES public.imgs (id) ON DELETE CASCADE,
+    width      integer        NOT NULL,
+    height     integer        NOT NULL,
+    path       text           NOT NULL,
+    size       bigint         NOT NULL DEFAULT 0,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    UNIQUE (img_id, width)
+);
+
+
+
+-- 图片所在的存储桶 public: 公共桶 delete: 回收站桶
+CREATE TYPE img_bucket_kind AS ENUM ('public', 'delete');
+
+-- 租户存储用量表 按分类与桶统计 category_id 为空表示未分类
+-- 随上传与删除增量更新 定期按桶内实际对象校准
+CREATE TABLE public.img_storage_usages
+(
+    id            UUID PRIMARY KEY DEFAULT uuidv7(),
+    tenant_id     UUID            NOT NULL REFERENCES public.tenants (id) ON DELETE CASCADE,
+    category_id   UUID REFERENCES public.img_categories (id) ON DELETE CASCADE,
+    bucket        img_bucket_kind NOT NULL,
+    bytes         bigint          NOT NULL DEFAULT 0,
+    objects       bigint          NOT NULL DEFAULT 0,
+    reconciled_at timestamptz(6),
+    updated_at    timestamptz(6)  NOT NULL DEFAULT now(),
+    UNIQUE NULLS NOT DISTINCT (tenant_id, category_id, bucket)
+);
+
+
+
+-- 对象存储服务商
+CREATE TYPE storage_provider AS ENUM ('r2', 's3', 'local');
+
+-- 租户对象存储配置表
+CREATE TABLE public.tenant_storage_configs (
+    tenant_id UUID NOT NULL REFERENCES public.tenants(id) ON DELETE CASCADE PRIMARY KEY,
+    provider storage_provider NOT NULL DEFAULT 'r2',
+    account_id varchar(32) NULL,  -- 仅 r2 使用
+    endpoint varchar(255) NULL,  -- 仅 s3 兼容服务使用 为空时使用 aws 默认端点
+    region varchar(32) NULL,
+    use_path_style boolean NOT NULL DEFAULT false,
+    access_key_id varchar(128) NULL,
     secret_access_key text  NULL,  -- 加密存储
-    public_bucket varchar(32) NOT NULL,
+    public_bucket varchar(63) NOT NULL,
+    public_url_prefix varchar(128) NOT NULL,
+    delete_bucket varchar(63) NOT NULL,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at timestamptz(6) NOT NULL DEFAULT now()
+);
+
+-- 存储迁移任务表 将全部对象复制到新的存储配置 校验通过后切换 失败时清理已复制的对象
+CREATE TABLE public.img_storage_migrations (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    tenant_id UUID NOT NULL REFERENCES public.tenants(id) ON DELETE CASCADE,
+    status img_job_status NOT NULL DEFAULT 'pending',
+    -- 目标存储配置 切换时写入 tenant_storage_configs
+    provider storage_provider NOT NULL,
+    account_id varchar(32) NULL,
+    endpoint varchar(255) NULL,
+    region varchar(32) NULL,
+    use_path_style boolean NOT NULL DEFAULT false,
+    access_key_id varchar(128) NULL,
+    secret_access_key text NULL,  -- 加密存储
+    public_bucket varchar(63) NOT NULL,
     public_url_prefix varchar(128) NOT NULL,
-    delete_bucket varchar(32) NOT NULL,
+    delete_bucket varchar(63) NOT NULL,
+    -- 进度
+    total integer NOT NULL DEFAULT 0,
+    copied integer NOT NULL DEFAULT 0,
+    verified integer NOT NULL DEFAULT 0,
+    last_error text NULL,
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at timestamptz(6) NOT NULL DEFAULT now(),  -- 运行中作为心跳 超时后可被其他实例接管
+    switched_at timestamptz(6) NULL,
+    finished_at timestamptz(6) NULL
+);
+CREATE INDEX idx_img_storage_migration_tenant ON public.img_storage_migrations (tenant_id, created_at);
+CREATE INDEX idx_img_storage_migration_status ON public.img_storage_migrations (status, updated_at);
+-- 每个租户同时只能有一个未完成的迁移
+CREATE UNIQUE INDEX uq_img_storage_migration_active ON public.img_storage_migrations (tenant_id) WHERE status IN ('pending', 'running');
+
+
+
+-- 图片输出格式
+CREATE TYPE img_output_format AS ENUM ('original', 'webp', 'jpeg');
+
+-- 上传内容重复时的处理方式 off: 不去重 reuse: 返回已有图片 link: 新路径共享已有对象
+CREATE TYPE img_dedup_mode AS ENUM ('off', 'reuse', 'link');
+
+-- 租户图片处理配置表 未配置时使用默认值
+CREATE TABLE public.tenant_img_settings (
+    tenant_id UUID NOT NULL REFERENCES public.tenants(id) ON DELETE CASCADE PRIMARY KEY,
+    output_format img_output_format NOT NULL DEFAULT 'original',
+    quality smallint NOT NULL DEFAULT 75 CHECK (quality BETWEEN 1 AND 100),
+    variant_widths integer[] NOT NULL DEFAULT '{160,480,1280}',
+    dedup_mode img_dedup_mode NOT NULL DEFAULT 'reuse',
+    created_at timestamptz(6) NOT NULL DEFAULT now(),
+    updated_at timestamptz(6) NOT NULL DEFAULT now()
+);
+
+CREATE TYPE img_watermark_kind AS ENUM ('text', 'image');
+CREATE TYPE img_watermark_position AS ENUM ('top_left', 'top_right', 'bottom_left', 'bottom_right', 'center');
+
+-- 租户级水印配置 上传经服务端处理时叠加到公共图片
+CREATE TABLE public.img_watermark_settings (
+    tenant_id UUID NOT NULL REFERENCES public.tenants(id) ON DELETE CASCADE PRIMARY KEY,
+    enabled boolean NOT NULL DEFAULT false,
+    kind img_watermark_kind NOT NULL DEFAULT 'text',
+    text varchar(64) NOT NULL DEFAULT '',
+    text_color char(7) NOT NULL DEFAULT '#FFFFFF',
+    image_key text NOT NULL DEFAULT '',  -- 水印图片在 delete_bucket 中的路径
+    position img_watermark_position NOT NULL DEFAULT 'bottom_right',
+    opacity smallint NOT NULL DEFAULT 50 CHECK (opacity BETWEEN 1 AND 100),
+    scale smallint NOT NULL DEFAULT 20 CHECK (scale BETWEEN 1 AND 100),  -- 水印宽度占图片宽度的百分比
+    category_ids text[] NOT NULL DEFAULT '{}',  -- 为空表示全部图片 包含子分类
+    keep_original boolean NOT NULL DEFAULT false,  -- 在 delete_bucket 中保留未加水印的图片
     created_at timestamptz(6) NOT NULL DEFAULT now(),
     updated_at timestamptz(6) NOT NULL DEFAULT now()
 );
@@ -0,0 +1,36 @@
+-- user-026: 第三方登录身份从 users.github_id / users.google_id 迁移到 user_identities
+-- 适用于已有数据的库; 新库直接使用 ddl.sql 初始化即可
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS public.user_identities
+(
+    id               UUID PRIMARY KEY DEFAULT uuidv7(),
+    user_id          UUID           NOT NULL REFERENCES public.users (id) ON DELETE CASCADE,
+    provider         varchar(20)    NOT NULL,
+    provider_user_id varchar(60)    NOT NULL,
+    email            varchar(80)    NULL,
+    email_verified   boolean        NOT NULL DEFAULT false,
+    last_used_at     timestamptz(6) NOT NULL DEFAULT now(),
+    created_at       timestamptz(6) NOT NULL DEFAULT now(),
+    UNIQUE (provider, provider_user_id),
+    UNIQUE (user_id, provider)
+);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON public.user_identities (user_id);
+
+-- 旧绑定均由第三方确认过邮箱后创建, 视为已验证
+INSERT INTO public.user_identities (user_id, provider, provider_user_id, email, email_verified, last_used_at, created_at)
+SELECT id, 'github', github_id, email, true, last_login_at, created_at
+FROM public.users
+WHERE github_id IS NOT NULL AND github_id <> ''
+ON CONFLICT DO NOTHING;
+
+INSERT INTO public.user_identities (user_id, provider, provider_user_id, email, email_verified, last_used_at, created_at)
+SELECT id, 'google', google_id, email, true, last_login_at, created_at
+FROM public.users
+WHERE google_id IS NOT NULL AND google_id <> ''
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE public.users DROP COLUMN IF EXISTS github_id;
+ALTER TABLE public.users DROP COLUMN IF EXISTS google_id;
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- user-030: tenant_r2_configs 重命名为 tenant_storage_configs 并支持多种存储服务商
+-- 已有配置均为 r2 数据原样保留
+BEGIN;
+
+CREATE TYPE storage_provider AS ENUM ('r2', 's3', 'local');
+
+ALTER TABLE public.tenant_r2_configs RENAME TO tenant_storage_configs;
+ALTER TABLE public.tenant_storage_configs RENAME CONSTRAINT tenant_r2_configs_pkey TO tenant_storage_configs_pkey;
+ALTER TABLE public.tenant_storage_configs RENAME CONSTRAINT tenant_r2_configs_tenant_id_fkey TO tenant_storage_configs_tenant_id_fkey;
+
+ALTER TABLE public.tenant_storage_configs
+    ADD COLUMN provider storage_provider NOT NULL DEFAULT 'r2',
+    ADD COLUMN endpoint varchar(255) NULL,
+    ADD COLUMN region varchar(32) NULL,
+    ADD COLUMN use_path_style boolean NOT NULL DEFAULT false,
+    ALTER COLUMN account_id DROP NOT NULL,
+    ALTER COLUMN access_key_id DROP NOT NULL,
+    ALTER COLUMN access_key_id TYPE varchar(128),
+    ALTER COLUMN public_bucket TYPE varchar(63),
+    ALTER COLUMN delete_bucket TYPE varchar(63);
+
+COMMIT;
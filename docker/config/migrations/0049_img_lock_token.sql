@@ -0,0 +1,3 @@
+-- user-049: 图片记录保存最近一次加锁写入的 fencing token
+-- 删除、恢复与移动仅在令牌不小于已记录值时生效 锁过期后的旧持有者写入被拒绝
+ALTER TABLE public.imgs ADD COLUMN IF NOT EXISTS lock_token bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"encoding/json"
+	"saas/internal/admin/domain"
+	"saas/internal/common/orm"
+
+	"github.com/aarondl/null/v8"
+)
+
+func ormUserToDomain(ormUser *orm.User) *domain.User {
+	if ormUser == nil {
+		return nil
+	}
+
+	return &domain.User{
+		ID:              ormUser.ID,
+		Email:           ormUser.Email,
+		Nickname:        ormUser.Nickname,
+		Avatar:          ormUser.Avatar,
+		IsPlatformAdmin: ormUser.IsPlatformAdmin,
+		CreatedAt:       ormUser.CreatedAt,
+		LastLoginAt:     ormUser.LastLoginAt,
+	}
+}
+
+func ormUsersToDomain(ormUsers []*orm.User) []*domain.User {
+	users := make([]*domain.User, 0, len(ormUsers))
+	for _, ormUser := range ormUsers {
+		if ormUser != nil {
+			users = append(users, ormUserToDomain(ormUser))
+		}
+	}
+	return users
+}
+
+func ormTenantToDomain(ormTenant *orm.Tenant) *domain.Tenant {
+	if ormTenant == nil {
+		return nil
+	}
+
+	tenant := &domain.Tenant{
+		ID:           ormTenant.ID,
+		Name:         ormTenant.Name,
+		CreatorID:    ormTenant.CreatorID,
+		PlanType:     domain.PlanType(ormTenant.PlanType),
+		BillingCycle: domain.BillingCycle(ormTenant.BillingCycle),
+		Status:       domain.TenantStatus(ormTenant.Status),
+		StartAt:      ormTenant.StartAt,
+		CreatedAt:    ormTenant.CreatedAt,
+		UpdatedAt:    ormTenant.UpdatedAt,
+	}
+
+	// 处理null项
+	if ormTenant.EndAt.Valid {
+		tenant.EndAt = ormTenant.EndAt.Time
+	}
+
+	return tenant
+}
+
+func ormTenantsToDomain(ormTenants []*orm.Tenant) []*domain.Tenant {
+	tenants := make([]*domain.Tenant, 0, len(ormTenants))
+	for _, ormTenant := range ormTenants {
+		if ormTenant != nil {
+			tenants = append(tenants, ormTenantToDomain(ormTenant))
+		}
+	}
+	return tenants
+}
+
+func domainAuditLogToORM(log *domain.AuditLog) (*orm.PlatformAuditLog, error) {
+	if log == nil {
+		return nil, nil
+	}
+
+	ormLog := &orm.PlatformAuditLog{
+		ID:         log.ID,
+		AdminID:    log.AdminID,
+		Action:     log.Action,
+		TargetType: log.TargetType,
+		TargetID:   log.TargetID,
+		IP:         log.IP,
+	}
+
+	if len(log.Detail) > 0 {
+		detail, err := json.Marshal(log.Detail)
+		if err != nil {
+			return nil, err
+		}
+		ormLog.Detail = null.JSONFrom(detail)
+	}
+
+	return ormLog, nil
+}
+
+func ormAuditLogToDomain(ormLog *orm.PlatformAuditLog) *domain.AuditLog {
+	if ormLog == nil {
+		return nil
+	}
+
+	log := &domain.AuditLog{
+		ID:         ormLog.ID,
+		AdminID:    ormLog.AdminID,
+		Action:     ormLog.Action,
+		TargetType: ormLog.TargetType,
+		TargetID:   ormLog.TargetID,
+		IP:         ormLog.IP,
+		CreatedAt:  ormLog.CreatedAt,
+	}
+
+	// 处理null项
+	if ormLog.Detail.Valid {
+		_ = json.Unmarshal(ormLog.Detail.JSON, &log.Detail)
+	}
+
+	return log
+}
+
+func ormAuditLogsToDomain(ormLogs []*orm.PlatformAuditLog) []*domain.AuditLog {
+	logs := make([]*domain.AuditLog, 0, len(ormLogs))
+	for _, ormLog := range ormLogs {
+		if ormLog != nil {
+			logs = append(logs, ormAuditLogToDomain(ormLog))
+		}
+	}
+	return logs
+}
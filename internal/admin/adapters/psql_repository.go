@@ -0,0 +1,366 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"saas/internal/admin/domain"
+	"saas/internal/common/orm"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/common/utils/dbkit"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+)
+
+type AdminPSQLRepository struct {
+}
+
+func NewAdminPSQLRepository() domain.AdminRepository {
+	return &AdminPSQLRepository{}
+}
+
+func (repo *AdminPSQLRepository) GetUser(id string) (*domain.User, error) {
+	ormUser, err := orm.FindUserG(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrUserNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	user := ormUserToDomain(ormUser)
+
+	user.TenantCount, err = orm.Tenants(orm.TenantWhere.CreatorID.EQ(id)).CountG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return user, nil
+}
+
+func (repo *AdminPSQLRepository) ListUsersByKeyset(query *domain.UserKeysetQuery) (*domain.UserKeysetResult, error) {
+	baseMods := make([]qm.QueryMod, 0, 2)
+
+	if query.Keyword != "" {
+		like := "%" + query.Keyword + "%"
+		baseMods = append(baseMods, qm.Where(
+			fmt.Sprintf("(%s ILIKE ? OR %s ILIKE ? OR %s::text = ?)",
+				orm.UserColumns.Email, orm.UserColumns.Nickname, orm.UserColumns.ID),
+			like, like, query.Keyword,
+		))
+	}
+
+	ks := dbkit.NewKeyset[*domain.User](
+		orm.UserColumns.ID,
+		orm.UserColumns.CreatedAt,
+		query.PrevCursor,
+		query.NextCursor,
+		query.PageSize,
+	)
+
+	mods := ks.ApplyKeysetMods(baseMods)
+
+	ormUsers, err := orm.Users(mods...).AllG()
+	if err != nil {
+		return nil, err
+	}
+
+	domains := ormUsersToDomain(ormUsers)
+
+	// 精确判断 hasPrev/hasNext：exists 必须和 baseMods 保持一致
+	exists := func(primary time.Time, id string, checkPrev bool) (bool, error) {
+		var cond qm.QueryMod
+		if checkPrev {
+			cond = ks.BeforeWhere(primary, id)
+		} else {
+			cond = ks.AfterWhere(primary, id)
+		}
+		checkMods := append([]qm.QueryMod{}, baseMods...)
+		checkMods = append(checkMods, cond, qm.Limit(1))
+		return orm.Users(checkMods...).ExistsG()
+	}
+
+	result, err := ks.BuildWithExistence(domains, exists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UserKeysetResult{
+		Items:      result.Items,
+		PrevCursor: result.PrevCursor,
+		NextCursor: result.NextCursor,
+		HasPrev:    result.HasPrev,
+		HasNext:    result.HasNext,
+	}, nil
+}
+
+func (repo *AdminPSQLRepository) GetTenant(id string) (*domain.Tenant, error) {
+	ormTenant, err := orm.FindTenantG(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrTenantNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	tenant := ormTenantToDomain(ormTenant)
+
+	creator, err := orm.FindUserG(ormTenant.CreatorID, orm.UserColumns.Email)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.WithStack(err)
+	}
+	if creator != nil {
+		tenant.CreatorEmail = creator.Email
+	}
+
+	return tenant, nil
+}
+
+func (repo *AdminPSQLRepository) ListTenantsByKeyset(query *domain.TenantKeysetQuery) (*domain.TenantKeysetResult, error) {
+	baseMods := make([]qm.QueryMod, 0, 4)
+
+	if query.Keyword != "" {
+		like := "%" + query.Keyword + "%"
+		baseMods = append(baseMods, qm.Where(
+			fmt.Sprintf("(%s ILIKE ? OR %s::text = ?)", orm.TenantColumns.Name, orm.TenantColumns.ID),
+			like, query.Keyword,
+		))
+	}
+	if query.CreatorID != "" {
+		baseMods = append(baseMods, orm.TenantWhere.CreatorID.EQ(query.CreatorID))
+	}
+	if query.Status != "" {
+		baseMods = append(baseMods, orm.TenantWhere.Status.EQ(orm.TenantStatus(query.Status)))
+	}
+	if query.PlanType != "" {
+		baseMods = append(baseMods, orm.TenantWhere.PlanType.EQ(orm.TenantPlanType(query.PlanType)))
+	}
+
+	ks := dbkit.NewKeyset[*domain.Tenant](
+		orm.TenantColumns.ID,
+		orm.TenantColumns.CreatedAt,
+		query.PrevCursor,
+		query.NextCursor,
+		query.PageSize,
+	)
+
+	mods := ks.ApplyKeysetMods(baseMods)
+
+	ormTenants, err := orm.Tenants(mods...).AllG()
+	if err != nil {
+		return nil, err
+	}
+
+	domains := ormTenantsToDomain(ormTenants)
+
+	// 精确判断 hasPrev/hasNext：exists 必须和 baseMods 保持一致
+	exists := func(primary time.Time, id string, checkPrev bool) (bool, error) {
+		var cond qm.QueryMod
+		if checkPrev {
+			cond = ks.BeforeWhere(primary, id)
+		} else {
+			cond = ks.AfterWhere(primary, id)
+		}
+		checkMods := append([]qm.QueryMod{}, baseMods...)
+		checkMods = append(checkMods, cond, qm.Limit(1))
+		return orm.Tenants(checkMods...).ExistsG()
+	}
+
+	result, err := ks.BuildWithExistence(domains, exists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TenantKeysetResult{
+		Items:      result.Items,
+		PrevCursor: result.PrevCursor,
+		NextCursor: result.NextCursor,
+		HasPrev:    result.HasPrev,
+		HasNext:    result.HasNext,
+	}, nil
+}
+
+func (repo *AdminPSQLRepository) UpdateTenantStatus(id string, status domain.TenantStatus) error {
+	rows, err := orm.Tenants(orm.TenantWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.TenantColumns.Status:    orm.TenantStatus(status),
+		orm.TenantColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrTenantNotFound
+	}
+
+	return nil
+}
+
+func (repo *AdminPSQLRepository) UpdateTenantPlan(id string, change *domain.PlanChange) error {
+	endAt := null.Time{}
+	if !change.EndAt.IsZero() {
+		endAt = null.TimeFrom(change.EndAt)
+	}
+
+	rows, err := orm.Tenants(orm.TenantWhere.ID.EQ(id)).UpdateAllG(orm.M{
+		orm.TenantColumns.PlanType:     orm.TenantPlanType(change.PlanType),
+		orm.TenantColumns.BillingCycle: orm.TnantPlanBillingCycle(change.BillingCycle),
+		orm.TenantColumns.StartAt:      time.Now(),
+		orm.TenantColumns.EndAt:        endAt,
+		orm.TenantColumns.UpdatedAt:    time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrTenantNotFound
+	}
+
+	return nil
+}
+
+func (repo *AdminPSQLRepository) CreatorHasPlan(creatorID string, planType domain.PlanType, excludeTenantID string) (bool, error) {
+	exist, err := orm.Tenants(
+		orm.TenantWhere.CreatorID.EQ(creatorID),
+		orm.TenantWhere.PlanType.EQ(orm.TenantPlanType(planType)),
+		orm.TenantWhere.ID.NEQ(excludeTenantID),
+	).ExistsG()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return exist, nil
+}
+
+func (repo *AdminPSQLRepository) GetStats(since7d, since24h time.Time) (*domain.Stats, error) {
+	var err error
+	stats := &domain.Stats{
+		TenantByStatus: make(map[domain.TenantStatus]int64),
+		TenantByPlan:   make(map[domain.PlanType]int64),
+	}
+
+	if stats.UserCount, err = orm.Users().CountG(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if stats.NewUserCount7d, err = orm.Users(orm.UserWhere.CreatedAt.GTE(since7d)).CountG(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if stats.PlatformAdminCount, err = orm.Users(orm.UserWhere.IsPlatformAdmin.EQ(true)).CountG(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if stats.TenantCount, err = orm.Tenants().CountG(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if stats.ImgCount, err = orm.Imgs().CountG(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if stats.CommentCount, err = orm.Comments().CountG(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if stats.LoginCount24h, err = orm.UserLoginEvents(
+		orm.UserLoginEventWhere.Success.EQ(true),
+		orm.UserLoginEventWhere.CreatedAt.GTE(since24h),
+	).CountG(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 按状态/计划分组统计租户
+	var byStatus []struct {
+		Status string `boil:"status"`
+		Count  int64  `boil:"count"`
+	}
+	if err := orm.Tenants(
+		qm.Select(orm.TenantColumns.Status, "count(*) AS count"),
+		qm.GroupBy(orm.TenantColumns.Status),
+	).BindG(context.Background(), &byStatus); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, row := range byStatus {
+		stats.TenantByStatus[domain.TenantStatus(row.Status)] = row.Count
+	}
+
+	var byPlan []struct {
+		PlanType string `boil:"plan_type"`
+		Count    int64  `boil:"count"`
+	}
+	if err := orm.Tenants(
+		qm.Select(orm.TenantColumns.PlanType, "count(*) AS count"),
+		qm.GroupBy(orm.TenantColumns.PlanType),
+	).BindG(context.Background(), &byPlan); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, row := range byPlan {
+		stats.TenantByPlan[domain.PlanType(row.PlanType)] = row.Count
+	}
+
+	return stats, nil
+}
+
+func (repo *AdminPSQLRepository) CreateAuditLog(log *domain.AuditLog) error {
+	ormLog, err := domainAuditLogToORM(log)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(ormLog.InsertG(boil.Infer()))
+}
+
+func (repo *AdminPSQLRepository) ListAuditLogsByKeyset(query *domain.AuditLogKeysetQuery) (*domain.AuditLogKeysetResult, error) {
+	baseMods := make([]qm.QueryMod, 0, 3)
+
+	if query.AdminID != "" {
+		baseMods = append(baseMods, orm.PlatformAuditLogWhere.AdminID.EQ(query.AdminID))
+	}
+	if query.TargetType != "" {
+		baseMods = append(baseMods, orm.PlatformAuditLogWhere.TargetType.EQ(query.TargetType))
+	}
+	if query.TargetID != "" {
+		baseMods = append(baseMods, orm.PlatformAuditLogWhere.TargetID.EQ(query.TargetID))
+	}
+
+	ks := dbkit.NewKeyset[*domain.AuditLog](
+		orm.PlatformAuditLogColumns.ID,
+		orm.PlatformAuditLogColumns.CreatedAt,
+		query.PrevCursor,
+		query.NextCursor,
+		query.PageSize,
+	)
+
+	mods := ks.ApplyKeysetMods(baseMods)
+
+	ormLogs, err := orm.PlatformAuditLogs(mods...).AllG()
+	if err != nil {
+		return nil, err
+	}
+
+	domains := ormAuditLogsToDomain(ormLogs)
+
+	// 精确判断 hasPrev/hasNext：exists 必须和 baseMods 保持一致
+	exists := func(primary time.Time, id string, checkPrev bool) (bool, error) {
+		var cond qm.QueryMod
+		if checkPrev {
+			cond = ks.BeforeWhere(primary, id)
+		} else {
+			cond = ks.AfterWhere(primary, id)
+		}
+		checkMods := append([]qm.QueryMod{}, baseMods...)
+		checkMods = append(checkMods, cond, qm.Limit(1))
+		return orm.PlatformAuditLogs(checkMods...).ExistsG()
+	}
+
+	result, err := ks.BuildWithExistence(domains, exists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AuditLogKeysetResult{
+		Items:      result.Items,
+		PrevCursor: result.PrevCursor,
+		NextCursor: result.NextCursor,
+		HasPrev:    result.HasPrev,
+		HasNext:    result.HasNext,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package adapters
+
+import (
+	"saas/internal/admin/domain"
+	"saas/internal/common/jwt"
+	"saas/internal/common/utils"
+	"time"
+
+	"github.com/pkg/errors"
+
+	userdomain "saas/internal/user/domain"
+)
+
+// 模拟登录令牌有效期 刻意短于普通访问令牌
+const impersonationTTL = 15 * time.Minute
+
+type JWTTokenIssuer struct {
+	secret string
+}
+
+func NewJWTTokenIssuer() domain.TokenIssuer {
+	return &JWTTokenIssuer{
+		secret: utils.GetEnv("JWT_SECRET"),
+	}
+}
+
+// IssueImpersonationToken 签发与普通访问令牌同格式的 JWT 通过 ImpersonatorID 标记为只读会话
+func (i *JWTTokenIssuer) IssueImpersonationToken(userID, adminID string) (*domain.Impersonation, error) {
+	payload := &userdomain.JwtPayload{
+		UserID:         userID,
+		ImpersonatorID: adminID,
+	}
+
+	token, err := jwt.GenToken[userdomain.JwtPayload](payload, i.secret, impersonationTTL)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.Impersonation{
+		AccessToken: token,
+		ExpiresAt:   time.Now().Add(impersonationTTL),
+	}, nil
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"time"
+)
+
+type TenantStatus string
+
+const TenantActiveStatus TenantStatus = "active"
+const TenantInactiveStatus TenantStatus = "inactive"
+const TenantSuspendedStatus TenantStatus = "suspended"
+
+type PlanType string
+
+const PlanFreeType PlanType = "free"
+const PlanCareType PlanType = "care"
+const PlanProType PlanType = "pro"
+
+type BillingCycle string
+
+const MonthlyBillingCycle BillingCycle = "monthly"
+const YearlyBillingCycle BillingCycle = "yearly"
+const LifetimeBillingCycle BillingCycle = "lifetime"
+
+type User struct {
+	ID              string
+	Email           string
+	Nickname        string
+	Avatar          string
+	IsPlatformAdmin bool
+	TenantCount     int64
+	CreatedAt       time.Time
+	LastLoginAt     time.Time
+}
+
+func (u *User) GetCursorPrimary() time.Time {
+	return u.CreatedAt
+}
+
+func (u *User) GetID() string {
+	return u.ID
+}
+
+type Tenant struct {
+	ID           string
+	Name         string
+	CreatorID    string
+	CreatorEmail string
+	PlanType     PlanType
+	BillingCycle BillingCycle
+	Status       TenantStatus
+	StartAt      time.Time
+	EndAt        time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (t *Tenant) GetCursorPrimary() time.Time {
+	return t.CreatedAt
+}
+
+func (t *Tenant) GetID() string {
+	return t.ID
+}
+
+type UserKeysetQuery struct {
+	Keyword    string
+	PageSize   int
+	PrevCursor string
+	NextCursor string
+}
+
+type UserKeysetResult struct {
+	Items      []*User
+	PrevCursor string
+	NextCursor string
+	HasPrev    bool
+	HasNext    bool
+}
+
+type TenantKeysetQuery struct {
+	Keyword    string
+	CreatorID  string
+	Status     TenantStatus
+	PlanType   PlanType
+	PageSize   int
+	PrevCursor string
+	NextCursor string
+}
+
+type TenantKeysetResult struct {
+	Items      []*Tenant
+	PrevCursor string
+	NextCursor string
+	HasPrev    bool
+	HasNext    bool
+}
+
+// PlanChange 平台管理员调整租户计划 EndAt 为零值表示不设结束时间
+type PlanChange struct {
+	PlanType     PlanType
+	BillingCycle BillingCycle
+	EndAt        time.Time
+}
+
+type Stats struct {
+	UserCount          int64
+	NewUserCount7d     int64
+	TenantCount        int64
+	TenantByStatus     map[TenantStatus]int64
+	TenantByPlan       map[PlanType]int64
+	ImgCount           int64
+	CommentCount       int64
+	LoginCount24h      int64
+	PlatformAdminCount int64
+}
+
+// 审计动作
+const (
+	AuditActionSuspendTenant    = "suspend_tenant"
+	AuditActionReactivateTenant = "reactivate_tenant"
+	AuditActionChangePlan       = "change_plan"
+	AuditActionImpersonate      = "impersonate"
+)
+
+const (
+	AuditTargetUser   = "user"
+	AuditTargetTenant = "tenant"
+)
+
+type AuditLog struct {
+	ID         string
+	AdminID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Detail     map[string]any
+	IP         string
+	CreatedAt  time.Time
+}
+
+func (l *AuditLog) GetCursorPrimary() time.Time {
+	return l.CreatedAt
+}
+
+func (l *AuditLog) GetID() string {
+	return l.ID
+}
+
+type AuditLogKeysetQuery struct {
+	AdminID    string
+	TargetType string
+	TargetID   string
+	PageSize   int
+	PrevCursor string
+	NextCursor string
+}
+
+type AuditLogKeysetResult struct {
+	Items      []*AuditLog
+	PrevCursor string
+	NextCursor string
+	HasPrev    bool
+	HasNext    bool
+}
+
+// Impersonation 模拟登录结果 仅包含短期只读访问令牌
+type Impersonation struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type AdminRepository interface {
+	// 用户
+	GetUser(id string) (*User, error)
+	ListUsersByKeyset(query *UserKeysetQuery) (*UserKeysetResult, error)
+
+	// 租户
+	GetTenant(id string) (*Tenant, error)
+	ListTenantsByKeyset(query *TenantKeysetQuery) (*TenantKeysetResult, error)
+	UpdateTenantStatus(id string, status TenantStatus) error
+	UpdateTenantPlan(id string, change *PlanChange) error
+	CreatorHasPlan(creatorID string, planType PlanType, excludeTenantID string) (bool, error)
+
+	// 统计
+	GetStats(since7d, since24h time.Time) (*Stats, error)
+
+	// 审计
+	CreateAuditLog(log *AuditLog) error
+	ListAuditLogsByKeyset(query *AuditLogKeysetQuery) (*AuditLogKeysetResult, error)
+}
+
+// TokenIssuer 签发模拟登录使用的只读令牌
+type TokenIssuer interface {
+	IssueImpersonationToken(userID, adminID string) (*Impersonation, error)
+}
@@ -0,0 +1,23 @@
+package domain
+
+type AdminService interface {
+	SearchUsers(query *UserKeysetQuery) (*UserKeysetResult, error)
+	GetUser(id string) (*User, error)
+
+	SearchTenants(query *TenantKeysetQuery) (*TenantKeysetResult, error)
+	GetTenant(id string) (*Tenant, error)
+	SuspendTenant(actor *Actor, id, reason string) error
+	ReactivateTenant(actor *Actor, id string) error
+	ChangeTenantPlan(actor *Actor, id string, change *PlanChange) error
+
+	Impersonate(actor *Actor, userID, reason string) (*Impersonation, error)
+
+	GetStats() (*Stats, error)
+	ListAuditLogs(query *AuditLogKeysetQuery) (*AuditLogKeysetResult, error)
+}
+
+// Actor 执行操作的平台管理员
+type Actor struct {
+	AdminID string
+	IP      string
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"saas/internal/admin/domain"
+)
+
+func domainUserToResponse(user *domain.User) *UserResponse {
+	if user == nil {
+		return nil
+	}
+
+	return &UserResponse{
+		ID:              user.ID,
+		Email:           user.Email,
+		Nickname:        user.Nickname,
+		Avatar:          user.Avatar,
+		IsPlatformAdmin: user.IsPlatformAdmin,
+		TenantCount:     user.TenantCount,
+		CreatedAt:       user.CreatedAt.Unix(),
+		LastLoginAt:     user.LastLoginAt.Unix(),
+	}
+}
+
+func domainUserKeysetToResponse(pager *domain.UserKeysetResult) *SearchUsersResponse {
+	if pager == nil {
+		return nil
+	}
+
+	items := make([]*UserResponse, 0, len(pager.Items))
+	for _, user := range pager.Items {
+		items = append(items, domainUserToResponse(user))
+	}
+
+	return &SearchUsersResponse{
+		Items:      items,
+		PrevCursor: pager.PrevCursor,
+		NextCursor: pager.NextCursor,
+		HasPrev:    pager.HasPrev,
+		HasNext:    pager.HasNext,
+	}
+}
+
+func domainTenantToResponse(tenant *domain.Tenant) *TenantResponse {
+	if tenant == nil {
+		return nil
+	}
+
+	resp := &TenantResponse{
+		ID:           tenant.ID,
+		Name:         tenant.Name,
+		CreatorID:    tenant.CreatorID,
+		CreatorEmail: tenant.CreatorEmail,
+		PlanType:     tenant.PlanType,
+		BillingCycle: tenant.BillingCycle,
+		Status:       tenant.Status,
+		StartAt:      tenant.StartAt.Unix(),
+		CreatedAt:    tenant.CreatedAt.Unix(),
+		UpdatedAt:    tenant.UpdatedAt.Unix(),
+	}
+
+	if !tenant.EndAt.IsZero() {
+		resp.EndAt = tenant.EndAt.Unix()
+	}
+
+	return resp
+}
+
+func domainTenantKeysetToResponse(pager *domain.TenantKeysetResult) *SearchTenantsResponse {
+	if pager == nil {
+		return nil
+	}
+
+	items := make([]*TenantResponse, 0, len(pager.Items))
+	for _, tenant := range pager.Items {
+		items = append(items, domainTenantToResponse(tenant))
+	}
+
+	return &SearchTenantsResponse{
+		Items:      items,
+		PrevCursor: pager.PrevCursor,
+		NextCursor: pager.NextCursor,
+		HasPrev:    pager.HasPrev,
+		HasNext:    pager.HasNext,
+	}
+}
+
+func domainImpersonationToResponse(impersonation *domain.Impersonation) *ImpersonateResponse {
+	return &ImpersonateResponse{
+		AccessToken: impersonation.AccessToken,
+		ExpiresAt:   impersonation.ExpiresAt.Unix(),
+		ReadOnly:    true,
+	}
+}
+
+func domainStatsToResponse(stats *domain.Stats) *StatsResponse {
+	if stats == nil {
+		return nil
+	}
+
+	return &StatsResponse{
+		UserCount:          stats.UserCount,
+		NewUserCount7d:     stats.NewUserCount7d,
+		PlatformAdminCount: stats.PlatformAdminCount,
+		TenantCount:        stats.TenantCount,
+		TenantByStatus:     stats.TenantByStatus,
+		TenantByPlan:       stats.TenantByPlan,
+		ImgCount:           stats.ImgCount,
+		CommentCount:       stats.CommentCount,
+		LoginCount24h:      stats.LoginCount24h,
+	}
+}
+
+func domainAuditLogToResponse(log *domain.AuditLog) *AuditLogResponse {
+	if log == nil {
+		return nil
+	}
+
+	return &AuditLogResponse{
+		ID:         log.ID,
+		AdminID:    log.AdminID,
+		Action:     log.Action,
+		TargetType: log.TargetType,
+		TargetID:   log.TargetID,
+		Detail:     log.Detail,
+		IP:         log.IP,
+		CreatedAt:  log.CreatedAt.Unix(),
+	}
+}
+
+func domainAuditLogKeysetToResponse(pager *domain.AuditLogKeysetResult) *ListAuditLogsResponse {
+	if pager == nil {
+		return nil
+	}
+
+	items := make([]*AuditLogResponse, 0, len(pager.Items))
+	for _, log := range pager.Items {
+		items = append(items, domainAuditLogToResponse(log))
+	}
+
+	return &ListAuditLogsResponse{
+		Items:      items,
+		PrevCursor: pager.PrevCursor,
+		NextCursor: pager.NextCursor,
+		HasPrev:    pager.HasPrev,
+		HasNext:    pager.HasNext,
+	}
+}
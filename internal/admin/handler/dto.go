@@ -0,0 +1,128 @@
+package handler
+
+import "saas/internal/admin/domain"
+
+type SearchUsersRequest struct {
+	Keyword    string `form:"keyword"`
+	PageSize   int    `form:"page_size,default=10" binding:"min=5,max=50"`
+	PrevCursor string `form:"prev_cursor"`
+	NextCursor string `form:"next_cursor"`
+}
+
+type UserResponse struct {
+	ID              string `json:"id"`
+	Email           string `json:"email"`
+	Nickname        string `json:"nickname"`
+	Avatar          string `json:"avatar,omitempty"`
+	IsPlatformAdmin bool   `json:"is_platform_admin"`
+	TenantCount     int64  `json:"tenant_count,omitempty"`
+	CreatedAt       int64  `json:"created_at"`
+	LastLoginAt     int64  `json:"last_login_at"`
+}
+
+type SearchUsersResponse struct {
+	Items      []*UserResponse `json:"items"`
+	PrevCursor string          `json:"prev_cursor,omitempty"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+	HasPrev    bool            `json:"has_prev"`
+	HasNext    bool            `json:"has_next"`
+}
+
+type IDRequest struct {
+	ID string `json:"-" uri:"id" binding:"required,uuid"`
+}
+
+type SearchTenantsRequest struct {
+	Keyword    string              `form:"keyword"`
+	CreatorID  string              `form:"creator_id" binding:"omitempty,uuid"`
+	Status     domain.TenantStatus `form:"status" binding:"omitempty,oneof=active inactive suspended"`
+	PlanType   domain.PlanType     `form:"plan_type" binding:"omitempty,oneof=free care pro"`
+	PageSize   int                 `form:"page_size,default=10" binding:"min=5,max=50"`
+	PrevCursor string              `form:"prev_cursor"`
+	NextCursor string              `form:"next_cursor"`
+}
+
+type TenantResponse struct {
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	CreatorID    string              `json:"creator_id"`
+	CreatorEmail string              `json:"creator_email,omitempty"`
+	PlanType     domain.PlanType     `json:"plan_type"`
+	BillingCycle domain.BillingCycle `json:"billing_cycle"`
+	Status       domain.TenantStatus `json:"status"`
+	StartAt      int64               `json:"start_at"`
+	EndAt        int64               `json:"end_at,omitempty"`
+	CreatedAt    int64               `json:"created_at"`
+	UpdatedAt    int64               `json:"updated_at"`
+}
+
+type SearchTenantsResponse struct {
+	Items      []*TenantResponse `json:"items"`
+	PrevCursor string            `json:"prev_cursor,omitempty"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	HasPrev    bool              `json:"has_prev"`
+	HasNext    bool              `json:"has_next"`
+}
+
+type SuspendTenantRequest struct {
+	ID     string `json:"-" uri:"id" binding:"required,uuid"`
+	Reason string `json:"reason" binding:"required,max=200"`
+}
+
+type ChangePlanRequest struct {
+	ID           string              `json:"-" uri:"id" binding:"required,uuid"`
+	PlanType     domain.PlanType     `json:"plan_type" binding:"required,oneof=free care pro"`
+	BillingCycle domain.BillingCycle `json:"billing_cycle" binding:"required,oneof=monthly yearly lifetime"`
+	EndAt        int64               `json:"end_at" binding:"omitempty,min=0"` // unix秒 不传表示不设结束时间
+}
+
+type ImpersonateRequest struct {
+	ID     string `json:"-" uri:"id" binding:"required,uuid"`
+	Reason string `json:"reason" binding:"required,max=200"`
+}
+
+type ImpersonateResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresAt   int64  `json:"expires_at"`
+	ReadOnly    bool   `json:"read_only"`
+}
+
+type StatsResponse struct {
+	UserCount          int64                         `json:"user_count"`
+	NewUserCount7d     int64                         `json:"new_user_count_7d"`
+	PlatformAdminCount int64                         `json:"platform_admin_count"`
+	TenantCount        int64                         `json:"tenant_count"`
+	TenantByStatus     map[domain.TenantStatus]int64 `json:"tenant_by_status"`
+	TenantByPlan       map[domain.PlanType]int64     `json:"tenant_by_plan"`
+	ImgCount           int64                         `json:"img_count"`
+	CommentCount       int64                         `json:"comment_count"`
+	LoginCount24h      int64                         `json:"login_count_24h"`
+}
+
+type ListAuditLogsRequest struct {
+	AdminID    string `form:"admin_id" binding:"omitempty,uuid"`
+	TargetType string `form:"target_type" binding:"omitempty,oneof=user tenant"`
+	TargetID   string `form:"target_id" binding:"omitempty,uuid"`
+	PageSize   int    `form:"page_size,default=20" binding:"min=5,max=100"`
+	PrevCursor string `form:"prev_cursor"`
+	NextCursor string `form:"next_cursor"`
+}
+
+type AuditLogResponse struct {
+	ID         string         `json:"id"`
+	AdminID    string         `json:"admin_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	Detail     map[string]any `json:"detail,omitempty"`
+	IP         string         `json:"ip"`
+	CreatedAt  int64          `json:"created_at"`
+}
+
+type ListAuditLogsResponse struct {
+	Items      []*AuditLogResponse `json:"items"`
+	PrevCursor string              `json:"prev_cursor,omitempty"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+	HasPrev    bool                `json:"has_prev"`
+	HasNext    bool                `json:"has_next"`
+}
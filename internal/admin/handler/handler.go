@@ -0,0 +1,370 @@
+package handler
+
+import (
+	"saas/internal/admin/domain"
+	"saas/internal/common/reqkit/bind"
+	"saas/internal/common/reskit/response"
+	"saas/internal/common/server"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HttpHandler struct {
+	service domain.AdminService
+}
+
+func NewHttpHandler(service domain.AdminService) *HttpHandler {
+	return &HttpHandler{
+		service: service,
+	}
+}
+
+func getActor(ctx *gin.Context) (*domain.Actor, error) {
+	adminID, err := server.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Actor{
+		AdminID: adminID,
+		IP:      ctx.ClientIP(),
+	}, nil
+}
+
+// SearchUsers godoc
+// @Summary      搜索用户
+// @Description  按邮箱、昵称或用户id搜索平台用户
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request query handler.SearchUsersRequest false "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.SearchUsersResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/users [get]
+func (h *HttpHandler) SearchUsers(ctx *gin.Context) {
+	req := new(SearchUsersRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	data, err := h.service.SearchUsers(&domain.UserKeysetQuery{
+		Keyword:    req.Keyword,
+		PageSize:   req.PageSize,
+		PrevCursor: req.PrevCursor,
+		NextCursor: req.NextCursor,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserKeysetToResponse(data))
+}
+
+// GetUser godoc
+// @Summary      获取用户详情
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "用户id"
+// @Success      200  {object}  response.successResponse{data=handler.UserResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      404  {object}  response.errorResponse "用户不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/users/{id} [get]
+func (h *HttpHandler) GetUser(ctx *gin.Context) {
+	req := new(IDRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	user, err := h.service.GetUser(req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainUserToResponse(user))
+}
+
+// Impersonate godoc
+// @Summary      模拟登录用户
+// @Description  为技术支持签发目标用户的短期只读访问令牌 签发及后续每个请求均写入审计日志
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                        true  "用户id"
+// @Param        request  body      handler.ImpersonateRequest    true  "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.ImpersonateResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      404  {object}  response.errorResponse "用户不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/users/{id}/impersonate [post]
+func (h *HttpHandler) Impersonate(ctx *gin.Context) {
+	actor, err := getActor(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(ImpersonateRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	impersonation, err := h.service.Impersonate(actor, req.ID, req.Reason)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImpersonationToResponse(impersonation))
+}
+
+// SearchTenants godoc
+// @Summary      搜索租户
+// @Description  按名称或id搜索租户 可按创建者、状态、计划过滤
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request query handler.SearchTenantsRequest false "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.SearchTenantsResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/tenants [get]
+func (h *HttpHandler) SearchTenants(ctx *gin.Context) {
+	req := new(SearchTenantsRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	data, err := h.service.SearchTenants(&domain.TenantKeysetQuery{
+		Keyword:    req.Keyword,
+		CreatorID:  req.CreatorID,
+		Status:     req.Status,
+		PlanType:   req.PlanType,
+		PageSize:   req.PageSize,
+		PrevCursor: req.PrevCursor,
+		NextCursor: req.NextCursor,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainTenantKeysetToResponse(data))
+}
+
+// GetTenant godoc
+// @Summary      获取租户详情
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "租户id"
+// @Success      200  {object}  response.successResponse{data=handler.TenantResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      404  {object}  response.errorResponse "租户不存在"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/tenants/{id} [get]
+func (h *HttpHandler) GetTenant(ctx *gin.Context) {
+	req := new(IDRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	tenant, err := h.service.GetTenant(req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainTenantToResponse(tenant))
+}
+
+// SuspendTenant godoc
+// @Summary      封禁租户
+// @Description  封禁后租户创建者无法再操作该租户下的资源
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                          true  "租户id"
+// @Param        request  body      handler.SuspendTenantRequest    true  "请求参数"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      404  {object}  response.errorResponse "租户不存在"
+// @Failure      409  {object}  response.errorResponse "租户已处于封禁状态"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/tenants/{id}/suspend [put]
+func (h *HttpHandler) SuspendTenant(ctx *gin.Context) {
+	actor, err := getActor(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(SuspendTenantRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.SuspendTenant(actor, req.ID, req.Reason); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ReactivateTenant godoc
+// @Summary      解封租户
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "租户id"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      404  {object}  response.errorResponse "租户不存在"
+// @Failure      409  {object}  response.errorResponse "租户未处于封禁状态"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/tenants/{id}/reactivate [put]
+func (h *HttpHandler) ReactivateTenant(ctx *gin.Context) {
+	actor, err := getActor(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(IDRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.ReactivateTenant(actor, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ChangeTenantPlan godoc
+// @Summary      调整租户计划
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                       true  "租户id"
+// @Param        request  body      handler.ChangePlanRequest    true  "请求参数"
+// @Success      200  {object}  response.successResponse "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      404  {object}  response.errorResponse "租户不存在"
+// @Failure      409  {object}  response.errorResponse "该用户已拥有此类型的计划"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/tenants/{id}/plan [put]
+func (h *HttpHandler) ChangeTenantPlan(ctx *gin.Context) {
+	actor, err := getActor(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(ChangePlanRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	change := &domain.PlanChange{
+		PlanType:     req.PlanType,
+		BillingCycle: req.BillingCycle,
+	}
+	if req.EndAt > 0 {
+		change.EndAt = time.Unix(req.EndAt, 0)
+	}
+
+	if err := h.service.ChangeTenantPlan(actor, req.ID, change); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// GetStats godoc
+// @Summary      平台全局统计
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  response.successResponse{data=handler.StatsResponse} "请求成功"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/stats [get]
+func (h *HttpHandler) GetStats(ctx *gin.Context) {
+	stats, err := h.service.GetStats()
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainStatsToResponse(stats))
+}
+
+// ListAuditLogs godoc
+// @Summary      平台审计日志
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request query handler.ListAuditLogsRequest false "请求参数"
+// @Success      200  {object}  response.successResponse{data=handler.ListAuditLogsResponse} "请求成功"
+// @Failure      400  {object}  response.invalidParamsResponse "参数错误"
+// @Failure      403  {object}  response.errorResponse "需要平台管理员权限"
+// @Failure      500  {object}  response.errorResponse "服务器错误"
+// @Router       /v1/admin/audit_logs [get]
+func (h *HttpHandler) ListAuditLogs(ctx *gin.Context) {
+	req := new(ListAuditLogsRequest)
+
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	data, err := h.service.ListAuditLogs(&domain.AuditLogKeysetQuery{
+		AdminID:    req.AdminID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		PageSize:   req.PageSize,
+		PrevCursor: req.PrevCursor,
+		NextCursor: req.NextCursor,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainAuditLogKeysetToResponse(data))
+}
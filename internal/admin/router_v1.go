@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"saas/internal/admin/handler"
+	"saas/internal/common/middleware/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
+	// 平台管理 仅允许平台管理员的登录会话访问
+	g := r.Group("/v1/admin", auth.JWTValidate(), auth.SessionOnly(), auth.PlatformAdminValidate())
+	{
+		// 用户
+		g.GET("/users", handler.SearchUsers)
+		g.GET("/users/:id", handler.GetUser)
+		g.POST("/users/:id/impersonate", handler.Impersonate)
+
+		// 租户
+		g.GET("/tenants", handler.SearchTenants)
+		g.GET("/tenants/:id", handler.GetTenant)
+		g.PUT("/tenants/:id/suspend", handler.SuspendTenant)
+		g.PUT("/tenants/:id/reactivate", handler.ReactivateTenant)
+		g.PUT("/tenants/:id/plan", handler.ChangeTenantPlan)
+
+		// 统计与审计
+		g.GET("/stats", handler.GetStats)
+		g.GET("/audit_logs", handler.ListAuditLogs)
+	}
+
+	return nil
+}
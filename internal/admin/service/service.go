@@ -0,0 +1,176 @@
+package service
+
+import (
+	"saas/internal/admin/domain"
+	"saas/internal/common/reskit/codes"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type service struct {
+	repo        domain.AdminRepository
+	tokenIssuer domain.TokenIssuer
+}
+
+func NewAdminService(repo domain.AdminRepository, tokenIssuer domain.TokenIssuer) domain.AdminService {
+	return &service{
+		repo:        repo,
+		tokenIssuer: tokenIssuer,
+	}
+}
+
+func (s *service) SearchUsers(query *domain.UserKeysetQuery) (*domain.UserKeysetResult, error) {
+	return s.repo.ListUsersByKeyset(query)
+}
+
+func (s *service) GetUser(id string) (*domain.User, error) {
+	return s.repo.GetUser(id)
+}
+
+func (s *service) SearchTenants(query *domain.TenantKeysetQuery) (*domain.TenantKeysetResult, error) {
+	return s.repo.ListTenantsByKeyset(query)
+}
+
+func (s *service) GetTenant(id string) (*domain.Tenant, error) {
+	return s.repo.GetTenant(id)
+}
+
+func (s *service) SuspendTenant(actor *domain.Actor, id, reason string) error {
+	tenant, err := s.repo.GetTenant(id)
+	if err != nil {
+		return err
+	}
+
+	if tenant.Status == domain.TenantSuspendedStatus {
+		return codes.ErrAdminTenantAlreadySuspended
+	}
+
+	if err := s.repo.UpdateTenantStatus(id, domain.TenantSuspendedStatus); err != nil {
+		return err
+	}
+
+	s.audit(actor, domain.AuditActionSuspendTenant, domain.AuditTargetTenant, id, map[string]any{
+		"reason":      reason,
+		"prev_status": tenant.Status,
+	})
+
+	return nil
+}
+
+func (s *service) ReactivateTenant(actor *domain.Actor, id string) error {
+	tenant, err := s.repo.GetTenant(id)
+	if err != nil {
+		return err
+	}
+
+	if tenant.Status != domain.TenantSuspendedStatus {
+		return codes.ErrAdminTenantNotSuspended
+	}
+
+	if err := s.repo.UpdateTenantStatus(id, domain.TenantActiveStatus); err != nil {
+		return err
+	}
+
+	s.audit(actor, domain.AuditActionReactivateTenant, domain.AuditTargetTenant, id, nil)
+
+	return nil
+}
+
+func (s *service) ChangeTenantPlan(actor *domain.Actor, id string, change *domain.PlanChange) error {
+	tenant, err := s.repo.GetTenant(id)
+	if err != nil {
+		return err
+	}
+
+	// 与用户自助创建保持一致 每个用户仅能拥有一个 Free/Care
+	if change.PlanType == domain.PlanFreeType || change.PlanType == domain.PlanCareType {
+		exist, err := s.repo.CreatorHasPlan(tenant.CreatorID, change.PlanType, id)
+		if err != nil {
+			return errors.WithMessage(err, "检查用户已有计划失败")
+		}
+		if exist {
+			return codes.ErrAdminPlanUserLimit
+		}
+	}
+
+	if err := s.repo.UpdateTenantPlan(id, change); err != nil {
+		return err
+	}
+
+	detail := map[string]any{
+		"prev_plan_type":     tenant.PlanType,
+		"prev_billing_cycle": tenant.BillingCycle,
+		"plan_type":          change.PlanType,
+		"billing_cycle":      change.BillingCycle,
+	}
+	if !change.EndAt.IsZero() {
+		detail["end_at"] = change.EndAt.Unix()
+	}
+	s.audit(actor, domain.AuditActionChangePlan, domain.AuditTargetTenant, id, detail)
+
+	return nil
+}
+
+func (s *service) Impersonate(actor *domain.Actor, userID, reason string) (*domain.Impersonation, error) {
+	user, err := s.repo.GetUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 避免借模拟登录横向获取其他管理员的权限
+	if user.IsPlatformAdmin {
+		return nil, codes.ErrImpersonateAdmin
+	}
+
+	impersonation, err := s.tokenIssuer.IssueImpersonationToken(userID, actor.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 模拟登录必须留痕 审计写入失败时不签发令牌
+	if err := s.repo.CreateAuditLog(&domain.AuditLog{
+		AdminID:    actor.AdminID,
+		Action:     domain.AuditActionImpersonate,
+		TargetType: domain.AuditTargetUser,
+		TargetID:   userID,
+		Detail: map[string]any{
+			"reason":     reason,
+			"expires_at": impersonation.ExpiresAt.Unix(),
+		},
+		IP: actor.IP,
+	}); err != nil {
+		return nil, err
+	}
+
+	return impersonation, nil
+}
+
+func (s *service) GetStats() (*domain.Stats, error) {
+	now := time.Now()
+	return s.repo.GetStats(now.AddDate(0, 0, -7), now.Add(-24*time.Hour))
+}
+
+func (s *service) ListAuditLogs(query *domain.AuditLogKeysetQuery) (*domain.AuditLogKeysetResult, error) {
+	return s.repo.ListAuditLogsByKeyset(query)
+}
+
+// audit 记录管理操作 写入失败不回滚已完成的操作 仅记录日志
+func (s *service) audit(actor *domain.Actor, action, targetType, targetID string, detail map[string]any) {
+	if err := s.repo.CreateAuditLog(&domain.AuditLog{
+		AdminID:    actor.AdminID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Detail:     detail,
+		IP:         actor.IP,
+	}); err != nil {
+		zap.L().Error("写入平台审计日志失败",
+			zap.String("admin_id", actor.AdminID),
+			zap.String("action", action),
+			zap.String("target_id", targetID),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,25 @@
+//go:build wireinject
+// +build wireinject
+
+package admin
+
+import (
+	"saas/internal/admin/adapters"
+	"saas/internal/admin/handler"
+	"saas/internal/admin/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+)
+
+func InitV1(r *gin.RouterGroup) func() {
+	wire.Build(
+		RegisterV1,
+		handler.NewHttpHandler,
+		service.NewAdminService,
+		adapters.NewAdminPSQLRepository,
+		adapters.NewJWTTokenIssuer,
+	)
+
+	return nil
+}
@@ -0,0 +1,25 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+	"saas/internal/admin/adapters"
+	"saas/internal/admin/handler"
+	"saas/internal/admin/service"
+)
+
+// Injectors from wire.go:
+
+func InitV1(r *gin.RouterGroup) func() {
+	adminRepository := adapters.NewAdminPSQLRepository()
+	tokenIssuer := adapters.NewJWTTokenIssuer()
+	adminService := service.NewAdminService(adminRepository, tokenIssuer)
+	httpHandler := handler.NewHttpHandler(adminService)
+	v := RegisterV1(r, httpHandler)
+	return v
+}
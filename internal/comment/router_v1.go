@@ -25,12 +25,12 @@ func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 	{
 		// 访客 获取评论
 		// 获取根评论
-		g.GET("/:belong_key/roots", auth.OptionalJWTValidate(), handler.ListRoots)
+		g.GET("/:belong_key/roots", auth.OptionalJWTValidate(), auth.TenantActiveValidate(), handler.ListRoots)
 		// 根据根评论去获取其树下评论
-		g.GET("/:belong_key/:root_id/replies", auth.OptionalJWTValidate(), handler.ListReplies)
+		g.GET("/:belong_key/:root_id/replies", auth.OptionalJWTValidate(), auth.TenantActiveValidate(), handler.ListReplies)
 	}
 
-	protect := g.Group("", auth.JWTValidate())
+	protect := g.Group("", auth.JWTValidate(), auth.RequireScope("comment"), auth.TenantActiveValidate())
 	{
 		// 创建评论
 		protect.POST("/:belong_key", handler.Create)
@@ -42,7 +42,7 @@ func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 	}
 
 	// 仅租户创建者可访问的路由
-	creatorOnly := g.Group("", auth.JWTValidate(), auth.TenantCreatorValited())
+	creatorOnly := g.Group("", auth.JWTValidate(), auth.RequireScope("comment"), auth.TenantCreatorValited())
 	{
 		// 管理员
 		// 审计
@@ -9,8 +9,10 @@ import (
 	useradapter "saas/internal/user/adapters"
 	userdomain "saas/internal/user/domain"
 	userService "saas/internal/user/service"
+	"slices"
 	"strings"
 
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/casbin/casbin/v2"
 	"github.com/pkg/errors"
 
@@ -19,6 +21,8 @@ import (
 
 var tokenServer userdomain.TokenService
 
+var patServer userdomain.PATService
+
 var enforcer *casbin.Enforcer
 
 func Init() {
@@ -26,6 +30,7 @@ func Init() {
 	tokenCache := useradapter.NewTokenRedisCache()
 	userRepo := useradapter.NewUserPSQLRepository()
 	tokenServer = userService.NewTokenService(tokenCache, userRepo)
+	patServer = userService.NewPATService(useradapter.NewPATPSQLRepository())
 
 }
 
@@ -48,6 +53,58 @@ func parseTokenFromHeader(c *gin.Context) (string, error) {
 	return strings.TrimPrefix(authHeader, bearerPrefix), nil
 }
 
+// 校验令牌并将认证信息写入上下文 JWTValidate 与 OptionalJWTValidate 共用
+func authenticate(c *gin.Context, tokenStr string) error {
+	// 个人访问令牌
+	if strings.HasPrefix(tokenStr, userdomain.PATPrefix) {
+		token, err := patServer.Validate(tokenStr)
+		if err != nil {
+			return err
+		}
+
+		c.Set(server.UserIDKey, token.UserID)
+		c.Set(patScopesKey, token.Scopes)
+		return nil
+	}
+
+	// 验证token
+	isExpire, err := tokenServer.ValidateAccessToken(tokenStr)
+	if err != nil {
+		if isExpire {
+			return codes.ErrTokenExpired
+		}
+		return codes.ErrTokenInvalid
+	}
+
+	// 解析 Token
+	payload, err := tokenServer.ParseAccessToken(tokenStr)
+	if err != nil {
+		return codes.ErrTokenInvalid
+	}
+
+	c.Set(server.UserIDKey, payload.UserID)
+	if payload.ImpersonatorID != "" {
+		c.Set(server.ImpersonatorIDKey, payload.ImpersonatorID)
+	}
+	return nil
+}
+
+// 模拟登录令牌 仅允许只读操作并记录审计
+func guardImpersonation(c *gin.Context) error {
+	impersonatorID, ok := server.GetImpersonatorID(c)
+	if !ok {
+		return nil
+	}
+
+	if !isReadOnlyMethod(c.Request.Method) {
+		return codes.ErrImpersonateReadOnly
+	}
+
+	userID, _ := server.GetUserID(c)
+	auditImpersonatedRequest(c, impersonatorID, userID)
+	return nil
+}
+
 func JWTValidate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. 从请求头解析 Token
@@ -57,31 +114,22 @@ func JWTValidate() gin.HandlerFunc {
 			return
 		}
 
-		// 2. 验证token
-		isExpire, err := tokenServer.ValidateAccessToken(tokenStr)
-		if err != nil {
-			if isExpire {
-				response.Error(c, codes.ErrTokenExpired)
-			} else {
-				response.Error(c, codes.ErrTokenInvalid)
-			}
+		// 2. 校验 Token 并写入上下文
+		if err := authenticate(c, tokenStr); err != nil {
+			response.Error(c, err)
 			return
 		}
 
-		// 3. 解析 Token
-		payload, err := tokenServer.ParseAccessToken(tokenStr)
-		if err != nil {
-			response.Error(c, codes.ErrTokenInvalid)
+		if err := guardImpersonation(c); err != nil {
+			response.Error(c, err)
 			return
 		}
 
-		// 3. 将用户 相关信息存入上下文
-		c.Set(server.UserIDKey, payload.UserID)
-
 		c.Next()
 	}
 }
 
+// OptionalJWTValidate 携带有效令牌时与 JWTValidate 行为一致 未携带或令牌无效时按访客处理
 func OptionalJWTValidate() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. 从请求头解析 Token
@@ -91,22 +139,61 @@ func OptionalJWTValidate() gin.HandlerFunc {
 			return
 		}
 
-		// 2. 验证token
-		_, err = tokenServer.ValidateAccessToken(tokenStr)
-		if err != nil {
+		// 2. 令牌无效时按访客处理
+		if err := authenticate(c, tokenStr); err != nil {
 			c.Next()
 			return
 		}
 
-		// 3. 解析 Token
-		payload, err := tokenServer.ParseAccessToken(tokenStr)
-		if err != nil {
+		// 3. 模拟登录的限制与审计同样生效
+		if err := guardImpersonation(c); err != nil {
+			response.Error(c, err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// 使用个人访问令牌认证时 上下文中存放其权限范围
+const patScopesKey = "pat_scopes"
+
+// RequireScope 校验个人访问令牌的权限范围
+// 只读请求需要 resource:read 其余请求需要 resource:write 登录会话不受限制
+func RequireScope(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, exist := c.Get(patScopesKey)
+		if !exist {
 			c.Next()
 			return
 		}
 
-		// 3. 将用户 相关信息存入上下文
-		c.Set(server.UserIDKey, payload.UserID)
+		scopes, _ := value.([]userdomain.TokenScope)
+
+		action := "write"
+		if isReadOnlyMethod(c.Request.Method) {
+			action = "read"
+		}
+		required := userdomain.TokenScope(resource + ":" + action)
+
+		if !slices.Contains(scopes, required) {
+			response.Error(c, codes.ErrPATScopeDenied.WithDetail(map[string]any{
+				"required": required,
+			}))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// SessionOnly 仅允许登录会话访问 拒绝个人访问令牌
+func SessionOnly() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, exist := c.Get(patScopesKey); exist {
+			response.Error(c, codes.ErrPATSessionRequired)
+			return
+		}
 
 		c.Next()
 	}
@@ -131,12 +218,47 @@ func TenantCreatorValited() gin.HandlerFunc {
 		}
 
 		// 检测用户是否为该租户的创建者
-		exist, err := orm.Tenants(
+		tenant, err := orm.Tenants(
+			qm.Select(orm.TenantColumns.Status),
 			orm.TenantWhere.ID.EQ(tenantID),
 			orm.TenantWhere.CreatorID.EQ(userID),
-		).ExistsG()
-		if err != nil || !exist {
+		).OneG()
+		if err != nil {
 			response.Error(ctx, codes.ErrTenantNotCreator)
+			return
+		}
+
+		// 被平台封禁的租户不可继续操作
+		if tenant.Status == orm.TenantStatusSuspended {
+			response.Error(ctx, codes.ErrTenantSuspended)
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// TenantActiveValidate 拒绝访问已被平台封禁的租户 用于访客也可访问的租户路由
+func TenantActiveValidate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		tenantID, err := server.GetTenantID(ctx)
+		if err != nil {
+			response.Error(ctx, err)
+			return
+		}
+
+		tenant, err := orm.Tenants(
+			qm.Select(orm.TenantColumns.Status),
+			orm.TenantWhere.ID.EQ(tenantID),
+		).OneG()
+		if err != nil {
+			response.Error(ctx, codes.ErrTenantNotFound)
+			return
+		}
+
+		if tenant.Status == orm.TenantStatusSuspended {
+			response.Error(ctx, codes.ErrTenantSuspended)
+			return
 		}
 
 		ctx.Next()
@@ -164,12 +286,20 @@ func CasbinValited() gin.HandlerFunc {
 		}
 
 		// 检测用户是否为该租户的创建者
-		exist, err := orm.Tenants(
+		tenant, err := orm.Tenants(
+			qm.Select(orm.TenantColumns.Status),
 			orm.TenantWhere.ID.EQ(tenantID),
 			orm.TenantWhere.CreatorID.EQ(userID),
-		).ExistsG()
-		if err != nil || !exist {
+		).OneG()
+		if err != nil {
 			response.Error(ctx, codes.ErrTenantNotCreator)
+			return
+		}
+
+		// 被平台封禁的租户不可继续操作
+		if tenant.Status == orm.TenantStatusSuspended {
+			response.Error(ctx, codes.ErrTenantSuspended)
+			return
 		}
 
 		ctx.Next()
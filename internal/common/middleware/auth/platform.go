@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"saas/internal/common/orm"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/common/reskit/response"
+	"saas/internal/common/server"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// PlatformAdminValidate 仅允许平台管理员访问 需在 JWTValidate 之后使用
+func PlatformAdminValidate() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		userID, err := server.GetUserID(ctx)
+		if err != nil {
+			response.Error(ctx, codes.ErrUnauthorized)
+			return
+		}
+
+		// 模拟登录的会话不具备平台权限
+		if _, ok := server.GetImpersonatorID(ctx); ok {
+			response.Error(ctx, codes.ErrPlatformAdminRequired)
+			return
+		}
+
+		exist, err := orm.Users(
+			orm.UserWhere.ID.EQ(userID),
+			orm.UserWhere.IsPlatformAdmin.EQ(true),
+		).ExistsG()
+		if err != nil || !exist {
+			response.Error(ctx, codes.ErrPlatformAdminRequired)
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// 模拟登录期间的每个请求都写入审计日志
+func auditImpersonatedRequest(ctx *gin.Context, adminID, userID string) {
+	detail, _ := json.Marshal(map[string]any{
+		"method": ctx.Request.Method,
+		"path":   ctx.Request.URL.Path,
+	})
+
+	log := &orm.PlatformAuditLog{
+		AdminID:    adminID,
+		Action:     "impersonated_request",
+		TargetType: "user",
+		TargetID:   userID,
+		Detail:     null.JSONFrom(detail),
+		IP:         ctx.ClientIP(),
+	}
+
+	if err := log.InsertG(boil.Infer()); err != nil {
+		zap.L().Error("写入模拟登录审计日志失败",
+			zap.String("admin_id", adminID),
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+	}
+}
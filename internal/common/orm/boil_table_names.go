@@ -9,10 +9,27 @@ var TableNames = struct {
 	CommentPlates        string
 	CommentTenantConfigs string
 	Comments             string
+	ImgAlbumItems        string
+	ImgAlbums            string
 	ImgCategories        string
+	ImgCategoryJobs      string
+	ImgExportJobs        string
+	ImgImportItems       string
+	ImgImportJobs        string
+	ImgStorageMigrations string
+	ImgStorageUsages     string
+	ImgTagAssignments    string
+	ImgTags              string
+	ImgVariants          string
+	ImgWatermarkSettings string
 	Imgs                 string
-	TenantR2Configs      string
+	PersonalAccessTokens string
+	PlatformAuditLogs    string
+	TenantImgSettings    string
+	TenantStorageConfigs string
 	Tenants              string
+	UserIdentities       string
+	UserLoginEvents      string
 	Users                string
 }{
 	CommentLikes:         "comment_likes",
@@ -20,9 +37,26 @@ var TableNames = struct {
 	CommentPlates:        "comment_plates",
 	CommentTenantConfigs: "comment_tenant_configs",
 	Comments:             "comments",
+	ImgAlbumItems:        "img_album_items",
+	ImgAlbums:            "img_albums",
 	ImgCategories:        "img_categories",
+	ImgCategoryJobs:      "img_category_jobs",
+	ImgExportJobs:        "img_export_jobs",
+	ImgImportItems:       "img_import_items",
+	ImgImportJobs:        "img_import_jobs",
+	ImgStorageMigrations: "img_storage_migrations",
+	ImgStorageUsages:     "img_storage_usages",
+	ImgTagAssignments:    "img_tag_assignments",
+	ImgTags:              "img_tags",
+	ImgVariants:          "img_variants",
+	ImgWatermarkSettings: "img_watermark_settings",
 	Imgs:                 "imgs",
-	TenantR2Configs:      "tenant_r2_configs",
+	PersonalAccessTokens: "personal_access_tokens",
+	PlatformAuditLogs:    "platform_audit_logs",
+	TenantImgSettings:    "tenant_img_settings",
+	TenantStorageConfigs: "tenant_storage_configs",
 	Tenants:              "tenants",
+	UserIdentities:       "user_identities",
+	UserLoginEvents:      "user_login_events",
 	Users:                "users",
 }
@@ -91,6 +91,398 @@ func (e CommentStatus) Ordinal() int {
 	}
 }
 
+type ImgCategoryJobKind string
+
+// Enum values for ImgCategoryJobKind
+const (
+	ImgCategoryJobKindRename     ImgCategoryJobKind = "rename"
+	ImgCategoryJobKindDeleteMove ImgCategoryJobKind = "delete_move"
+)
+
+func AllImgCategoryJobKind() []ImgCategoryJobKind {
+	return []ImgCategoryJobKind{
+		ImgCategoryJobKindRename,
+		ImgCategoryJobKindDeleteMove,
+	}
+}
+
+func (e ImgCategoryJobKind) IsValid() error {
+	switch e {
+	case ImgCategoryJobKindRename, ImgCategoryJobKindDeleteMove:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgCategoryJobKind) String() string {
+	return string(e)
+}
+
+func (e ImgCategoryJobKind) Ordinal() int {
+	switch e {
+	case ImgCategoryJobKindRename:
+		return 0
+	case ImgCategoryJobKindDeleteMove:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ImgJobStatus string
+
+// Enum values for ImgJobStatus
+const (
+	ImgJobStatusPending   ImgJobStatus = "pending"
+	ImgJobStatusRunning   ImgJobStatus = "running"
+	ImgJobStatusSucceeded ImgJobStatus = "succeeded"
+	ImgJobStatusFailed    ImgJobStatus = "failed"
+)
+
+func AllImgJobStatus() []ImgJobStatus {
+	return []ImgJobStatus{
+		ImgJobStatusPending,
+		ImgJobStatusRunning,
+		ImgJobStatusSucceeded,
+		ImgJobStatusFailed,
+	}
+}
+
+func (e ImgJobStatus) IsValid() error {
+	switch e {
+	case ImgJobStatusPending, ImgJobStatusRunning, ImgJobStatusSucceeded, ImgJobStatusFailed:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgJobStatus) String() string {
+	return string(e)
+}
+
+func (e ImgJobStatus) Ordinal() int {
+	switch e {
+	case ImgJobStatusPending:
+		return 0
+	case ImgJobStatusRunning:
+		return 1
+	case ImgJobStatusSucceeded:
+		return 2
+	case ImgJobStatusFailed:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ImgImportSource string
+
+// Enum values for ImgImportSource
+const (
+	ImgImportSourceUrls ImgImportSource = "urls"
+	ImgImportSourceZip  ImgImportSource = "zip"
+)
+
+func AllImgImportSource() []ImgImportSource {
+	return []ImgImportSource{
+		ImgImportSourceUrls,
+		ImgImportSourceZip,
+	}
+}
+
+func (e ImgImportSource) IsValid() error {
+	switch e {
+	case ImgImportSourceUrls, ImgImportSourceZip:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgImportSource) String() string {
+	return string(e)
+}
+
+func (e ImgImportSource) Ordinal() int {
+	switch e {
+	case ImgImportSourceUrls:
+		return 0
+	case ImgImportSourceZip:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type StorageProvider string
+
+// Enum values for StorageProvider
+const (
+	StorageProviderR2    StorageProvider = "r2"
+	StorageProviderS3    StorageProvider = "s3"
+	StorageProviderLocal StorageProvider = "local"
+)
+
+func AllStorageProvider() []StorageProvider {
+	return []StorageProvider{
+		StorageProviderR2,
+		StorageProviderS3,
+		StorageProviderLocal,
+	}
+}
+
+func (e StorageProvider) IsValid() error {
+	switch e {
+	case StorageProviderR2, StorageProviderS3, StorageProviderLocal:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e StorageProvider) String() string {
+	return string(e)
+}
+
+func (e StorageProvider) Ordinal() int {
+	switch e {
+	case StorageProviderR2:
+		return 0
+	case StorageProviderS3:
+		return 1
+	case StorageProviderLocal:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ImgBucketKind string
+
+// Enum values for ImgBucketKind
+const (
+	ImgBucketKindPublic ImgBucketKind = "public"
+	ImgBucketKindDelete ImgBucketKind = "delete"
+)
+
+func AllImgBucketKind() []ImgBucketKind {
+	return []ImgBucketKind{
+		ImgBucketKindPublic,
+		ImgBucketKindDelete,
+	}
+}
+
+func (e ImgBucketKind) IsValid() error {
+	switch e {
+	case ImgBucketKindPublic, ImgBucketKindDelete:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgBucketKind) String() string {
+	return string(e)
+}
+
+func (e ImgBucketKind) Ordinal() int {
+	switch e {
+	case ImgBucketKindPublic:
+		return 0
+	case ImgBucketKindDelete:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ImgWatermarkKind string
+
+// Enum values for ImgWatermarkKind
+const (
+	ImgWatermarkKindText  ImgWatermarkKind = "text"
+	ImgWatermarkKindImage ImgWatermarkKind = "image"
+)
+
+func AllImgWatermarkKind() []ImgWatermarkKind {
+	return []ImgWatermarkKind{
+		ImgWatermarkKindText,
+		ImgWatermarkKindImage,
+	}
+}
+
+func (e ImgWatermarkKind) IsValid() error {
+	switch e {
+	case ImgWatermarkKindText, ImgWatermarkKindImage:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgWatermarkKind) String() string {
+	return string(e)
+}
+
+func (e ImgWatermarkKind) Ordinal() int {
+	switch e {
+	case ImgWatermarkKindText:
+		return 0
+	case ImgWatermarkKindImage:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ImgWatermarkPosition string
+
+// Enum values for ImgWatermarkPosition
+const (
+	ImgWatermarkPositionTopLeft     ImgWatermarkPosition = "top_left"
+	ImgWatermarkPositionTopRight    ImgWatermarkPosition = "top_right"
+	ImgWatermarkPositionBottomLeft  ImgWatermarkPosition = "bottom_left"
+	ImgWatermarkPositionBottomRight ImgWatermarkPosition = "bottom_right"
+	ImgWatermarkPositionCenter      ImgWatermarkPosition = "center"
+)
+
+func AllImgWatermarkPosition() []ImgWatermarkPosition {
+	return []ImgWatermarkPosition{
+		ImgWatermarkPositionTopLeft,
+		ImgWatermarkPositionTopRight,
+		ImgWatermarkPositionBottomLeft,
+		ImgWatermarkPositionBottomRight,
+		ImgWatermarkPositionCenter,
+	}
+}
+
+func (e ImgWatermarkPosition) IsValid() error {
+	switch e {
+	case ImgWatermarkPositionTopLeft, ImgWatermarkPositionTopRight, ImgWatermarkPositionBottomLeft, ImgWatermarkPositionBottomRight, ImgWatermarkPositionCenter:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgWatermarkPosition) String() string {
+	return string(e)
+}
+
+func (e ImgWatermarkPosition) Ordinal() int {
+	switch e {
+	case ImgWatermarkPositionTopLeft:
+		return 0
+	case ImgWatermarkPositionTopRight:
+		return 1
+	case ImgWatermarkPositionBottomLeft:
+		return 2
+	case ImgWatermarkPositionBottomRight:
+		return 3
+	case ImgWatermarkPositionCenter:
+		return 4
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ImgOutputFormat string
+
+// Enum values for ImgOutputFormat
+const (
+	ImgOutputFormatOriginal ImgOutputFormat = "original"
+	ImgOutputFormatWebp     ImgOutputFormat = "webp"
+	ImgOutputFormatJpeg     ImgOutputFormat = "jpeg"
+)
+
+func AllImgOutputFormat() []ImgOutputFormat {
+	return []ImgOutputFormat{
+		ImgOutputFormatOriginal,
+		ImgOutputFormatWebp,
+		ImgOutputFormatJpeg,
+	}
+}
+
+func (e ImgOutputFormat) IsValid() error {
+	switch e {
+	case ImgOutputFormatOriginal, ImgOutputFormatWebp, ImgOutputFormatJpeg:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgOutputFormat) String() string {
+	return string(e)
+}
+
+func (e ImgOutputFormat) Ordinal() int {
+	switch e {
+	case ImgOutputFormatOriginal:
+		return 0
+	case ImgOutputFormatWebp:
+		return 1
+	case ImgOutputFormatJpeg:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ImgDedupMode string
+
+// Enum values for ImgDedupMode
+const (
+	ImgDedupModeOff   ImgDedupMode = "off"
+	ImgDedupModeReuse ImgDedupMode = "reuse"
+	ImgDedupModeLink  ImgDedupMode = "link"
+)
+
+func AllImgDedupMode() []ImgDedupMode {
+	return []ImgDedupMode{
+		ImgDedupModeOff,
+		ImgDedupModeReuse,
+		ImgDedupModeLink,
+	}
+}
+
+func (e ImgDedupMode) IsValid() error {
+	switch e {
+	case ImgDedupModeOff, ImgDedupModeReuse, ImgDedupModeLink:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ImgDedupMode) String() string {
+	return string(e)
+}
+
+func (e ImgDedupMode) Ordinal() int {
+	switch e {
+	case ImgDedupModeOff:
+		return 0
+	case ImgDedupModeReuse:
+		return 1
+	case ImgDedupModeLink:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
 type TenantPlanType string
 
 // Enum values for TenantPlanType
@@ -139,20 +531,22 @@ type TenantStatus string
 
 // Enum values for TenantStatus
 const (
-	TenantStatusActive   TenantStatus = "active"
-	TenantStatusInactive TenantStatus = "inactive"
+	TenantStatusActive    TenantStatus = "active"
+	TenantStatusInactive  TenantStatus = "inactive"
+	TenantStatusSuspended TenantStatus = "suspended"
 )
 
 func AllTenantStatus() []TenantStatus {
 	return []TenantStatus{
 		TenantStatusActive,
 		TenantStatusInactive,
+		TenantStatusSuspended,
 	}
 }
 
 func (e TenantStatus) IsValid() error {
 	switch e {
-	case TenantStatusActive, TenantStatusInactive:
+	case TenantStatusActive, TenantStatusInactive, TenantStatusSuspended:
 		return nil
 	default:
 		return errors.New("enum is not valid")
@@ -169,6 +563,8 @@ func (e TenantStatus) Ordinal() int {
 		return 0
 	case TenantStatusInactive:
 		return 1
+	case TenantStatusSuspended:
+		return 2
 
 	default:
 		panic(errors.New("enum is not valid"))
@@ -12,6 +12,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -22,11 +23,14 @@ import (
 
 // ImgCategory is an object representing the database table.
 type ImgCategory struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	TenantID  string    `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
-	Title     string    `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Prefix    string    `boil:"prefix" json:"prefix" toml:"prefix" yaml:"prefix"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TenantID  string      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	ParentID  null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	TreePath  string      `boil:"tree_path" json:"tree_path" toml:"tree_path" yaml:"tree_path"`
+	Depth     int         `boil:"depth" json:"depth" toml:"depth" yaml:"depth"`
+	Title     string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Prefix    string      `boil:"prefix" json:"prefix" toml:"prefix" yaml:"prefix"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 
 	R *imgCategoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L imgCategoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -35,12 +39,18 @@ type ImgCategory struct {
 var ImgCategoryColumns = struct {
 	ID        string
 	TenantID  string
+	ParentID  string
+	TreePath  string
+	Depth     string
 	Title     string
 	Prefix    string
 	CreatedAt string
 }{
 	ID:        "id",
 	TenantID:  "tenant_id",
+	ParentID:  "parent_id",
+	TreePath:  "tree_path",
+	Depth:     "depth",
 	Title:     "title",
 	Prefix:    "prefix",
 	CreatedAt: "created_at",
@@ -49,12 +59,18 @@ var ImgCategoryColumns = struct {
 var ImgCategoryTableColumns = struct {
 	ID        string
 	TenantID  string
+	ParentID  string
+	TreePath  string
+	Depth     string
 	Title     string
 	Prefix    string
 	CreatedAt string
 }{
 	ID:        "img_categories.id",
 	TenantID:  "img_categories.tenant_id",
+	ParentID:  "img_categories.parent_id",
+	TreePath:  "img_categories.tree_path",
+	Depth:     "img_categories.depth",
 	Title:     "img_categories.title",
 	Prefix:    "img_categories.prefix",
 	CreatedAt: "img_categories.created_at",
@@ -62,15 +78,44 @@ var ImgCategoryTableColumns = struct {
 
 // Generated where
 
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var ImgCategoryWhere = struct {
 	ID        whereHelperstring
 	TenantID  whereHelperstring
+	ParentID  whereHelpernull_String
+	TreePath  whereHelperstring
+	Depth     whereHelperint
 	Title     whereHelperstring
 	Prefix    whereHelperstring
 	CreatedAt whereHelpertime_Time
 }{
 	ID:        whereHelperstring{field: "\"img_categories\".\"id\""},
 	TenantID:  whereHelperstring{field: "\"img_categories\".\"tenant_id\""},
+	ParentID:  whereHelpernull_String{field: "\"img_categories\".\"parent_id\""},
+	TreePath:  whereHelperstring{field: "\"img_categories\".\"tree_path\""},
+	Depth:     whereHelperint{field: "\"img_categories\".\"depth\""},
 	Title:     whereHelperstring{field: "\"img_categories\".\"title\""},
 	Prefix:    whereHelperstring{field: "\"img_categories\".\"prefix\""},
 	CreatedAt: whereHelpertime_Time{field: "\"img_categories\".\"created_at\""},
@@ -78,17 +123,26 @@ var ImgCategoryWhere = struct {
 
 // ImgCategoryRels is where relationship names are stored.
 var ImgCategoryRels = struct {
-	Tenant       string
-	CategoryImgs string
+	Parent                   string
+	Tenant                   string
+	ParentImgCategories      string
+	CategoryImgStorageUsages string
+	CategoryImgs             string
 }{
-	Tenant:       "Tenant",
-	CategoryImgs: "CategoryImgs",
+	Parent:                   "Parent",
+	Tenant:                   "Tenant",
+	ParentImgCategories:      "ParentImgCategories",
+	CategoryImgStorageUsages: "CategoryImgStorageUsages",
+	CategoryImgs:             "CategoryImgs",
 }
 
 // imgCategoryR is where relationships are stored.
 type imgCategoryR struct {
-	Tenant       *Tenant  `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
-	CategoryImgs ImgSlice `boil:"CategoryImgs" json:"CategoryImgs" toml:"CategoryImgs" yaml:"CategoryImgs"`
+	Parent                   *ImgCategory         `boil:"Parent" json:"Parent" toml:"Parent" yaml:"Parent"`
+	Tenant                   *Tenant              `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	ParentImgCategories      ImgCategorySlice     `boil:"ParentImgCategories" json:"ParentImgCategories" toml:"ParentImgCategories" yaml:"ParentImgCategories"`
+	CategoryImgStorageUsages ImgStorageUsageSlice `boil:"CategoryImgStorageUsages" json:"CategoryImgStorageUsages" toml:"CategoryImgStorageUsages" yaml:"CategoryImgStorageUsages"`
+	CategoryImgs             ImgSlice             `boil:"CategoryImgs" json:"CategoryImgs" toml:"CategoryImgs" yaml:"CategoryImgs"`
 }
 
 // NewStruct creates a new relationship struct
@@ -96,6 +150,22 @@ func (*imgCategoryR) NewStruct() *imgCategoryR {
 	return &imgCategoryR{}
 }
 
+func (o *ImgCategory) GetParent() *ImgCategory {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetParent()
+}
+
+func (r *imgCategoryR) GetParent() *ImgCategory {
+	if r == nil {
+		return nil
+	}
+
+	return r.Parent
+}
+
 func (o *ImgCategory) GetTenant() *Tenant {
 	if o == nil {
 		return nil
@@ -112,6 +182,38 @@ func (r *imgCategoryR) GetTenant() *Tenant {
 	return r.Tenant
 }
 
+func (o *ImgCategory) GetParentImgCategories() ImgCategorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetParentImgCategories()
+}
+
+func (r *imgCategoryR) GetParentImgCategories() ImgCategorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ParentImgCategories
+}
+
+func (o *ImgCategory) GetCategoryImgStorageUsages() ImgStorageUsageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCategoryImgStorageUsages()
+}
+
+func (r *imgCategoryR) GetCategoryImgStorageUsages() ImgStorageUsageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CategoryImgStorageUsages
+}
+
 func (o *ImgCategory) GetCategoryImgs() ImgSlice {
 	if o == nil {
 		return nil
@@ -132,9 +234,9 @@ func (r *imgCategoryR) GetCategoryImgs() ImgSlice {
 type imgCategoryL struct{}
 
 var (
-	imgCategoryAllColumns            = []string{"id", "tenant_id", "title", "prefix", "created_at"}
+	imgCategoryAllColumns            = []string{"id", "tenant_id", "parent_id", "tree_path", "depth", "title", "prefix", "created_at"}
 	imgCategoryColumnsWithoutDefault = []string{"tenant_id", "title", "prefix"}
-	imgCategoryColumnsWithDefault    = []string{"id", "created_at"}
+	imgCategoryColumnsWithDefault    = []string{"id", "parent_id", "tree_path", "depth", "created_at"}
 	imgCategoryPrimaryKeyColumns     = []string{"id"}
 	imgCategoryGeneratedColumns      = []string{}
 )
@@ -428,6 +530,17 @@ func (q imgCategoryQuery) Exists(exec boil.Executor) (bool, error) {
 	return count > 0, nil
 }
 
+// Parent pointed to by the foreign key.
+func (o *ImgCategory) Parent(mods ...qm.QueryMod) imgCategoryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ParentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ImgCategories(queryMods...)
+}
+
 // Tenant pointed to by the foreign key.
 func (o *ImgCategory) Tenant(mods ...qm.QueryMod) tenantQuery {
 	queryMods := []qm.QueryMod{
@@ -439,6 +552,34 @@ func (o *ImgCategory) Tenant(mods ...qm.QueryMod) tenantQuery {
 	return Tenants(queryMods...)
 }
 
+// ParentImgCategories retrieves all the img_category's ImgCategories with an executor via parent_id column.
+func (o *ImgCategory) ParentImgCategories(mods ...qm.QueryMod) imgCategoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_categories\".\"parent_id\"=?", o.ID),
+	)
+
+	return ImgCategories(queryMods...)
+}
+
+// CategoryImgStorageUsages retrieves all the img_storage_usage's ImgStorageUsages with an executor via category_id column.
+func (o *ImgCategory) CategoryImgStorageUsages(mods ...qm.QueryMod) imgStorageUsageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_storage_usages\".\"category_id\"=?", o.ID),
+	)
+
+	return ImgStorageUsages(queryMods...)
+}
+
 // CategoryImgs retrieves all the img's Imgs with an executor via category_id column.
 func (o *ImgCategory) CategoryImgs(mods ...qm.QueryMod) imgQuery {
 	var queryMods []qm.QueryMod
@@ -453,6 +594,130 @@ func (o *ImgCategory) CategoryImgs(mods ...qm.QueryMod) imgQuery {
 	return Imgs(queryMods...)
 }
 
+// LoadParent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (imgCategoryL) LoadParent(e boil.Executor, singular bool, maybeImgCategory interface{}, mods queries.Applicator) error {
+	var slice []*ImgCategory
+	var object *ImgCategory
+
+	if singular {
+		var ok bool
+		object, ok = maybeImgCategory.(*ImgCategory)
+		if !ok {
+			object = new(ImgCategory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImgCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImgCategory))
+			}
+		}
+	} else {
+		s, ok := maybeImgCategory.(*[]*ImgCategory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImgCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImgCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imgCategoryR{}
+		}
+		if !queries.IsNil(object.ParentID) {
+			args[object.ParentID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imgCategoryR{}
+			}
+
+			if !queries.IsNil(obj.ParentID) {
+				args[obj.ParentID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_categories`),
+		qm.WhereIn(`img_categories.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ImgCategory")
+	}
+
+	var resultSlice []*ImgCategory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ImgCategory")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for img_categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_categories")
+	}
+
+	if len(imgCategoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Parent = foreign
+		if foreign.R == nil {
+			foreign.R = &imgCategoryR{}
+		}
+		foreign.R.ParentImgCategories = append(foreign.R.ParentImgCategories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ParentID, foreign.ID) {
+				local.R.Parent = foreign
+				if foreign.R == nil {
+					foreign.R = &imgCategoryR{}
+				}
+				foreign.R.ParentImgCategories = append(foreign.R.ParentImgCategories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadTenant allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (imgCategoryL) LoadTenant(e boil.Executor, singular bool, maybeImgCategory interface{}, mods queries.Applicator) error {
@@ -573,9 +838,9 @@ func (imgCategoryL) LoadTenant(e boil.Executor, singular bool, maybeImgCategory
 	return nil
 }
 
-// LoadCategoryImgs allows an eager lookup of values, cached into the
+// LoadParentImgCategories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (imgCategoryL) LoadCategoryImgs(e boil.Executor, singular bool, maybeImgCategory interface{}, mods queries.Applicator) error {
+func (imgCategoryL) LoadParentImgCategories(e boil.Executor, singular bool, maybeImgCategory interface{}, mods queries.Applicator) error {
 	var slice []*ImgCategory
 	var object *ImgCategory
 
@@ -628,9 +893,8 @@ func (imgCategoryL) LoadCategoryImgs(e boil.Executor, singular bool, maybeImgCat
 	}
 
 	query := NewQuery(
-		qm.From(`imgs`),
-		qm.WhereIn(`imgs.category_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`imgs.deleted_at`),
+		qm.From(`img_categories`),
+		qm.WhereIn(`img_categories.parent_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -638,22 +902,22 @@ func (imgCategoryL) LoadCategoryImgs(e boil.Executor, singular bool, maybeImgCat
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load imgs")
+		return errors.Wrap(err, "failed to eager load img_categories")
 	}
 
-	var resultSlice []*Img
+	var resultSlice []*ImgCategory
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice imgs")
+		return errors.Wrap(err, "failed to bind eager loaded slice img_categories")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on imgs")
+		return errors.Wrap(err, "failed to close results in eager load on img_categories")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for imgs")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_categories")
 	}
 
-	if len(imgAfterSelectHooks) != 0 {
+	if len(imgCategoryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -661,24 +925,24 @@ func (imgCategoryL) LoadCategoryImgs(e boil.Executor, singular bool, maybeImgCat
 		}
 	}
 	if singular {
-		object.R.CategoryImgs = resultSlice
+		object.R.ParentImgCategories = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &imgR{}
+				foreign.R = &imgCategoryR{}
 			}
-			foreign.R.Category = object
+			foreign.R.Parent = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CategoryID) {
-				local.R.CategoryImgs = append(local.R.CategoryImgs, foreign)
+			if queries.Equal(local.ID, foreign.ParentID) {
+				local.R.ParentImgCategories = append(local.R.ParentImgCategories, foreign)
 				if foreign.R == nil {
-					foreign.R = &imgR{}
+					foreign.R = &imgCategoryR{}
 				}
-				foreign.R.Category = local
+				foreign.R.Parent = local
 				break
 			}
 		}
@@ -687,49 +951,371 @@ func (imgCategoryL) LoadCategoryImgs(e boil.Executor, singular bool, maybeImgCat
 	return nil
 }
 
-// SetTenantG of the imgCategory to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.ImgCategories.
-// Uses the global database handle.
-func (o *ImgCategory) SetTenantG(insert bool, related *Tenant) error {
-	return o.SetTenant(boil.GetDB(), insert, related)
-}
+// LoadCategoryImgStorageUsages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (imgCategoryL) LoadCategoryImgStorageUsages(e boil.Executor, singular bool, maybeImgCategory interface{}, mods queries.Applicator) error {
+	var slice []*ImgCategory
+	var object *ImgCategory
 
-// SetTenant of the imgCategory to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.ImgCategories.
-func (o *ImgCategory) SetTenant(exec boil.Executor, insert bool, related *Tenant) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeImgCategory.(*ImgCategory)
+		if !ok {
+			object = new(ImgCategory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImgCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImgCategory))
+			}
+		}
+	} else {
+		s, ok := maybeImgCategory.(*[]*ImgCategory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImgCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImgCategory))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"img_categories\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-		strmangle.WhereClause("\"", "\"", 2, imgCategoryPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
-
-	o.TenantID = related.ID
-	if o.R == nil {
-		o.R = &imgCategoryR{
-			Tenant: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imgCategoryR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.Tenant = related
-	}
-
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imgCategoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_storage_usages`),
+		qm.WhereIn(`img_storage_usages.category_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_storage_usages")
+	}
+
+	var resultSlice []*ImgStorageUsage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_storage_usages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_storage_usages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_storage_usages")
+	}
+
+	if len(imgStorageUsageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CategoryImgStorageUsages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgStorageUsageR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CategoryID) {
+				local.R.CategoryImgStorageUsages = append(local.R.CategoryImgStorageUsages, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgStorageUsageR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCategoryImgs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (imgCategoryL) LoadCategoryImgs(e boil.Executor, singular bool, maybeImgCategory interface{}, mods queries.Applicator) error {
+	var slice []*ImgCategory
+	var object *ImgCategory
+
+	if singular {
+		var ok bool
+		object, ok = maybeImgCategory.(*ImgCategory)
+		if !ok {
+			object = new(ImgCategory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImgCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImgCategory))
+			}
+		}
+	} else {
+		s, ok := maybeImgCategory.(*[]*ImgCategory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImgCategory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImgCategory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imgCategoryR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imgCategoryR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`imgs`),
+		qm.WhereIn(`imgs.category_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`imgs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load imgs")
+	}
+
+	var resultSlice []*Img
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice imgs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on imgs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for imgs")
+	}
+
+	if len(imgAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CategoryImgs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgR{}
+			}
+			foreign.R.Category = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CategoryID) {
+				local.R.CategoryImgs = append(local.R.CategoryImgs, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgR{}
+				}
+				foreign.R.Category = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetParentG of the imgCategory to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentImgCategories.
+// Uses the global database handle.
+func (o *ImgCategory) SetParentG(insert bool, related *ImgCategory) error {
+	return o.SetParent(boil.GetDB(), insert, related)
+}
+
+// SetParent of the imgCategory to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentImgCategories.
+func (o *ImgCategory) SetParent(exec boil.Executor, insert bool, related *ImgCategory) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"img_categories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+		strmangle.WhereClause("\"", "\"", 2, imgCategoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ParentID, related.ID)
+	if o.R == nil {
+		o.R = &imgCategoryR{
+			Parent: related,
+		}
+	} else {
+		o.R.Parent = related
+	}
+
+	if related.R == nil {
+		related.R = &imgCategoryR{
+			ParentImgCategories: ImgCategorySlice{o},
+		}
+	} else {
+		related.R.ParentImgCategories = append(related.R.ParentImgCategories, o)
+	}
+
+	return nil
+}
+
+// RemoveParentG relationship.
+// Sets o.R.Parent to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *ImgCategory) RemoveParentG(related *ImgCategory) error {
+	return o.RemoveParent(boil.GetDB(), related)
+}
+
+// RemoveParent relationship.
+// Sets o.R.Parent to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ImgCategory) RemoveParent(exec boil.Executor, related *ImgCategory) error {
+	var err error
+
+	queries.SetScanner(&o.ParentID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("parent_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Parent = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ParentImgCategories {
+		if queries.Equal(o.ParentID, ri.ParentID) {
+			continue
+		}
+
+		ln := len(related.R.ParentImgCategories)
+		if ln > 1 && i < ln-1 {
+			related.R.ParentImgCategories[i] = related.R.ParentImgCategories[ln-1]
+		}
+		related.R.ParentImgCategories = related.R.ParentImgCategories[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTenantG of the imgCategory to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.ImgCategories.
+// Uses the global database handle.
+func (o *ImgCategory) SetTenantG(insert bool, related *Tenant) error {
+	return o.SetTenant(boil.GetDB(), insert, related)
+}
+
+// SetTenant of the imgCategory to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.ImgCategories.
+func (o *ImgCategory) SetTenant(exec boil.Executor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"img_categories\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, imgCategoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TenantID = related.ID
+	if o.R == nil {
+		o.R = &imgCategoryR{
+			Tenant: related,
+		}
+	} else {
+		o.R.Tenant = related
+	}
+
 	if related.R == nil {
 		related.R = &tenantR{
 			ImgCategories: ImgCategorySlice{o},
@@ -741,6 +1327,312 @@ func (o *ImgCategory) SetTenant(exec boil.Executor, insert bool, related *Tenant
 	return nil
 }
 
+// AddParentImgCategoriesG adds the given related objects to the existing relationships
+// of the img_category, optionally inserting them as new records.
+// Appends related to o.R.ParentImgCategories.
+// Sets related.R.Parent appropriately.
+// Uses the global database handle.
+func (o *ImgCategory) AddParentImgCategoriesG(insert bool, related ...*ImgCategory) error {
+	return o.AddParentImgCategories(boil.GetDB(), insert, related...)
+}
+
+// AddParentImgCategories adds the given related objects to the existing relationships
+// of the img_category, optionally inserting them as new records.
+// Appends related to o.R.ParentImgCategories.
+// Sets related.R.Parent appropriately.
+func (o *ImgCategory) AddParentImgCategories(exec boil.Executor, insert bool, related ...*ImgCategory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ParentID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_categories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgCategoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ParentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &imgCategoryR{
+			ParentImgCategories: related,
+		}
+	} else {
+		o.R.ParentImgCategories = append(o.R.ParentImgCategories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgCategoryR{
+				Parent: o,
+			}
+		} else {
+			rel.R.Parent = o
+		}
+	}
+	return nil
+}
+
+// SetParentImgCategoriesG removes all previously related items of the
+// img_category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Parent's ParentImgCategories accordingly.
+// Replaces o.R.ParentImgCategories with related.
+// Sets related.R.Parent's ParentImgCategories accordingly.
+// Uses the global database handle.
+func (o *ImgCategory) SetParentImgCategoriesG(insert bool, related ...*ImgCategory) error {
+	return o.SetParentImgCategories(boil.GetDB(), insert, related...)
+}
+
+// SetParentImgCategories removes all previously related items of the
+// img_category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Parent's ParentImgCategories accordingly.
+// Replaces o.R.ParentImgCategories with related.
+// Sets related.R.Parent's ParentImgCategories accordingly.
+func (o *ImgCategory) SetParentImgCategories(exec boil.Executor, insert bool, related ...*ImgCategory) error {
+	query := "update \"img_categories\" set \"parent_id\" = null where \"parent_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ParentImgCategories {
+			queries.SetScanner(&rel.ParentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Parent = nil
+		}
+		o.R.ParentImgCategories = nil
+	}
+
+	return o.AddParentImgCategories(exec, insert, related...)
+}
+
+// RemoveParentImgCategoriesG relationships from objects passed in.
+// Removes related items from R.ParentImgCategories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Parent.
+// Uses the global database handle.
+func (o *ImgCategory) RemoveParentImgCategoriesG(related ...*ImgCategory) error {
+	return o.RemoveParentImgCategories(boil.GetDB(), related...)
+}
+
+// RemoveParentImgCategories relationships from objects passed in.
+// Removes related items from R.ParentImgCategories (uses pointer comparison, removal does not keep order)
+// Sets related.R.Parent.
+func (o *ImgCategory) RemoveParentImgCategories(exec boil.Executor, related ...*ImgCategory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ParentID, nil)
+		if rel.R != nil {
+			rel.R.Parent = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("parent_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ParentImgCategories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ParentImgCategories)
+			if ln > 1 && i < ln-1 {
+				o.R.ParentImgCategories[i] = o.R.ParentImgCategories[ln-1]
+			}
+			o.R.ParentImgCategories = o.R.ParentImgCategories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCategoryImgStorageUsagesG adds the given related objects to the existing relationships
+// of the img_category, optionally inserting them as new records.
+// Appends related to o.R.CategoryImgStorageUsages.
+// Sets related.R.Category appropriately.
+// Uses the global database handle.
+func (o *ImgCategory) AddCategoryImgStorageUsagesG(insert bool, related ...*ImgStorageUsage) error {
+	return o.AddCategoryImgStorageUsages(boil.GetDB(), insert, related...)
+}
+
+// AddCategoryImgStorageUsages adds the given related objects to the existing relationships
+// of the img_category, optionally inserting them as new records.
+// Appends related to o.R.CategoryImgStorageUsages.
+// Sets related.R.Category appropriately.
+func (o *ImgCategory) AddCategoryImgStorageUsages(exec boil.Executor, insert bool, related ...*ImgStorageUsage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CategoryID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_storage_usages\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgStorageUsagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CategoryID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &imgCategoryR{
+			CategoryImgStorageUsages: related,
+		}
+	} else {
+		o.R.CategoryImgStorageUsages = append(o.R.CategoryImgStorageUsages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgStorageUsageR{
+				Category: o,
+			}
+		} else {
+			rel.R.Category = o
+		}
+	}
+	return nil
+}
+
+// SetCategoryImgStorageUsagesG removes all previously related items of the
+// img_category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Category's CategoryImgStorageUsages accordingly.
+// Replaces o.R.CategoryImgStorageUsages with related.
+// Sets related.R.Category's CategoryImgStorageUsages accordingly.
+// Uses the global database handle.
+func (o *ImgCategory) SetCategoryImgStorageUsagesG(insert bool, related ...*ImgStorageUsage) error {
+	return o.SetCategoryImgStorageUsages(boil.GetDB(), insert, related...)
+}
+
+// SetCategoryImgStorageUsages removes all previously related items of the
+// img_category replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Category's CategoryImgStorageUsages accordingly.
+// Replaces o.R.CategoryImgStorageUsages with related.
+// Sets related.R.Category's CategoryImgStorageUsages accordingly.
+func (o *ImgCategory) SetCategoryImgStorageUsages(exec boil.Executor, insert bool, related ...*ImgStorageUsage) error {
+	query := "update \"img_storage_usages\" set \"category_id\" = null where \"category_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CategoryImgStorageUsages {
+			queries.SetScanner(&rel.CategoryID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Category = nil
+		}
+		o.R.CategoryImgStorageUsages = nil
+	}
+
+	return o.AddCategoryImgStorageUsages(exec, insert, related...)
+}
+
+// RemoveCategoryImgStorageUsagesG relationships from objects passed in.
+// Removes related items from R.CategoryImgStorageUsages (uses pointer comparison, removal does not keep order)
+// Sets related.R.Category.
+// Uses the global database handle.
+func (o *ImgCategory) RemoveCategoryImgStorageUsagesG(related ...*ImgStorageUsage) error {
+	return o.RemoveCategoryImgStorageUsages(boil.GetDB(), related...)
+}
+
+// RemoveCategoryImgStorageUsages relationships from objects passed in.
+// Removes related items from R.CategoryImgStorageUsages (uses pointer comparison, removal does not keep order)
+// Sets related.R.Category.
+func (o *ImgCategory) RemoveCategoryImgStorageUsages(exec boil.Executor, related ...*ImgStorageUsage) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CategoryID, nil)
+		if rel.R != nil {
+			rel.R.Category = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("category_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CategoryImgStorageUsages {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CategoryImgStorageUsages)
+			if ln > 1 && i < ln-1 {
+				o.R.CategoryImgStorageUsages[i] = o.R.CategoryImgStorageUsages[ln-1]
+			}
+			o.R.CategoryImgStorageUsages = o.R.CategoryImgStorageUsages[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // AddCategoryImgsG adds the given related objects to the existing relationships
 // of the img_category, optionally inserting them as new records.
 // Appends related to o.R.CategoryImgs.
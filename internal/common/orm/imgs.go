@@ -23,118 +23,162 @@ import (
 
 // Img is an object representing the database table.
 type Img struct {
-	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	TenantID    string      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
-	CategoryID  null.String `boil:"category_id" json:"category_id,omitempty" toml:"category_id" yaml:"category_id,omitempty"`
-	Path        string      `boil:"path" json:"path" toml:"path" yaml:"path"`
-	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
-	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID               string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TenantID         string      `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	CategoryID       null.String `boil:"category_id" json:"category_id,omitempty" toml:"category_id" yaml:"category_id,omitempty"`
+	Path             string      `boil:"path" json:"path" toml:"path" yaml:"path"`
+	ObjectPath       null.String `boil:"object_path" json:"object_path,omitempty" toml:"object_path" yaml:"object_path,omitempty"`
+	ContentHash      null.String `boil:"content_hash" json:"content_hash,omitempty" toml:"content_hash" yaml:"content_hash,omitempty"`
+	Width            int         `boil:"width" json:"width" toml:"width" yaml:"width"`
+	Height           int         `boil:"height" json:"height" toml:"height" yaml:"height"`
+	Size             int64       `boil:"size" json:"size" toml:"size" yaml:"size"`
+	MimeType         null.String `boil:"mime_type" json:"mime_type,omitempty" toml:"mime_type" yaml:"mime_type,omitempty"`
+	OriginalFilename null.String `boil:"original_filename" json:"original_filename,omitempty" toml:"original_filename" yaml:"original_filename,omitempty"`
+	Description      null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt        null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	LockToken        int64       `boil:"lock_token" json:"lock_token" toml:"lock_token" yaml:"lock_token"`
 
 	R *imgR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L imgL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ImgColumns = struct {
-	ID          string
-	TenantID    string
-	CategoryID  string
-	Path        string
-	Description string
-	CreatedAt   string
-	UpdatedAt   string
-	DeletedAt   string
+	ID               string
+	TenantID         string
+	CategoryID       string
+	Path             string
+	ObjectPath       string
+	ContentHash      string
+	Width            string
+	Height           string
+	Size             string
+	MimeType         string
+	OriginalFilename string
+	Description      string
+	CreatedAt        string
+	UpdatedAt        string
+	DeletedAt        string
+	LockToken        string
 }{
-	ID:          "id",
-	TenantID:    "tenant_id",
-	CategoryID:  "category_id",
-	Path:        "path",
-	Description: "description",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
-	DeletedAt:   "deleted_at",
+	ID:               "id",
+	TenantID:         "tenant_id",
+	CategoryID:       "category_id",
+	Path:             "path",
+	ObjectPath:       "object_path",
+	ContentHash:      "content_hash",
+	Width:            "width",
+	Height:           "height",
+	Size:             "size",
+	MimeType:         "mime_type",
+	OriginalFilename: "original_filename",
+	Description:      "description",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	DeletedAt:        "deleted_at",
+	LockToken:        "lock_token",
 }
 
 var ImgTableColumns = struct {
-	ID          string
-	TenantID    string
-	CategoryID  string
-	Path        string
-	Description string
-	CreatedAt   string
-	UpdatedAt   string
-	DeletedAt   string
+	ID               string
+	TenantID         string
+	CategoryID       string
+	Path             string
+	ObjectPath       string
+	ContentHash      string
+	Width            string
+	Height           string
+	Size             string
+	MimeType         string
+	OriginalFilename string
+	Description      string
+	CreatedAt        string
+	UpdatedAt        string
+	DeletedAt        string
+	LockToken        string
 }{
-	ID:          "imgs.id",
-	TenantID:    "imgs.tenant_id",
-	CategoryID:  "imgs.category_id",
-	Path:        "imgs.path",
-	Description: "imgs.description",
-	CreatedAt:   "imgs.created_at",
-	UpdatedAt:   "imgs.updated_at",
-	DeletedAt:   "imgs.deleted_at",
+	ID:               "imgs.id",
+	TenantID:         "imgs.tenant_id",
+	CategoryID:       "imgs.category_id",
+	Path:             "imgs.path",
+	ObjectPath:       "imgs.object_path",
+	ContentHash:      "imgs.content_hash",
+	Width:            "imgs.width",
+	Height:           "imgs.height",
+	Size:             "imgs.size",
+	MimeType:         "imgs.mime_type",
+	OriginalFilename: "imgs.original_filename",
+	Description:      "imgs.description",
+	CreatedAt:        "imgs.created_at",
+	UpdatedAt:        "imgs.updated_at",
+	DeletedAt:        "imgs.deleted_at",
+	LockToken:        "imgs.lock_token",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var ImgWhere = struct {
-	ID          whereHelperstring
-	TenantID    whereHelperstring
-	CategoryID  whereHelpernull_String
-	Path        whereHelperstring
-	Description whereHelpernull_String
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
-	DeletedAt   whereHelpernull_Time
+	ID               whereHelperstring
+	TenantID         whereHelperstring
+	CategoryID       whereHelpernull_String
+	Path             whereHelperstring
+	ObjectPath       whereHelpernull_String
+	ContentHash      whereHelpernull_String
+	Width            whereHelperint
+	Height           whereHelperint
+	Size             whereHelperint64
+	MimeType         whereHelpernull_String
+	OriginalFilename whereHelpernull_String
+	Description      whereHelpernull_String
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+	DeletedAt        whereHelpernull_Time
+	LockToken        whereHelperint64
 }{
-	ID:          whereHelperstring{field: "\"imgs\".\"id\""},
-	TenantID:    whereHelperstring{field: "\"imgs\".\"tenant_id\""},
-	CategoryID:  whereHelpernull_String{field: "\"imgs\".\"category_id\""},
-	Path:        whereHelperstring{field: "\"imgs\".\"path\""},
-	Description: whereHelpernull_String{field: "\"imgs\".\"description\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"imgs\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"imgs\".\"updated_at\""},
-	DeletedAt:   whereHelpernull_Time{field: "\"imgs\".\"deleted_at\""},
+	ID:               whereHelperstring{field: "\"imgs\".\"id\""},
+	TenantID:         whereHelperstring{field: "\"imgs\".\"tenant_id\""},
+	CategoryID:       whereHelpernull_String{field: "\"imgs\".\"category_id\""},
+	Path:             whereHelperstring{field: "\"imgs\".\"path\""},
+	ObjectPath:       whereHelpernull_String{field: "\"imgs\".\"object_path\""},
+	ContentHash:      whereHelpernull_String{field: "\"imgs\".\"content_hash\""},
+	Width:            whereHelperint{field: "\"imgs\".\"width\""},
+	Height:           whereHelperint{field: "\"imgs\".\"height\""},
+	Size:             whereHelperint64{field: "\"imgs\".\"size\""},
+	MimeType:         whereHelpernull_String{field: "\"imgs\".\"mime_type\""},
+	OriginalFilename: whereHelpernull_String{field: "\"imgs\".\"original_filename\""},
+	Description:      whereHelpernull_String{field: "\"imgs\".\"description\""},
+	CreatedAt:        whereHelpertime_Time{field: "\"imgs\".\"created_at\""},
+	UpdatedAt:        whereHelpertime_Time{field: "\"imgs\".\"updated_at\""},
+	DeletedAt:        whereHelpernull_Time{field: "\"imgs\".\"deleted_at\""},
+	LockToken:        whereHelperint64{field: "\"imgs\".\"lock_token\""},
 }
 
 // ImgRels is where relationship names are stored.
 var ImgRels = struct {
-	Category string
-	Tenant   string
+	Category          string
+	Tenant            string
+	ImgAlbumItems     string
+	ImgImportItems    string
+	ImgTagAssignments string
+	ImgVariants       string
 }{
-	Category: "Category",
-	Tenant:   "Tenant",
+	Category:          "Category",
+	Tenant:            "Tenant",
+	ImgAlbumItems:     "ImgAlbumItems",
+	ImgImportItems:    "ImgImportItems",
+	ImgTagAssignments: "ImgTagAssignments",
+	ImgVariants:       "ImgVariants",
 }
 
 // imgR is where relationships are stored.
 type imgR struct {
-	Category *ImgCategory `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
-	Tenant   *Tenant      `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	Category          *ImgCategory          `boil:"Category" json:"Category" toml:"Category" yaml:"Category"`
+	Tenant            *Tenant               `boil:"Tenant" json:"Tenant" toml:"Tenant" yaml:"Tenant"`
+	ImgAlbumItems     ImgAlbumItemSlice     `boil:"ImgAlbumItems" json:"ImgAlbumItems" toml:"ImgAlbumItems" yaml:"ImgAlbumItems"`
+	ImgImportItems    ImgImportItemSlice    `boil:"ImgImportItems" json:"ImgImportItems" toml:"ImgImportItems" yaml:"ImgImportItems"`
+	ImgTagAssignments ImgTagAssignmentSlice `boil:"ImgTagAssignments" json:"ImgTagAssignments" toml:"ImgTagAssignments" yaml:"ImgTagAssignments"`
+	ImgVariants       ImgVariantSlice       `boil:"ImgVariants" json:"ImgVariants" toml:"ImgVariants" yaml:"ImgVariants"`
 }
 
 // NewStruct creates a new relationship struct
@@ -174,13 +218,77 @@ func (r *imgR) GetTenant() *Tenant {
 	return r.Tenant
 }
 
+func (o *Img) GetImgAlbumItems() ImgAlbumItemSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgAlbumItems()
+}
+
+func (r *imgR) GetImgAlbumItems() ImgAlbumItemSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgAlbumItems
+}
+
+func (o *Img) GetImgImportItems() ImgImportItemSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgImportItems()
+}
+
+func (r *imgR) GetImgImportItems() ImgImportItemSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgImportItems
+}
+
+func (o *Img) GetImgTagAssignments() ImgTagAssignmentSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgTagAssignments()
+}
+
+func (r *imgR) GetImgTagAssignments() ImgTagAssignmentSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgTagAssignments
+}
+
+func (o *Img) GetImgVariants() ImgVariantSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgVariants()
+}
+
+func (r *imgR) GetImgVariants() ImgVariantSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgVariants
+}
+
 // imgL is where Load methods for each relationship are stored.
 type imgL struct{}
 
 var (
-	imgAllColumns            = []string{"id", "tenant_id", "category_id", "path", "description", "created_at", "updated_at", "deleted_at"}
+	imgAllColumns            = []string{"id", "tenant_id", "category_id", "path", "object_path", "content_hash", "width", "height", "size", "mime_type", "original_filename", "description", "created_at", "updated_at", "deleted_at", "lock_token"}
 	imgColumnsWithoutDefault = []string{"tenant_id", "path"}
-	imgColumnsWithDefault    = []string{"id", "category_id", "description", "created_at", "updated_at", "deleted_at"}
+	imgColumnsWithDefault    = []string{"id", "category_id", "object_path", "content_hash", "width", "height", "size", "mime_type", "original_filename", "description", "created_at", "updated_at", "deleted_at", "lock_token"}
 	imgPrimaryKeyColumns     = []string{"id"}
 	imgGeneratedColumns      = []string{}
 )
@@ -496,6 +604,62 @@ func (o *Img) Tenant(mods ...qm.QueryMod) tenantQuery {
 	return Tenants(queryMods...)
 }
 
+// ImgAlbumItems retrieves all the img_album_item's ImgAlbumItems with an executor.
+func (o *Img) ImgAlbumItems(mods ...qm.QueryMod) imgAlbumItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_album_items\".\"img_id\"=?", o.ID),
+	)
+
+	return ImgAlbumItems(queryMods...)
+}
+
+// ImgImportItems retrieves all the img_import_item's ImgImportItems with an executor.
+func (o *Img) ImgImportItems(mods ...qm.QueryMod) imgImportItemQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_import_items\".\"img_id\"=?", o.ID),
+	)
+
+	return ImgImportItems(queryMods...)
+}
+
+// ImgTagAssignments retrieves all the img_tag_assignment's ImgTagAssignments with an executor.
+func (o *Img) ImgTagAssignments(mods ...qm.QueryMod) imgTagAssignmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_tag_assignments\".\"img_id\"=?", o.ID),
+	)
+
+	return ImgTagAssignments(queryMods...)
+}
+
+// ImgVariants retrieves all the img_variant's ImgVariants with an executor.
+func (o *Img) ImgVariants(mods ...qm.QueryMod) imgVariantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_variants\".\"img_id\"=?", o.ID),
+	)
+
+	return ImgVariants(queryMods...)
+}
+
 // LoadCategory allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (imgL) LoadCategory(e boil.Executor, singular bool, maybeImg interface{}, mods queries.Applicator) error {
@@ -740,124 +904,576 @@ func (imgL) LoadTenant(e boil.Executor, singular bool, maybeImg interface{}, mod
 	return nil
 }
 
-// SetCategoryG of the img to the related item.
-// Sets o.R.Category to related.
-// Adds o to related.R.CategoryImgs.
-// Uses the global database handle.
-func (o *Img) SetCategoryG(insert bool, related *ImgCategory) error {
-	return o.SetCategory(boil.GetDB(), insert, related)
-}
+// LoadImgAlbumItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (imgL) LoadImgAlbumItems(e boil.Executor, singular bool, maybeImg interface{}, mods queries.Applicator) error {
+	var slice []*Img
+	var object *Img
 
-// SetCategory of the img to the related item.
-// Sets o.R.Category to related.
-// Adds o to related.R.CategoryImgs.
-func (o *Img) SetCategory(exec boil.Executor, insert bool, related *ImgCategory) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeImg.(*Img)
+		if !ok {
+			object = new(Img)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImg))
+			}
+		}
+	} else {
+		s, ok := maybeImg.(*[]*Img)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImg))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"imgs\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
-		strmangle.WhereClause("\"", "\"", 2, imgPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
-
-	queries.Assign(&o.CategoryID, related.ID)
-	if o.R == nil {
-		o.R = &imgR{
-			Category: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imgR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.Category = related
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imgR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &imgCategoryR{
-			CategoryImgs: ImgSlice{o},
-		}
-	} else {
-		related.R.CategoryImgs = append(related.R.CategoryImgs, o)
+	if len(args) == 0 {
+		return nil
 	}
 
-	return nil
-}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-// RemoveCategoryG relationship.
-// Sets o.R.Category to nil.
-// Removes o from all passed in related items' relationships struct.
-// Uses the global database handle.
-func (o *Img) RemoveCategoryG(related *ImgCategory) error {
-	return o.RemoveCategory(boil.GetDB(), related)
-}
+	query := NewQuery(
+		qm.From(`img_album_items`),
+		qm.WhereIn(`img_album_items.img_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-// RemoveCategory relationship.
-// Sets o.R.Category to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *Img) RemoveCategory(exec boil.Executor, related *ImgCategory) error {
-	var err error
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_album_items")
+	}
 
-	queries.SetScanner(&o.CategoryID, nil)
-	if _, err = o.Update(exec, boil.Whitelist("category_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	var resultSlice []*ImgAlbumItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_album_items")
 	}
 
-	if o.R != nil {
-		o.R.Category = nil
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_album_items")
 	}
-	if related == nil || related.R == nil {
-		return nil
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_album_items")
 	}
 
-	for i, ri := range related.R.CategoryImgs {
-		if queries.Equal(o.CategoryID, ri.CategoryID) {
-			continue
+	if len(imgAlbumItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgAlbumItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgAlbumItemR{}
+			}
+			foreign.R.Img = object
 		}
+		return nil
+	}
 
-		ln := len(related.R.CategoryImgs)
-		if ln > 1 && i < ln-1 {
-			related.R.CategoryImgs[i] = related.R.CategoryImgs[ln-1]
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ImgID {
+				local.R.ImgAlbumItems = append(local.R.ImgAlbumItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgAlbumItemR{}
+				}
+				foreign.R.Img = local
+				break
+			}
 		}
-		related.R.CategoryImgs = related.R.CategoryImgs[:ln-1]
-		break
 	}
+
 	return nil
 }
 
-// SetTenantG of the img to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.Imgs.
-// Uses the global database handle.
-func (o *Img) SetTenantG(insert bool, related *Tenant) error {
-	return o.SetTenant(boil.GetDB(), insert, related)
-}
+// LoadImgImportItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (imgL) LoadImgImportItems(e boil.Executor, singular bool, maybeImg interface{}, mods queries.Applicator) error {
+	var slice []*Img
+	var object *Img
 
-// SetTenant of the img to the related item.
-// Sets o.R.Tenant to related.
-// Adds o to related.R.Imgs.
-func (o *Img) SetTenant(exec boil.Executor, insert bool, related *Tenant) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeImg.(*Img)
+		if !ok {
+			object = new(Img)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImg))
+			}
+		}
+	} else {
+		s, ok := maybeImg.(*[]*Img)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImg))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"imgs\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-		strmangle.WhereClause("\"", "\"", 2, imgPrimaryKeyColumns),
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imgR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imgR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_import_items`),
+		qm.WhereIn(`img_import_items.img_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_import_items")
+	}
+
+	var resultSlice []*ImgImportItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_import_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_import_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_import_items")
+	}
+
+	if len(imgImportItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgImportItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgImportItemR{}
+			}
+			foreign.R.Img = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ImgID) {
+				local.R.ImgImportItems = append(local.R.ImgImportItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgImportItemR{}
+				}
+				foreign.R.Img = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgTagAssignments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (imgL) LoadImgTagAssignments(e boil.Executor, singular bool, maybeImg interface{}, mods queries.Applicator) error {
+	var slice []*Img
+	var object *Img
+
+	if singular {
+		var ok bool
+		object, ok = maybeImg.(*Img)
+		if !ok {
+			object = new(Img)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImg))
+			}
+		}
+	} else {
+		s, ok := maybeImg.(*[]*Img)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImg))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imgR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imgR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_tag_assignments`),
+		qm.WhereIn(`img_tag_assignments.img_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_tag_assignments")
+	}
+
+	var resultSlice []*ImgTagAssignment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_tag_assignments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_tag_assignments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_tag_assignments")
+	}
+
+	if len(imgTagAssignmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgTagAssignments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgTagAssignmentR{}
+			}
+			foreign.R.Img = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ImgID {
+				local.R.ImgTagAssignments = append(local.R.ImgTagAssignments, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgTagAssignmentR{}
+				}
+				foreign.R.Img = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgVariants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (imgL) LoadImgVariants(e boil.Executor, singular bool, maybeImg interface{}, mods queries.Applicator) error {
+	var slice []*Img
+	var object *Img
+
+	if singular {
+		var ok bool
+		object, ok = maybeImg.(*Img)
+		if !ok {
+			object = new(Img)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeImg))
+			}
+		}
+	} else {
+		s, ok := maybeImg.(*[]*Img)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeImg)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeImg))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &imgR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &imgR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_variants`),
+		qm.WhereIn(`img_variants.img_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_variants")
+	}
+
+	var resultSlice []*ImgVariant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_variants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_variants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_variants")
+	}
+
+	if len(imgVariantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgVariants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgVariantR{}
+			}
+			foreign.R.Img = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ImgID {
+				local.R.ImgVariants = append(local.R.ImgVariants, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgVariantR{}
+				}
+				foreign.R.Img = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCategoryG of the img to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.CategoryImgs.
+// Uses the global database handle.
+func (o *Img) SetCategoryG(insert bool, related *ImgCategory) error {
+	return o.SetCategory(boil.GetDB(), insert, related)
+}
+
+// SetCategory of the img to the related item.
+// Sets o.R.Category to related.
+// Adds o to related.R.CategoryImgs.
+func (o *Img) SetCategory(exec boil.Executor, insert bool, related *ImgCategory) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"imgs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"category_id"}),
+		strmangle.WhereClause("\"", "\"", 2, imgPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CategoryID, related.ID)
+	if o.R == nil {
+		o.R = &imgR{
+			Category: related,
+		}
+	} else {
+		o.R.Category = related
+	}
+
+	if related.R == nil {
+		related.R = &imgCategoryR{
+			CategoryImgs: ImgSlice{o},
+		}
+	} else {
+		related.R.CategoryImgs = append(related.R.CategoryImgs, o)
+	}
+
+	return nil
+}
+
+// RemoveCategoryG relationship.
+// Sets o.R.Category to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *Img) RemoveCategoryG(related *ImgCategory) error {
+	return o.RemoveCategory(boil.GetDB(), related)
+}
+
+// RemoveCategory relationship.
+// Sets o.R.Category to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Img) RemoveCategory(exec boil.Executor, related *ImgCategory) error {
+	var err error
+
+	queries.SetScanner(&o.CategoryID, nil)
+	if _, err = o.Update(exec, boil.Whitelist("category_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Category = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CategoryImgs {
+		if queries.Equal(o.CategoryID, ri.CategoryID) {
+			continue
+		}
+
+		ln := len(related.R.CategoryImgs)
+		if ln > 1 && i < ln-1 {
+			related.R.CategoryImgs[i] = related.R.CategoryImgs[ln-1]
+		}
+		related.R.CategoryImgs = related.R.CategoryImgs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTenantG of the img to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.Imgs.
+// Uses the global database handle.
+func (o *Img) SetTenantG(insert bool, related *Tenant) error {
+	return o.SetTenant(boil.GetDB(), insert, related)
+}
+
+// SetTenant of the img to the related item.
+// Sets o.R.Tenant to related.
+// Adds o to related.R.Imgs.
+func (o *Img) SetTenant(exec boil.Executor, insert bool, related *Tenant) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"imgs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+		strmangle.WhereClause("\"", "\"", 2, imgPrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
 
@@ -889,6 +1505,342 @@ func (o *Img) SetTenant(exec boil.Executor, insert bool, related *Tenant) error
 	return nil
 }
 
+// AddImgAlbumItemsG adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgAlbumItems.
+// Sets related.R.Img appropriately.
+// Uses the global database handle.
+func (o *Img) AddImgAlbumItemsG(insert bool, related ...*ImgAlbumItem) error {
+	return o.AddImgAlbumItems(boil.GetDB(), insert, related...)
+}
+
+// AddImgAlbumItems adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgAlbumItems.
+// Sets related.R.Img appropriately.
+func (o *Img) AddImgAlbumItems(exec boil.Executor, insert bool, related ...*ImgAlbumItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImgID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_album_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"img_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgAlbumItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AlbumID, rel.ImgID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImgID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &imgR{
+			ImgAlbumItems: related,
+		}
+	} else {
+		o.R.ImgAlbumItems = append(o.R.ImgAlbumItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgAlbumItemR{
+				Img: o,
+			}
+		} else {
+			rel.R.Img = o
+		}
+	}
+	return nil
+}
+
+// AddImgImportItemsG adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgImportItems.
+// Sets related.R.Img appropriately.
+// Uses the global database handle.
+func (o *Img) AddImgImportItemsG(insert bool, related ...*ImgImportItem) error {
+	return o.AddImgImportItems(boil.GetDB(), insert, related...)
+}
+
+// AddImgImportItems adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgImportItems.
+// Sets related.R.Img appropriately.
+func (o *Img) AddImgImportItems(exec boil.Executor, insert bool, related ...*ImgImportItem) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ImgID, o.ID)
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_import_items\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"img_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgImportItemPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ImgID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &imgR{
+			ImgImportItems: related,
+		}
+	} else {
+		o.R.ImgImportItems = append(o.R.ImgImportItems, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgImportItemR{
+				Img: o,
+			}
+		} else {
+			rel.R.Img = o
+		}
+	}
+	return nil
+}
+
+// SetImgImportItemsG removes all previously related items of the
+// img replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Img's ImgImportItems accordingly.
+// Replaces o.R.ImgImportItems with related.
+// Sets related.R.Img's ImgImportItems accordingly.
+// Uses the global database handle.
+func (o *Img) SetImgImportItemsG(insert bool, related ...*ImgImportItem) error {
+	return o.SetImgImportItems(boil.GetDB(), insert, related...)
+}
+
+// SetImgImportItems removes all previously related items of the
+// img replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Img's ImgImportItems accordingly.
+// Replaces o.R.ImgImportItems with related.
+// Sets related.R.Img's ImgImportItems accordingly.
+func (o *Img) SetImgImportItems(exec boil.Executor, insert bool, related ...*ImgImportItem) error {
+	query := "update \"img_import_items\" set \"img_id\" = null where \"img_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	_, err := exec.Exec(query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImgImportItems {
+			queries.SetScanner(&rel.ImgID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Img = nil
+		}
+		o.R.ImgImportItems = nil
+	}
+
+	return o.AddImgImportItems(exec, insert, related...)
+}
+
+// RemoveImgImportItemsG relationships from objects passed in.
+// Removes related items from R.ImgImportItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Img.
+// Uses the global database handle.
+func (o *Img) RemoveImgImportItemsG(related ...*ImgImportItem) error {
+	return o.RemoveImgImportItems(boil.GetDB(), related...)
+}
+
+// RemoveImgImportItems relationships from objects passed in.
+// Removes related items from R.ImgImportItems (uses pointer comparison, removal does not keep order)
+// Sets related.R.Img.
+func (o *Img) RemoveImgImportItems(exec boil.Executor, related ...*ImgImportItem) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ImgID, nil)
+		if rel.R != nil {
+			rel.R.Img = nil
+		}
+		if _, err = rel.Update(exec, boil.Whitelist("img_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImgImportItems {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImgImportItems)
+			if ln > 1 && i < ln-1 {
+				o.R.ImgImportItems[i] = o.R.ImgImportItems[ln-1]
+			}
+			o.R.ImgImportItems = o.R.ImgImportItems[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddImgTagAssignmentsG adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgTagAssignments.
+// Sets related.R.Img appropriately.
+// Uses the global database handle.
+func (o *Img) AddImgTagAssignmentsG(insert bool, related ...*ImgTagAssignment) error {
+	return o.AddImgTagAssignments(boil.GetDB(), insert, related...)
+}
+
+// AddImgTagAssignments adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgTagAssignments.
+// Sets related.R.Img appropriately.
+func (o *Img) AddImgTagAssignments(exec boil.Executor, insert bool, related ...*ImgTagAssignment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImgID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_tag_assignments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"img_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgTagAssignmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ImgID, rel.TagID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImgID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &imgR{
+			ImgTagAssignments: related,
+		}
+	} else {
+		o.R.ImgTagAssignments = append(o.R.ImgTagAssignments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgTagAssignmentR{
+				Img: o,
+			}
+		} else {
+			rel.R.Img = o
+		}
+	}
+	return nil
+}
+
+// AddImgVariantsG adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgVariants.
+// Sets related.R.Img appropriately.
+// Uses the global database handle.
+func (o *Img) AddImgVariantsG(insert bool, related ...*ImgVariant) error {
+	return o.AddImgVariants(boil.GetDB(), insert, related...)
+}
+
+// AddImgVariants adds the given related objects to the existing relationships
+// of the img, optionally inserting them as new records.
+// Appends related to o.R.ImgVariants.
+// Sets related.R.Img appropriately.
+func (o *Img) AddImgVariants(exec boil.Executor, insert bool, related ...*ImgVariant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ImgID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_variants\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"img_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgVariantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ImgID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &imgR{
+			ImgVariants: related,
+		}
+	} else {
+		o.R.ImgVariants = append(o.R.ImgVariants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgVariantR{
+				Img: o,
+			}
+		} else {
+			rel.R.Img = o
+		}
+	}
+	return nil
+}
+
 // Imgs retrieves all the records using an executor.
 func Imgs(mods ...qm.QueryMod) imgQuery {
 	mods = append(mods, qm.From("\"imgs\""), qmhelper.WhereIsNull("\"imgs\".\"deleted_at\""))
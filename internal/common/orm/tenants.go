@@ -228,20 +228,38 @@ var TenantWhere = struct {
 var TenantRels = struct {
 	Creator             string
 	CommentTenantConfig string
-	TenantR2Config      string
+	ImgStorageMigration string
+	ImgWatermarkSetting string
+	TenantImgSetting    string
+	TenantStorageConfig string
 	CommentLikes        string
 	CommentPlates       string
 	Comments            string
+	ImgAlbums           string
 	ImgCategories       string
+	ImgCategoryJobs     string
+	ImgExportJobs       string
+	ImgImportJobs       string
+	ImgStorageUsages    string
+	ImgTags             string
 	Imgs                string
 }{
 	Creator:             "Creator",
 	CommentTenantConfig: "CommentTenantConfig",
-	TenantR2Config:      "TenantR2Config",
+	ImgStorageMigration: "ImgStorageMigration",
+	ImgWatermarkSetting: "ImgWatermarkSetting",
+	TenantImgSetting:    "TenantImgSetting",
+	TenantStorageConfig: "TenantStorageConfig",
 	CommentLikes:        "CommentLikes",
 	CommentPlates:       "CommentPlates",
 	Comments:            "Comments",
+	ImgAlbums:           "ImgAlbums",
 	ImgCategories:       "ImgCategories",
+	ImgCategoryJobs:     "ImgCategoryJobs",
+	ImgExportJobs:       "ImgExportJobs",
+	ImgImportJobs:       "ImgImportJobs",
+	ImgStorageUsages:    "ImgStorageUsages",
+	ImgTags:             "ImgTags",
 	Imgs:                "Imgs",
 }
 
@@ -249,11 +267,20 @@ var TenantRels = struct {
 type tenantR struct {
 	Creator             *User                `boil:"Creator" json:"Creator" toml:"Creator" yaml:"Creator"`
 	CommentTenantConfig *CommentTenantConfig `boil:"CommentTenantConfig" json:"CommentTenantConfig" toml:"CommentTenantConfig" yaml:"CommentTenantConfig"`
-	TenantR2Config      *TenantR2Config      `boil:"TenantR2Config" json:"TenantR2Config" toml:"TenantR2Config" yaml:"TenantR2Config"`
+	ImgStorageMigration *ImgStorageMigration `boil:"ImgStorageMigration" json:"ImgStorageMigration" toml:"ImgStorageMigration" yaml:"ImgStorageMigration"`
+	ImgWatermarkSetting *ImgWatermarkSetting `boil:"ImgWatermarkSetting" json:"ImgWatermarkSetting" toml:"ImgWatermarkSetting" yaml:"ImgWatermarkSetting"`
+	TenantImgSetting    *TenantImgSetting    `boil:"TenantImgSetting" json:"TenantImgSetting" toml:"TenantImgSetting" yaml:"TenantImgSetting"`
+	TenantStorageConfig *TenantStorageConfig `boil:"TenantStorageConfig" json:"TenantStorageConfig" toml:"TenantStorageConfig" yaml:"TenantStorageConfig"`
 	CommentLikes        CommentLikeSlice     `boil:"CommentLikes" json:"CommentLikes" toml:"CommentLikes" yaml:"CommentLikes"`
 	CommentPlates       CommentPlateSlice    `boil:"CommentPlates" json:"CommentPlates" toml:"CommentPlates" yaml:"CommentPlates"`
 	Comments            CommentSlice         `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	ImgAlbums           ImgAlbumSlice        `boil:"ImgAlbums" json:"ImgAlbums" toml:"ImgAlbums" yaml:"ImgAlbums"`
 	ImgCategories       ImgCategorySlice     `boil:"ImgCategories" json:"ImgCategories" toml:"ImgCategories" yaml:"ImgCategories"`
+	ImgCategoryJobs     ImgCategoryJobSlice  `boil:"ImgCategoryJobs" json:"ImgCategoryJobs" toml:"ImgCategoryJobs" yaml:"ImgCategoryJobs"`
+	ImgExportJobs       ImgExportJobSlice    `boil:"ImgExportJobs" json:"ImgExportJobs" toml:"ImgExportJobs" yaml:"ImgExportJobs"`
+	ImgImportJobs       ImgImportJobSlice    `boil:"ImgImportJobs" json:"ImgImportJobs" toml:"ImgImportJobs" yaml:"ImgImportJobs"`
+	ImgStorageUsages    ImgStorageUsageSlice `boil:"ImgStorageUsages" json:"ImgStorageUsages" toml:"ImgStorageUsages" yaml:"ImgStorageUsages"`
+	ImgTags             ImgTagSlice          `boil:"ImgTags" json:"ImgTags" toml:"ImgTags" yaml:"ImgTags"`
 	Imgs                ImgSlice             `boil:"Imgs" json:"Imgs" toml:"Imgs" yaml:"Imgs"`
 }
 
@@ -294,20 +321,68 @@ func (r *tenantR) GetCommentTenantConfig() *CommentTenantConfig {
 	return r.CommentTenantConfig
 }
 
-func (o *Tenant) GetTenantR2Config() *TenantR2Config {
+func (o *Tenant) GetImgStorageMigration() *ImgStorageMigration {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetTenantR2Config()
+	return o.R.GetImgStorageMigration()
 }
 
-func (r *tenantR) GetTenantR2Config() *TenantR2Config {
+func (r *tenantR) GetImgStorageMigration() *ImgStorageMigration {
 	if r == nil {
 		return nil
 	}
 
-	return r.TenantR2Config
+	return r.ImgStorageMigration
+}
+
+func (o *Tenant) GetImgWatermarkSetting() *ImgWatermarkSetting {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgWatermarkSetting()
+}
+
+func (r *tenantR) GetImgWatermarkSetting() *ImgWatermarkSetting {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgWatermarkSetting
+}
+
+func (o *Tenant) GetTenantImgSetting() *TenantImgSetting {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenantImgSetting()
+}
+
+func (r *tenantR) GetTenantImgSetting() *TenantImgSetting {
+	if r == nil {
+		return nil
+	}
+
+	return r.TenantImgSetting
+}
+
+func (o *Tenant) GetTenantStorageConfig() *TenantStorageConfig {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTenantStorageConfig()
+}
+
+func (r *tenantR) GetTenantStorageConfig() *TenantStorageConfig {
+	if r == nil {
+		return nil
+	}
+
+	return r.TenantStorageConfig
 }
 
 func (o *Tenant) GetCommentLikes() CommentLikeSlice {
@@ -358,6 +433,22 @@ func (r *tenantR) GetComments() CommentSlice {
 	return r.Comments
 }
 
+func (o *Tenant) GetImgAlbums() ImgAlbumSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgAlbums()
+}
+
+func (r *tenantR) GetImgAlbums() ImgAlbumSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgAlbums
+}
+
 func (o *Tenant) GetImgCategories() ImgCategorySlice {
 	if o == nil {
 		return nil
@@ -374,6 +465,86 @@ func (r *tenantR) GetImgCategories() ImgCategorySlice {
 	return r.ImgCategories
 }
 
+func (o *Tenant) GetImgCategoryJobs() ImgCategoryJobSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgCategoryJobs()
+}
+
+func (r *tenantR) GetImgCategoryJobs() ImgCategoryJobSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgCategoryJobs
+}
+
+func (o *Tenant) GetImgExportJobs() ImgExportJobSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgExportJobs()
+}
+
+func (r *tenantR) GetImgExportJobs() ImgExportJobSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgExportJobs
+}
+
+func (o *Tenant) GetImgImportJobs() ImgImportJobSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgImportJobs()
+}
+
+func (r *tenantR) GetImgImportJobs() ImgImportJobSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgImportJobs
+}
+
+func (o *Tenant) GetImgStorageUsages() ImgStorageUsageSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgStorageUsages()
+}
+
+func (r *tenantR) GetImgStorageUsages() ImgStorageUsageSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgStorageUsages
+}
+
+func (o *Tenant) GetImgTags() ImgTagSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImgTags()
+}
+
+func (r *tenantR) GetImgTags() ImgTagSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImgTags
+}
+
 func (o *Tenant) GetImgs() ImgSlice {
 	if o == nil {
 		return nil
@@ -712,15 +883,48 @@ func (o *Tenant) CommentTenantConfig(mods ...qm.QueryMod) commentTenantConfigQue
 	return CommentTenantConfigs(queryMods...)
 }
 
-// TenantR2Config pointed to by the foreign key.
-func (o *Tenant) TenantR2Config(mods ...qm.QueryMod) tenantR2ConfigQuery {
+// ImgStorageMigration pointed to by the foreign key.
+func (o *Tenant) ImgStorageMigration(mods ...qm.QueryMod) imgStorageMigrationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"tenant_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ImgStorageMigrations(queryMods...)
+}
+
+// ImgWatermarkSetting pointed to by the foreign key.
+func (o *Tenant) ImgWatermarkSetting(mods ...qm.QueryMod) imgWatermarkSettingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"tenant_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ImgWatermarkSettings(queryMods...)
+}
+
+// TenantImgSetting pointed to by the foreign key.
+func (o *Tenant) TenantImgSetting(mods ...qm.QueryMod) tenantImgSettingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"tenant_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TenantImgSettings(queryMods...)
+}
+
+// TenantStorageConfig pointed to by the foreign key.
+func (o *Tenant) TenantStorageConfig(mods ...qm.QueryMod) tenantStorageConfigQuery {
 	queryMods := []qm.QueryMod{
 		qm.Where("\"tenant_id\" = ?", o.ID),
 	}
 
 	queryMods = append(queryMods, mods...)
 
-	return TenantR2Configs(queryMods...)
+	return TenantStorageConfigs(queryMods...)
 }
 
 // CommentLikes retrieves all the comment_like's CommentLikes with an executor.
@@ -765,6 +969,20 @@ func (o *Tenant) Comments(mods ...qm.QueryMod) commentQuery {
 	return Comments(queryMods...)
 }
 
+// ImgAlbums retrieves all the img_album's ImgAlbums with an executor.
+func (o *Tenant) ImgAlbums(mods ...qm.QueryMod) imgAlbumQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_albums\".\"tenant_id\"=?", o.ID),
+	)
+
+	return ImgAlbums(queryMods...)
+}
+
 // ImgCategories retrieves all the img_category's ImgCategories with an executor.
 func (o *Tenant) ImgCategories(mods ...qm.QueryMod) imgCategoryQuery {
 	var queryMods []qm.QueryMod
@@ -779,6 +997,76 @@ func (o *Tenant) ImgCategories(mods ...qm.QueryMod) imgCategoryQuery {
 	return ImgCategories(queryMods...)
 }
 
+// ImgCategoryJobs retrieves all the img_category_job's ImgCategoryJobs with an executor.
+func (o *Tenant) ImgCategoryJobs(mods ...qm.QueryMod) imgCategoryJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_category_jobs\".\"tenant_id\"=?", o.ID),
+	)
+
+	return ImgCategoryJobs(queryMods...)
+}
+
+// ImgExportJobs retrieves all the img_export_job's ImgExportJobs with an executor.
+func (o *Tenant) ImgExportJobs(mods ...qm.QueryMod) imgExportJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_export_jobs\".\"tenant_id\"=?", o.ID),
+	)
+
+	return ImgExportJobs(queryMods...)
+}
+
+// ImgImportJobs retrieves all the img_import_job's ImgImportJobs with an executor.
+func (o *Tenant) ImgImportJobs(mods ...qm.QueryMod) imgImportJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_import_jobs\".\"tenant_id\"=?", o.ID),
+	)
+
+	return ImgImportJobs(queryMods...)
+}
+
+// ImgStorageUsages retrieves all the img_storage_usage's ImgStorageUsages with an executor.
+func (o *Tenant) ImgStorageUsages(mods ...qm.QueryMod) imgStorageUsageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_storage_usages\".\"tenant_id\"=?", o.ID),
+	)
+
+	return ImgStorageUsages(queryMods...)
+}
+
+// ImgTags retrieves all the img_tag's ImgTags with an executor.
+func (o *Tenant) ImgTags(mods ...qm.QueryMod) imgTagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"img_tags\".\"tenant_id\"=?", o.ID),
+	)
+
+	return ImgTags(queryMods...)
+}
+
 // Imgs retrieves all the img's Imgs with an executor.
 func (o *Tenant) Imgs(mods ...qm.QueryMod) imgQuery {
 	var queryMods []qm.QueryMod
@@ -1030,9 +1318,9 @@ func (tenantL) LoadCommentTenantConfig(e boil.Executor, singular bool, maybeTena
 	return nil
 }
 
-// LoadTenantR2Config allows an eager lookup of values, cached into the
+// LoadImgStorageMigration allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
-func (tenantL) LoadTenantR2Config(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadImgStorageMigration(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1086,8 +1374,8 @@ func (tenantL) LoadTenantR2Config(e boil.Executor, singular bool, maybeTenant in
 	}
 
 	query := NewQuery(
-		qm.From(`tenant_r2_configs`),
-		qm.WhereIn(`tenant_r2_configs.tenant_id in ?`, argsSlice...),
+		qm.From(`img_storage_migrations`),
+		qm.WhereIn(`img_storage_migrations.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1095,22 +1383,22 @@ func (tenantL) LoadTenantR2Config(e boil.Executor, singular bool, maybeTenant in
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load TenantR2Config")
+		return errors.Wrap(err, "failed to eager load ImgStorageMigration")
 	}
 
-	var resultSlice []*TenantR2Config
+	var resultSlice []*ImgStorageMigration
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice TenantR2Config")
+		return errors.Wrap(err, "failed to bind eager loaded slice ImgStorageMigration")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for tenant_r2_configs")
+		return errors.Wrap(err, "failed to close results of eager load for img_storage_migrations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenant_r2_configs")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_storage_migrations")
 	}
 
-	if len(tenantR2ConfigAfterSelectHooks) != 0 {
+	if len(imgStorageMigrationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1124,9 +1412,9 @@ func (tenantL) LoadTenantR2Config(e boil.Executor, singular bool, maybeTenant in
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.TenantR2Config = foreign
+		object.R.ImgStorageMigration = foreign
 		if foreign.R == nil {
-			foreign.R = &tenantR2ConfigR{}
+			foreign.R = &imgStorageMigrationR{}
 		}
 		foreign.R.Tenant = object
 	}
@@ -1134,9 +1422,9 @@ func (tenantL) LoadTenantR2Config(e boil.Executor, singular bool, maybeTenant in
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
 			if local.ID == foreign.TenantID {
-				local.R.TenantR2Config = foreign
+				local.R.ImgStorageMigration = foreign
 				if foreign.R == nil {
-					foreign.R = &tenantR2ConfigR{}
+					foreign.R = &imgStorageMigrationR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1147,9 +1435,9 @@ func (tenantL) LoadTenantR2Config(e boil.Executor, singular bool, maybeTenant in
 	return nil
 }
 
-// LoadCommentLikes allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadCommentLikes(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+// LoadImgWatermarkSetting allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (tenantL) LoadImgWatermarkSetting(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1186,6 +1474,7 @@ func (tenantL) LoadCommentLikes(e boil.Executor, singular bool, maybeTenant inte
 			if obj.R == nil {
 				obj.R = &tenantR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1202,8 +1491,8 @@ func (tenantL) LoadCommentLikes(e boil.Executor, singular bool, maybeTenant inte
 	}
 
 	query := NewQuery(
-		qm.From(`comment_likes`),
-		qm.WhereIn(`comment_likes.tenant_id in ?`, argsSlice...),
+		qm.From(`img_watermark_settings`),
+		qm.WhereIn(`img_watermark_settings.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1211,45 +1500,48 @@ func (tenantL) LoadCommentLikes(e boil.Executor, singular bool, maybeTenant inte
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load comment_likes")
+		return errors.Wrap(err, "failed to eager load ImgWatermarkSetting")
 	}
 
-	var resultSlice []*CommentLike
+	var resultSlice []*ImgWatermarkSetting
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice comment_likes")
+		return errors.Wrap(err, "failed to bind eager loaded slice ImgWatermarkSetting")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on comment_likes")
+		return errors.Wrap(err, "failed to close results of eager load for img_watermark_settings")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment_likes")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_watermark_settings")
 	}
 
-	if len(commentLikeAfterSelectHooks) != 0 {
+	if len(imgWatermarkSettingAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.CommentLikes = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &commentLikeR{}
-			}
-			foreign.R.Tenant = object
+		foreign := resultSlice[0]
+		object.R.ImgWatermarkSetting = foreign
+		if foreign.R == nil {
+			foreign.R = &imgWatermarkSettingR{}
 		}
-		return nil
+		foreign.R.Tenant = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.TenantID {
-				local.R.CommentLikes = append(local.R.CommentLikes, foreign)
+				local.R.ImgWatermarkSetting = foreign
 				if foreign.R == nil {
-					foreign.R = &commentLikeR{}
+					foreign.R = &imgWatermarkSettingR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1260,9 +1552,9 @@ func (tenantL) LoadCommentLikes(e boil.Executor, singular bool, maybeTenant inte
 	return nil
 }
 
-// LoadCommentPlates allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadCommentPlates(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+// LoadTenantImgSetting allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (tenantL) LoadTenantImgSetting(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1299,6 +1591,7 @@ func (tenantL) LoadCommentPlates(e boil.Executor, singular bool, maybeTenant int
 			if obj.R == nil {
 				obj.R = &tenantR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1315,8 +1608,8 @@ func (tenantL) LoadCommentPlates(e boil.Executor, singular bool, maybeTenant int
 	}
 
 	query := NewQuery(
-		qm.From(`comment_plates`),
-		qm.WhereIn(`comment_plates.tenant_id in ?`, argsSlice...),
+		qm.From(`tenant_img_settings`),
+		qm.WhereIn(`tenant_img_settings.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1324,45 +1617,48 @@ func (tenantL) LoadCommentPlates(e boil.Executor, singular bool, maybeTenant int
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load comment_plates")
+		return errors.Wrap(err, "failed to eager load TenantImgSetting")
 	}
 
-	var resultSlice []*CommentPlate
+	var resultSlice []*TenantImgSetting
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice comment_plates")
+		return errors.Wrap(err, "failed to bind eager loaded slice TenantImgSetting")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on comment_plates")
+		return errors.Wrap(err, "failed to close results of eager load for tenant_img_settings")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment_plates")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenant_img_settings")
 	}
 
-	if len(commentPlateAfterSelectHooks) != 0 {
+	if len(tenantImgSettingAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.CommentPlates = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &commentPlateR{}
-			}
-			foreign.R.Tenant = object
+		foreign := resultSlice[0]
+		object.R.TenantImgSetting = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantImgSettingR{}
 		}
-		return nil
+		foreign.R.Tenant = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.TenantID {
-				local.R.CommentPlates = append(local.R.CommentPlates, foreign)
+				local.R.TenantImgSetting = foreign
 				if foreign.R == nil {
-					foreign.R = &commentPlateR{}
+					foreign.R = &tenantImgSettingR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1373,9 +1669,9 @@ func (tenantL) LoadCommentPlates(e boil.Executor, singular bool, maybeTenant int
 	return nil
 }
 
-// LoadComments allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadComments(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+// LoadTenantStorageConfig allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (tenantL) LoadTenantStorageConfig(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1412,6 +1708,7 @@ func (tenantL) LoadComments(e boil.Executor, singular bool, maybeTenant interfac
 			if obj.R == nil {
 				obj.R = &tenantR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1428,8 +1725,8 @@ func (tenantL) LoadComments(e boil.Executor, singular bool, maybeTenant interfac
 	}
 
 	query := NewQuery(
-		qm.From(`comments`),
-		qm.WhereIn(`comments.tenant_id in ?`, argsSlice...),
+		qm.From(`tenant_storage_configs`),
+		qm.WhereIn(`tenant_storage_configs.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1437,45 +1734,48 @@ func (tenantL) LoadComments(e boil.Executor, singular bool, maybeTenant interfac
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load comments")
+		return errors.Wrap(err, "failed to eager load TenantStorageConfig")
 	}
 
-	var resultSlice []*Comment
+	var resultSlice []*TenantStorageConfig
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+		return errors.Wrap(err, "failed to bind eager loaded slice TenantStorageConfig")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on comments")
+		return errors.Wrap(err, "failed to close results of eager load for tenant_storage_configs")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tenant_storage_configs")
 	}
 
-	if len(commentAfterSelectHooks) != 0 {
+	if len(tenantStorageConfigAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Comments = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &commentR{}
-			}
-			foreign.R.Tenant = object
+		foreign := resultSlice[0]
+		object.R.TenantStorageConfig = foreign
+		if foreign.R == nil {
+			foreign.R = &tenantStorageConfigR{}
 		}
-		return nil
+		foreign.R.Tenant = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.TenantID {
-				local.R.Comments = append(local.R.Comments, foreign)
+				local.R.TenantStorageConfig = foreign
 				if foreign.R == nil {
-					foreign.R = &commentR{}
+					foreign.R = &tenantStorageConfigR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1486,9 +1786,9 @@ func (tenantL) LoadComments(e boil.Executor, singular bool, maybeTenant interfac
 	return nil
 }
 
-// LoadImgCategories allows an eager lookup of values, cached into the
+// LoadCommentLikes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadImgCategories(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadCommentLikes(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1541,8 +1841,8 @@ func (tenantL) LoadImgCategories(e boil.Executor, singular bool, maybeTenant int
 	}
 
 	query := NewQuery(
-		qm.From(`img_categories`),
-		qm.WhereIn(`img_categories.tenant_id in ?`, argsSlice...),
+		qm.From(`comment_likes`),
+		qm.WhereIn(`comment_likes.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1550,22 +1850,22 @@ func (tenantL) LoadImgCategories(e boil.Executor, singular bool, maybeTenant int
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load img_categories")
+		return errors.Wrap(err, "failed to eager load comment_likes")
 	}
 
-	var resultSlice []*ImgCategory
+	var resultSlice []*CommentLike
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice img_categories")
+		return errors.Wrap(err, "failed to bind eager loaded slice comment_likes")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on img_categories")
+		return errors.Wrap(err, "failed to close results in eager load on comment_likes")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_categories")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment_likes")
 	}
 
-	if len(imgCategoryAfterSelectHooks) != 0 {
+	if len(commentLikeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1573,10 +1873,10 @@ func (tenantL) LoadImgCategories(e boil.Executor, singular bool, maybeTenant int
 		}
 	}
 	if singular {
-		object.R.ImgCategories = resultSlice
+		object.R.CommentLikes = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &imgCategoryR{}
+				foreign.R = &commentLikeR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1586,9 +1886,9 @@ func (tenantL) LoadImgCategories(e boil.Executor, singular bool, maybeTenant int
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.ImgCategories = append(local.R.ImgCategories, foreign)
+				local.R.CommentLikes = append(local.R.CommentLikes, foreign)
 				if foreign.R == nil {
-					foreign.R = &imgCategoryR{}
+					foreign.R = &commentLikeR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1599,9 +1899,9 @@ func (tenantL) LoadImgCategories(e boil.Executor, singular bool, maybeTenant int
 	return nil
 }
 
-// LoadImgs allows an eager lookup of values, cached into the
+// LoadCommentPlates allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tenantL) LoadImgs(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+func (tenantL) LoadCommentPlates(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
 	var slice []*Tenant
 	var object *Tenant
 
@@ -1654,9 +1954,8 @@ func (tenantL) LoadImgs(e boil.Executor, singular bool, maybeTenant interface{},
 	}
 
 	query := NewQuery(
-		qm.From(`imgs`),
-		qm.WhereIn(`imgs.tenant_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`imgs.deleted_at`),
+		qm.From(`comment_plates`),
+		qm.WhereIn(`comment_plates.tenant_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1664,22 +1963,22 @@ func (tenantL) LoadImgs(e boil.Executor, singular bool, maybeTenant interface{},
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load imgs")
+		return errors.Wrap(err, "failed to eager load comment_plates")
 	}
 
-	var resultSlice []*Img
+	var resultSlice []*CommentPlate
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice imgs")
+		return errors.Wrap(err, "failed to bind eager loaded slice comment_plates")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on imgs")
+		return errors.Wrap(err, "failed to close results in eager load on comment_plates")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for imgs")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comment_plates")
 	}
 
-	if len(imgAfterSelectHooks) != 0 {
+	if len(commentPlateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -1687,10 +1986,10 @@ func (tenantL) LoadImgs(e boil.Executor, singular bool, maybeTenant interface{},
 		}
 	}
 	if singular {
-		object.R.Imgs = resultSlice
+		object.R.CommentPlates = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &imgR{}
+				foreign.R = &commentPlateR{}
 			}
 			foreign.R.Tenant = object
 		}
@@ -1700,9 +1999,9 @@ func (tenantL) LoadImgs(e boil.Executor, singular bool, maybeTenant interface{},
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.TenantID {
-				local.R.Imgs = append(local.R.Imgs, foreign)
+				local.R.CommentPlates = append(local.R.CommentPlates, foreign)
 				if foreign.R == nil {
-					foreign.R = &imgR{}
+					foreign.R = &commentPlateR{}
 				}
 				foreign.R.Tenant = local
 				break
@@ -1713,188 +2012,1743 @@ func (tenantL) LoadImgs(e boil.Executor, singular bool, maybeTenant interface{},
 	return nil
 }
 
-// SetCreatorG of the tenant to the related item.
-// Sets o.R.Creator to related.
-// Adds o to related.R.CreatorTenant.
-// Uses the global database handle.
-func (o *Tenant) SetCreatorG(insert bool, related *User) error {
-	return o.SetCreator(boil.GetDB(), insert, related)
-}
+// LoadComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadComments(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
 
-// SetCreator of the tenant to the related item.
-// Sets o.R.Creator to related.
-// Adds o to related.R.CreatorTenant.
-func (o *Tenant) SetCreator(exec boil.Executor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"tenants\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
-		strmangle.WhereClause("\"", "\"", 2, tenantPrimaryKeyColumns),
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`comments`),
+		qm.WhereIn(`comments.tenant_id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load comments")
 	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice comments")
 	}
 
-	o.CreatorID = related.ID
-	if o.R == nil {
-		o.R = &tenantR{
-			Creator: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+	}
+
+	if len(commentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.Creator = related
+	}
+	if singular {
+		object.R.Comments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &commentR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			CreatorTenant: o,
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Comments = append(local.R.Comments, foreign)
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
 		}
-	} else {
-		related.R.CreatorTenant = o
 	}
 
 	return nil
 }
 
-// SetCommentTenantConfigG of the tenant to the related item.
-// Sets o.R.CommentTenantConfig to related.
-// Adds o to related.R.Tenant.
-// Uses the global database handle.
-func (o *Tenant) SetCommentTenantConfigG(insert bool, related *CommentTenantConfig) error {
-	return o.SetCommentTenantConfig(boil.GetDB(), insert, related)
-}
-
-// SetCommentTenantConfig of the tenant to the related item.
-// Sets o.R.CommentTenantConfig to related.
-// Adds o to related.R.Tenant.
-func (o *Tenant) SetCommentTenantConfig(exec boil.Executor, insert bool, related *CommentTenantConfig) error {
-	var err error
-
-	if insert {
-		related.TenantID = o.ID
+// LoadImgAlbums allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgAlbums(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
 
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_albums`),
+		qm.WhereIn(`img_albums.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_albums")
+	}
+
+	var resultSlice []*ImgAlbum
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_albums")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_albums")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_albums")
+	}
+
+	if len(imgAlbumAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgAlbums = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgAlbumR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.ImgAlbums = append(local.R.ImgAlbums, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgAlbumR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgCategories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgCategories(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_categories`),
+		qm.WhereIn(`img_categories.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_categories")
+	}
+
+	var resultSlice []*ImgCategory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_categories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_categories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_categories")
+	}
+
+	if len(imgCategoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgCategories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgCategoryR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.ImgCategories = append(local.R.ImgCategories, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgCategoryR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgCategoryJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgCategoryJobs(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_category_jobs`),
+		qm.WhereIn(`img_category_jobs.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_category_jobs")
+	}
+
+	var resultSlice []*ImgCategoryJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_category_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_category_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_category_jobs")
+	}
+
+	if len(imgCategoryJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgCategoryJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgCategoryJobR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.ImgCategoryJobs = append(local.R.ImgCategoryJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgCategoryJobR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgExportJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgExportJobs(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_export_jobs`),
+		qm.WhereIn(`img_export_jobs.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_export_jobs")
+	}
+
+	var resultSlice []*ImgExportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_export_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_export_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_export_jobs")
+	}
+
+	if len(imgExportJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgExportJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgExportJobR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.ImgExportJobs = append(local.R.ImgExportJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgExportJobR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgImportJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgImportJobs(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_import_jobs`),
+		qm.WhereIn(`img_import_jobs.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_import_jobs")
+	}
+
+	var resultSlice []*ImgImportJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_import_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_import_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_import_jobs")
+	}
+
+	if len(imgImportJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgImportJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgImportJobR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.ImgImportJobs = append(local.R.ImgImportJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgImportJobR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgStorageUsages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgStorageUsages(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_storage_usages`),
+		qm.WhereIn(`img_storage_usages.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_storage_usages")
+	}
+
+	var resultSlice []*ImgStorageUsage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_storage_usages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_storage_usages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_storage_usages")
+	}
+
+	if len(imgStorageUsageAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgStorageUsages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgStorageUsageR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.ImgStorageUsages = append(local.R.ImgStorageUsages, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgStorageUsageR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgTags(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`img_tags`),
+		qm.WhereIn(`img_tags.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load img_tags")
+	}
+
+	var resultSlice []*ImgTag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice img_tags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on img_tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for img_tags")
+	}
+
+	if len(imgTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImgTags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgTagR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.ImgTags = append(local.R.ImgTags, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgTagR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImgs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadImgs(e boil.Executor, singular bool, maybeTenant interface{}, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`imgs`),
+		qm.WhereIn(`imgs.tenant_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`imgs.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load imgs")
+	}
+
+	var resultSlice []*Img
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice imgs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on imgs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for imgs")
+	}
+
+	if len(imgAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Imgs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &imgR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Imgs = append(local.R.Imgs, foreign)
+				if foreign.R == nil {
+					foreign.R = &imgR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatorG of the tenant to the related item.
+// Sets o.R.Creator to related.
+// Adds o to related.R.CreatorTenant.
+// Uses the global database handle.
+func (o *Tenant) SetCreatorG(insert bool, related *User) error {
+	return o.SetCreator(boil.GetDB(), insert, related)
+}
+
+// SetCreator of the tenant to the related item.
+// Sets o.R.Creator to related.
+// Adds o to related.R.CreatorTenant.
+func (o *Tenant) SetCreator(exec boil.Executor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"tenants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+		strmangle.WhereClause("\"", "\"", 2, tenantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatorID = related.ID
+	if o.R == nil {
+		o.R = &tenantR{
+			Creator: related,
+		}
+	} else {
+		o.R.Creator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatorTenant: o,
+		}
+	} else {
+		related.R.CreatorTenant = o
+	}
+
+	return nil
+}
+
+// SetCommentTenantConfigG of the tenant to the related item.
+// Sets o.R.CommentTenantConfig to related.
+// Adds o to related.R.Tenant.
+// Uses the global database handle.
+func (o *Tenant) SetCommentTenantConfigG(insert bool, related *CommentTenantConfig) error {
+	return o.SetCommentTenantConfig(boil.GetDB(), insert, related)
+}
+
+// SetCommentTenantConfig of the tenant to the related item.
+// Sets o.R.CommentTenantConfig to related.
+// Adds o to related.R.Tenant.
+func (o *Tenant) SetCommentTenantConfig(exec boil.Executor, insert bool, related *CommentTenantConfig) error {
+	var err error
+
+	if insert {
+		related.TenantID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"comment_tenant_configs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+			strmangle.WhereClause("\"", "\"", 2, commentTenantConfigPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.TenantID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.TenantID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			CommentTenantConfig: related,
+		}
+	} else {
+		o.R.CommentTenantConfig = related
+	}
+
+	if related.R == nil {
+		related.R = &commentTenantConfigR{
+			Tenant: o,
+		}
+	} else {
+		related.R.Tenant = o
+	}
+	return nil
+}
+
+// SetImgStorageMigrationG of the tenant to the related item.
+// Sets o.R.ImgStorageMigration to related.
+// Adds o to related.R.Tenant.
+// Uses the global database handle.
+func (o *Tenant) SetImgStorageMigrationG(insert bool, related *ImgStorageMigration) error {
+	return o.SetImgStorageMigration(boil.GetDB(), insert, related)
+}
+
+// SetImgStorageMigration of the tenant to the related item.
+// Sets o.R.ImgStorageMigration to related.
+// Adds o to related.R.Tenant.
+func (o *Tenant) SetImgStorageMigration(exec boil.Executor, insert bool, related *ImgStorageMigration) error {
+	var err error
+
+	if insert {
+		related.TenantID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"img_storage_migrations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+			strmangle.WhereClause("\"", "\"", 2, imgStorageMigrationPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.TenantID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			ImgStorageMigration: related,
+		}
+	} else {
+		o.R.ImgStorageMigration = related
+	}
+
+	if related.R == nil {
+		related.R = &imgStorageMigrationR{
+			Tenant: o,
+		}
+	} else {
+		related.R.Tenant = o
+	}
+	return nil
+}
+
+// SetImgWatermarkSettingG of the tenant to the related item.
+// Sets o.R.ImgWatermarkSetting to related.
+// Adds o to related.R.Tenant.
+// Uses the global database handle.
+func (o *Tenant) SetImgWatermarkSettingG(insert bool, related *ImgWatermarkSetting) error {
+	return o.SetImgWatermarkSetting(boil.GetDB(), insert, related)
+}
+
+// SetImgWatermarkSetting of the tenant to the related item.
+// Sets o.R.ImgWatermarkSetting to related.
+// Adds o to related.R.Tenant.
+func (o *Tenant) SetImgWatermarkSetting(exec boil.Executor, insert bool, related *ImgWatermarkSetting) error {
+	var err error
+
+	if insert {
+		related.TenantID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
 		}
 	} else {
 		updateQuery := fmt.Sprintf(
-			"UPDATE \"comment_tenant_configs\" SET %s WHERE %s",
+			"UPDATE \"img_watermark_settings\" SET %s WHERE %s",
 			strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-			strmangle.WhereClause("\"", "\"", 2, commentTenantConfigPrimaryKeyColumns),
+			strmangle.WhereClause("\"", "\"", 2, imgWatermarkSettingPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.TenantID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.TenantID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			ImgWatermarkSetting: related,
+		}
+	} else {
+		o.R.ImgWatermarkSetting = related
+	}
+
+	if related.R == nil {
+		related.R = &imgWatermarkSettingR{
+			Tenant: o,
+		}
+	} else {
+		related.R.Tenant = o
+	}
+	return nil
+}
+
+// SetTenantImgSettingG of the tenant to the related item.
+// Sets o.R.TenantImgSetting to related.
+// Adds o to related.R.Tenant.
+// Uses the global database handle.
+func (o *Tenant) SetTenantImgSettingG(insert bool, related *TenantImgSetting) error {
+	return o.SetTenantImgSetting(boil.GetDB(), insert, related)
+}
+
+// SetTenantImgSetting of the tenant to the related item.
+// Sets o.R.TenantImgSetting to related.
+// Adds o to related.R.Tenant.
+func (o *Tenant) SetTenantImgSetting(exec boil.Executor, insert bool, related *TenantImgSetting) error {
+	var err error
+
+	if insert {
+		related.TenantID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"tenant_img_settings\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+			strmangle.WhereClause("\"", "\"", 2, tenantImgSettingPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.TenantID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.TenantID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			TenantImgSetting: related,
+		}
+	} else {
+		o.R.TenantImgSetting = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantImgSettingR{
+			Tenant: o,
+		}
+	} else {
+		related.R.Tenant = o
+	}
+	return nil
+}
+
+// SetTenantStorageConfigG of the tenant to the related item.
+// Sets o.R.TenantStorageConfig to related.
+// Adds o to related.R.Tenant.
+// Uses the global database handle.
+func (o *Tenant) SetTenantStorageConfigG(insert bool, related *TenantStorageConfig) error {
+	return o.SetTenantStorageConfig(boil.GetDB(), insert, related)
+}
+
+// SetTenantStorageConfig of the tenant to the related item.
+// Sets o.R.TenantStorageConfig to related.
+// Adds o to related.R.Tenant.
+func (o *Tenant) SetTenantStorageConfig(exec boil.Executor, insert bool, related *TenantStorageConfig) error {
+	var err error
+
+	if insert {
+		related.TenantID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"tenant_storage_configs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+			strmangle.WhereClause("\"", "\"", 2, tenantStorageConfigPrimaryKeyColumns),
 		)
 		values := []interface{}{o.ID, related.TenantID}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.TenantID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			TenantStorageConfig: related,
+		}
+	} else {
+		o.R.TenantStorageConfig = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantStorageConfigR{
+			Tenant: o,
+		}
+	} else {
+		related.R.Tenant = o
+	}
+	return nil
+}
+
+// AddCommentLikesG adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.CommentLikes.
+// Sets related.R.Tenant appropriately.
+// Uses the global database handle.
+func (o *Tenant) AddCommentLikesG(insert bool, related ...*CommentLike) error {
+	return o.AddCommentLikes(boil.GetDB(), insert, related...)
+}
+
+// AddCommentLikes adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.CommentLikes.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddCommentLikes(exec boil.Executor, insert bool, related ...*CommentLike) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comment_likes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentLikePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TenantID, rel.UserID, rel.CommentID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			CommentLikes: related,
+		}
+	} else {
+		o.R.CommentLikes = append(o.R.CommentLikes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentLikeR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddCommentPlatesG adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.CommentPlates.
+// Sets related.R.Tenant appropriately.
+// Uses the global database handle.
+func (o *Tenant) AddCommentPlatesG(insert bool, related ...*CommentPlate) error {
+	return o.AddCommentPlates(boil.GetDB(), insert, related...)
+}
+
+// AddCommentPlates adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.CommentPlates.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddCommentPlates(exec boil.Executor, insert bool, related ...*CommentPlate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comment_plates\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentPlatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			CommentPlates: related,
+		}
+	} else {
+		o.R.CommentPlates = append(o.R.CommentPlates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentPlateR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddCommentsG adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.Tenant appropriately.
+// Uses the global database handle.
+func (o *Tenant) AddCommentsG(insert bool, related ...*Comment) error {
+	return o.AddComments(boil.GetDB(), insert, related...)
+}
+
+// AddComments adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddComments(exec boil.Executor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
 		}
-		if _, err = exec.Exec(updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Comments: related,
 		}
+	} else {
+		o.R.Comments = append(o.R.Comments, related...)
+	}
 
-		related.TenantID = o.ID
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
+// AddImgAlbumsG adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.ImgAlbums.
+// Sets related.R.Tenant appropriately.
+// Uses the global database handle.
+func (o *Tenant) AddImgAlbumsG(insert bool, related ...*ImgAlbum) error {
+	return o.AddImgAlbums(boil.GetDB(), insert, related...)
+}
+
+// AddImgAlbums adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.ImgAlbums.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddImgAlbums(exec boil.Executor, insert bool, related ...*ImgAlbum) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_albums\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgAlbumPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
 	}
 
 	if o.R == nil {
 		o.R = &tenantR{
-			CommentTenantConfig: related,
+			ImgAlbums: related,
 		}
 	} else {
-		o.R.CommentTenantConfig = related
+		o.R.ImgAlbums = append(o.R.ImgAlbums, related...)
 	}
 
-	if related.R == nil {
-		related.R = &commentTenantConfigR{
-			Tenant: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgAlbumR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
 		}
-	} else {
-		related.R.Tenant = o
 	}
 	return nil
 }
 
-// SetTenantR2ConfigG of the tenant to the related item.
-// Sets o.R.TenantR2Config to related.
-// Adds o to related.R.Tenant.
+// AddImgCategoriesG adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.ImgCategories.
+// Sets related.R.Tenant appropriately.
 // Uses the global database handle.
-func (o *Tenant) SetTenantR2ConfigG(insert bool, related *TenantR2Config) error {
-	return o.SetTenantR2Config(boil.GetDB(), insert, related)
+func (o *Tenant) AddImgCategoriesG(insert bool, related ...*ImgCategory) error {
+	return o.AddImgCategories(boil.GetDB(), insert, related...)
 }
 
-// SetTenantR2Config of the tenant to the related item.
-// Sets o.R.TenantR2Config to related.
-// Adds o to related.R.Tenant.
-func (o *Tenant) SetTenantR2Config(exec boil.Executor, insert bool, related *TenantR2Config) error {
+// AddImgCategories adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.ImgCategories.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddImgCategories(exec boil.Executor, insert bool, related ...*ImgCategory) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_categories\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgCategoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if insert {
-		related.TenantID = o.ID
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			rel.TenantID = o.ID
 		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"tenant_r2_configs\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-			strmangle.WhereClause("\"", "\"", 2, tenantR2ConfigPrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.TenantID}
+	}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
+	if o.R == nil {
+		o.R = &tenantR{
+			ImgCategories: related,
 		}
-		if _, err = exec.Exec(updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+	} else {
+		o.R.ImgCategories = append(o.R.ImgCategories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgCategoryR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
 		}
+	}
+	return nil
+}
 
-		related.TenantID = o.ID
+// AddImgCategoryJobsG adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.ImgCategoryJobs.
+// Sets related.R.Tenant appropriately.
+// Uses the global database handle.
+func (o *Tenant) AddImgCategoryJobsG(insert bool, related ...*ImgCategoryJob) error {
+	return o.AddImgCategoryJobs(boil.GetDB(), insert, related...)
+}
+
+// AddImgCategoryJobs adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.ImgCategoryJobs.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddImgCategoryJobs(exec boil.Executor, insert bool, related ...*ImgCategoryJob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"img_category_jobs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imgCategoryJobPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
 	}
 
 	if o.R == nil {
 		o.R = &tenantR{
-			TenantR2Config: related,
+			ImgCategoryJobs: related,
 		}
 	} else {
-		o.R.TenantR2Config = related
+		o.R.ImgCategoryJobs = append(o.R.ImgCategoryJobs, related...)
 	}
 
-	if related.R == nil {
-		related.R = &tenantR2ConfigR{
-			Tenant: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imgCategoryJobR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
 		}
-	} else {
-		related.R.Tenant = o
 	}
 	return nil
 }
 
-// AddCommentLikesG adds the given related objects to the existing relationships
+// AddImgExportJobsG adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.CommentLikes.
+// Appends related to o.R.ImgExportJobs.
 // Sets related.R.Tenant appropriately.
 // Uses the global database handle.
-func (o *Tenant) AddCommentLikesG(insert bool, related ...*CommentLike) error {
-	return o.AddCommentLikes(boil.GetDB(), insert, related...)
+func (o *Tenant) AddImgExportJobsG(insert bool, related ...*ImgExportJob) error {
+	return o.AddImgExportJobs(boil.GetDB(), insert, related...)
 }
 
-// AddCommentLikes adds the given related objects to the existing relationships
+// AddImgExportJobs adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.CommentLikes.
+// Appends related to o.R.ImgExportJobs.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddCommentLikes(exec boil.Executor, insert bool, related ...*CommentLike) error {
+func (o *Tenant) AddImgExportJobs(exec boil.Executor, insert bool, related ...*ImgExportJob) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1904,11 +3758,11 @@ func (o *Tenant) AddCommentLikes(exec boil.Executor, insert bool, related ...*Co
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"comment_likes\" SET %s WHERE %s",
+				"UPDATE \"img_export_jobs\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, commentLikePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, imgExportJobPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.TenantID, rel.UserID, rel.CommentID}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.DebugMode {
 				fmt.Fprintln(boil.DebugWriter, updateQuery)
@@ -1924,15 +3778,15 @@ func (o *Tenant) AddCommentLikes(exec boil.Executor, insert bool, related ...*Co
 
 	if o.R == nil {
 		o.R = &tenantR{
-			CommentLikes: related,
+			ImgExportJobs: related,
 		}
 	} else {
-		o.R.CommentLikes = append(o.R.CommentLikes, related...)
+		o.R.ImgExportJobs = append(o.R.ImgExportJobs, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentLikeR{
+			rel.R = &imgExportJobR{
 				Tenant: o,
 			}
 		} else {
@@ -1942,20 +3796,20 @@ func (o *Tenant) AddCommentLikes(exec boil.Executor, insert bool, related ...*Co
 	return nil
 }
 
-// AddCommentPlatesG adds the given related objects to the existing relationships
+// AddImgImportJobsG adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.CommentPlates.
+// Appends related to o.R.ImgImportJobs.
 // Sets related.R.Tenant appropriately.
 // Uses the global database handle.
-func (o *Tenant) AddCommentPlatesG(insert bool, related ...*CommentPlate) error {
-	return o.AddCommentPlates(boil.GetDB(), insert, related...)
+func (o *Tenant) AddImgImportJobsG(insert bool, related ...*ImgImportJob) error {
+	return o.AddImgImportJobs(boil.GetDB(), insert, related...)
 }
 
-// AddCommentPlates adds the given related objects to the existing relationships
+// AddImgImportJobs adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.CommentPlates.
+// Appends related to o.R.ImgImportJobs.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddCommentPlates(exec boil.Executor, insert bool, related ...*CommentPlate) error {
+func (o *Tenant) AddImgImportJobs(exec boil.Executor, insert bool, related ...*ImgImportJob) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1965,9 +3819,9 @@ func (o *Tenant) AddCommentPlates(exec boil.Executor, insert bool, related ...*C
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"comment_plates\" SET %s WHERE %s",
+				"UPDATE \"img_import_jobs\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, commentPlatePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, imgImportJobPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1985,15 +3839,15 @@ func (o *Tenant) AddCommentPlates(exec boil.Executor, insert bool, related ...*C
 
 	if o.R == nil {
 		o.R = &tenantR{
-			CommentPlates: related,
+			ImgImportJobs: related,
 		}
 	} else {
-		o.R.CommentPlates = append(o.R.CommentPlates, related...)
+		o.R.ImgImportJobs = append(o.R.ImgImportJobs, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentPlateR{
+			rel.R = &imgImportJobR{
 				Tenant: o,
 			}
 		} else {
@@ -2003,20 +3857,20 @@ func (o *Tenant) AddCommentPlates(exec boil.Executor, insert bool, related ...*C
 	return nil
 }
 
-// AddCommentsG adds the given related objects to the existing relationships
+// AddImgStorageUsagesG adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Comments.
+// Appends related to o.R.ImgStorageUsages.
 // Sets related.R.Tenant appropriately.
 // Uses the global database handle.
-func (o *Tenant) AddCommentsG(insert bool, related ...*Comment) error {
-	return o.AddComments(boil.GetDB(), insert, related...)
+func (o *Tenant) AddImgStorageUsagesG(insert bool, related ...*ImgStorageUsage) error {
+	return o.AddImgStorageUsages(boil.GetDB(), insert, related...)
 }
 
-// AddComments adds the given related objects to the existing relationships
+// AddImgStorageUsages adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.Comments.
+// Appends related to o.R.ImgStorageUsages.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddComments(exec boil.Executor, insert bool, related ...*Comment) error {
+func (o *Tenant) AddImgStorageUsages(exec boil.Executor, insert bool, related ...*ImgStorageUsage) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2026,9 +3880,9 @@ func (o *Tenant) AddComments(exec boil.Executor, insert bool, related ...*Commen
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"comments\" SET %s WHERE %s",
+				"UPDATE \"img_storage_usages\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, imgStorageUsagePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2046,15 +3900,15 @@ func (o *Tenant) AddComments(exec boil.Executor, insert bool, related ...*Commen
 
 	if o.R == nil {
 		o.R = &tenantR{
-			Comments: related,
+			ImgStorageUsages: related,
 		}
 	} else {
-		o.R.Comments = append(o.R.Comments, related...)
+		o.R.ImgStorageUsages = append(o.R.ImgStorageUsages, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentR{
+			rel.R = &imgStorageUsageR{
 				Tenant: o,
 			}
 		} else {
@@ -2064,20 +3918,20 @@ func (o *Tenant) AddComments(exec boil.Executor, insert bool, related ...*Commen
 	return nil
 }
 
-// AddImgCategoriesG adds the given related objects to the existing relationships
+// AddImgTagsG adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.ImgCategories.
+// Appends related to o.R.ImgTags.
 // Sets related.R.Tenant appropriately.
 // Uses the global database handle.
-func (o *Tenant) AddImgCategoriesG(insert bool, related ...*ImgCategory) error {
-	return o.AddImgCategories(boil.GetDB(), insert, related...)
+func (o *Tenant) AddImgTagsG(insert bool, related ...*ImgTag) error {
+	return o.AddImgTags(boil.GetDB(), insert, related...)
 }
 
-// AddImgCategories adds the given related objects to the existing relationships
+// AddImgTags adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
-// Appends related to o.R.ImgCategories.
+// Appends related to o.R.ImgTags.
 // Sets related.R.Tenant appropriately.
-func (o *Tenant) AddImgCategories(exec boil.Executor, insert bool, related ...*ImgCategory) error {
+func (o *Tenant) AddImgTags(exec boil.Executor, insert bool, related ...*ImgTag) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2087,9 +3941,9 @@ func (o *Tenant) AddImgCategories(exec boil.Executor, insert bool, related ...*I
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"img_categories\" SET %s WHERE %s",
+				"UPDATE \"img_tags\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
-				strmangle.WhereClause("\"", "\"", 2, imgCategoryPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, imgTagPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2107,15 +3961,15 @@ func (o *Tenant) AddImgCategories(exec boil.Executor, insert bool, related ...*I
 
 	if o.R == nil {
 		o.R = &tenantR{
-			ImgCategories: related,
+			ImgTags: related,
 		}
 	} else {
-		o.R.ImgCategories = append(o.R.ImgCategories, related...)
+		o.R.ImgTags = append(o.R.ImgTags, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &imgCategoryR{
+			rel.R = &imgTagR{
 				Tenant: o,
 			}
 		} else {
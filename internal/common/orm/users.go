@@ -23,111 +23,116 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Nickname     string      `boil:"nickname" json:"nickname" toml:"nickname" yaml:"nickname"`
-	Email        string      `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Avatar       string      `boil:"avatar" json:"avatar" toml:"avatar" yaml:"avatar"`
-	GithubID     null.String `boil:"github_id" json:"github_id,omitempty" toml:"github_id" yaml:"github_id,omitempty"`
-	GoogleID     null.String `boil:"google_id" json:"google_id,omitempty" toml:"google_id" yaml:"google_id,omitempty"`
-	PasswordHash null.String `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
-	LastLoginAt  time.Time   `boil:"last_login_at" json:"last_login_at" toml:"last_login_at" yaml:"last_login_at"`
-	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Nickname        string      `boil:"nickname" json:"nickname" toml:"nickname" yaml:"nickname"`
+	Email           string      `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Avatar          string      `boil:"avatar" json:"avatar" toml:"avatar" yaml:"avatar"`
+	PasswordHash    null.String `boil:"password_hash" json:"password_hash,omitempty" toml:"password_hash" yaml:"password_hash,omitempty"`
+	IsPlatformAdmin bool        `boil:"is_platform_admin" json:"is_platform_admin" toml:"is_platform_admin" yaml:"is_platform_admin"`
+	LastLoginAt     time.Time   `boil:"last_login_at" json:"last_login_at" toml:"last_login_at" yaml:"last_login_at"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID           string
-	Nickname     string
-	Email        string
-	Avatar       string
-	GithubID     string
-	GoogleID     string
-	PasswordHash string
-	LastLoginAt  string
-	CreatedAt    string
-	UpdatedAt    string
+	ID              string
+	Nickname        string
+	Email           string
+	Avatar          string
+	PasswordHash    string
+	IsPlatformAdmin string
+	LastLoginAt     string
+	CreatedAt       string
+	UpdatedAt       string
 }{
-	ID:           "id",
-	Nickname:     "nickname",
-	Email:        "email",
-	Avatar:       "avatar",
-	GithubID:     "github_id",
-	GoogleID:     "google_id",
-	PasswordHash: "password_hash",
-	LastLoginAt:  "last_login_at",
-	CreatedAt:    "created_at",
-	UpdatedAt:    "updated_at",
+	ID:              "id",
+	Nickname:        "nickname",
+	Email:           "email",
+	Avatar:          "avatar",
+	PasswordHash:    "password_hash",
+	IsPlatformAdmin: "is_platform_admin",
+	LastLoginAt:     "last_login_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
 }
 
 var UserTableColumns = struct {
-	ID           string
-	Nickname     string
-	Email        string
-	Avatar       string
-	GithubID     string
-	GoogleID     string
-	PasswordHash string
-	LastLoginAt  string
-	CreatedAt    string
-	UpdatedAt    string
+	ID              string
+	Nickname        string
+	Email           string
+	Avatar          string
+	PasswordHash    string
+	IsPlatformAdmin string
+	LastLoginAt     string
+	CreatedAt       string
+	UpdatedAt       string
 }{
-	ID:           "users.id",
-	Nickname:     "users.nickname",
-	Email:        "users.email",
-	Avatar:       "users.avatar",
-	GithubID:     "users.github_id",
-	GoogleID:     "users.google_id",
-	PasswordHash: "users.password_hash",
-	LastLoginAt:  "users.last_login_at",
-	CreatedAt:    "users.created_at",
-	UpdatedAt:    "users.updated_at",
+	ID:              "users.id",
+	Nickname:        "users.nickname",
+	Email:           "users.email",
+	Avatar:          "users.avatar",
+	PasswordHash:    "users.password_hash",
+	IsPlatformAdmin: "users.is_platform_admin",
+	LastLoginAt:     "users.last_login_at",
+	CreatedAt:       "users.created_at",
+	UpdatedAt:       "users.updated_at",
 }
 
 // Generated where
 
 var UserWhere = struct {
-	ID           whereHelperstring
-	Nickname     whereHelperstring
-	Email        whereHelperstring
-	Avatar       whereHelperstring
-	GithubID     whereHelpernull_String
-	GoogleID     whereHelpernull_String
-	PasswordHash whereHelpernull_String
-	LastLoginAt  whereHelpertime_Time
-	CreatedAt    whereHelpertime_Time
-	UpdatedAt    whereHelpertime_Time
+	ID              whereHelperstring
+	Nickname        whereHelperstring
+	Email           whereHelperstring
+	Avatar          whereHelperstring
+	PasswordHash    whereHelpernull_String
+	IsPlatformAdmin whereHelperbool
+	LastLoginAt     whereHelpertime_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
 }{
-	ID:           whereHelperstring{field: "\"users\".\"id\""},
-	Nickname:     whereHelperstring{field: "\"users\".\"nickname\""},
-	Email:        whereHelperstring{field: "\"users\".\"email\""},
-	Avatar:       whereHelperstring{field: "\"users\".\"avatar\""},
-	GithubID:     whereHelpernull_String{field: "\"users\".\"github_id\""},
-	GoogleID:     whereHelpernull_String{field: "\"users\".\"google_id\""},
-	PasswordHash: whereHelpernull_String{field: "\"users\".\"password_hash\""},
-	LastLoginAt:  whereHelpertime_Time{field: "\"users\".\"last_login_at\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"users\".\"created_at\""},
-	UpdatedAt:    whereHelpertime_Time{field: "\"users\".\"updated_at\""},
+	ID:              whereHelperstring{field: "\"users\".\"id\""},
+	Nickname:        whereHelperstring{field: "\"users\".\"nickname\""},
+	Email:           whereHelperstring{field: "\"users\".\"email\""},
+	Avatar:          whereHelperstring{field: "\"users\".\"avatar\""},
+	PasswordHash:    whereHelpernull_String{field: "\"users\".\"password_hash\""},
+	IsPlatformAdmin: whereHelperbool{field: "\"users\".\"is_platform_admin\""},
+	LastLoginAt:     whereHelpertime_Time{field: "\"users\".\"last_login_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"users\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"users\".\"updated_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	CreatorTenant string
-	CommentLikes  string
-	Comments      string
+	CreatorTenant          string
+	CommentLikes           string
+	Comments               string
+	PersonalAccessTokens   string
+	AdminPlatformAuditLogs string
+	UserIdentities         string
+	UserLoginEvents        string
 }{
-	CreatorTenant: "CreatorTenant",
-	CommentLikes:  "CommentLikes",
-	Comments:      "Comments",
+	CreatorTenant:          "CreatorTenant",
+	CommentLikes:           "CommentLikes",
+	Comments:               "Comments",
+	PersonalAccessTokens:   "PersonalAccessTokens",
+	AdminPlatformAuditLogs: "AdminPlatformAuditLogs",
+	UserIdentities:         "UserIdentities",
+	UserLoginEvents:        "UserLoginEvents",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	CreatorTenant *Tenant          `boil:"CreatorTenant" json:"CreatorTenant" toml:"CreatorTenant" yaml:"CreatorTenant"`
-	CommentLikes  CommentLikeSlice `boil:"CommentLikes" json:"CommentLikes" toml:"CommentLikes" yaml:"CommentLikes"`
-	Comments      CommentSlice     `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	CreatorTenant          *Tenant                  `boil:"CreatorTenant" json:"CreatorTenant" toml:"CreatorTenant" yaml:"CreatorTenant"`
+	CommentLikes           CommentLikeSlice         `boil:"CommentLikes" json:"CommentLikes" toml:"CommentLikes" yaml:"CommentLikes"`
+	Comments               CommentSlice             `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	PersonalAccessTokens   PersonalAccessTokenSlice `boil:"PersonalAccessTokens" json:"PersonalAccessTokens" toml:"PersonalAccessTokens" yaml:"PersonalAccessTokens"`
+	AdminPlatformAuditLogs PlatformAuditLogSlice    `boil:"AdminPlatformAuditLogs" json:"AdminPlatformAuditLogs" toml:"AdminPlatformAuditLogs" yaml:"AdminPlatformAuditLogs"`
+	UserIdentities         UserIdentitySlice        `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
+	UserLoginEvents        UserLoginEventSlice      `boil:"UserLoginEvents" json:"UserLoginEvents" toml:"UserLoginEvents" yaml:"UserLoginEvents"`
 }
 
 // NewStruct creates a new relationship struct
@@ -183,13 +188,77 @@ func (r *userR) GetComments() CommentSlice {
 	return r.Comments
 }
 
+func (o *User) GetPersonalAccessTokens() PersonalAccessTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPersonalAccessTokens()
+}
+
+func (r *userR) GetPersonalAccessTokens() PersonalAccessTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PersonalAccessTokens
+}
+
+func (o *User) GetAdminPlatformAuditLogs() PlatformAuditLogSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAdminPlatformAuditLogs()
+}
+
+func (r *userR) GetAdminPlatformAuditLogs() PlatformAuditLogSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AdminPlatformAuditLogs
+}
+
+func (o *User) GetUserIdentities() UserIdentitySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserIdentities()
+}
+
+func (r *userR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserIdentities
+}
+
+func (o *User) GetUserLoginEvents() UserLoginEventSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserLoginEvents()
+}
+
+func (r *userR) GetUserLoginEvents() UserLoginEventSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserLoginEvents
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "nickname", "email", "avatar", "github_id", "google_id", "password_hash", "last_login_at", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "nickname", "email", "avatar", "password_hash", "is_platform_admin", "last_login_at", "created_at", "updated_at"}
 	userColumnsWithoutDefault = []string{"nickname", "email", "avatar", "last_login_at"}
-	userColumnsWithDefault    = []string{"id", "github_id", "google_id", "password_hash", "created_at", "updated_at"}
+	userColumnsWithDefault    = []string{"id", "password_hash", "is_platform_admin", "created_at", "updated_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -522,6 +591,62 @@ func (o *User) Comments(mods ...qm.QueryMod) commentQuery {
 	return Comments(queryMods...)
 }
 
+// PersonalAccessTokens retrieves all the personal_access_token's PersonalAccessTokens with an executor.
+func (o *User) PersonalAccessTokens(mods ...qm.QueryMod) personalAccessTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"personal_access_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return PersonalAccessTokens(queryMods...)
+}
+
+// AdminPlatformAuditLogs retrieves all the platform_audit_log's PlatformAuditLogs with an executor via admin_id column.
+func (o *User) AdminPlatformAuditLogs(mods ...qm.QueryMod) platformAuditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"platform_audit_logs\".\"admin_id\"=?", o.ID),
+	)
+
+	return PlatformAuditLogs(queryMods...)
+}
+
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *User) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identities\".\"user_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
+// UserLoginEvents retrieves all the user_login_event's UserLoginEvents with an executor.
+func (o *User) UserLoginEvents(mods ...qm.QueryMod) userLoginEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_login_events\".\"user_id\"=?", o.ID),
+	)
+
+	return UserLoginEvents(queryMods...)
+}
+
 // LoadCreatorTenant allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (userL) LoadCreatorTenant(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -865,129 +990,581 @@ func (userL) LoadComments(e boil.Executor, singular bool, maybeUser interface{},
 	return nil
 }
 
-// SetCreatorTenantG of the user to the related item.
-// Sets o.R.CreatorTenant to related.
-// Adds o to related.R.Creator.
-// Uses the global database handle.
-func (o *User) SetCreatorTenantG(insert bool, related *Tenant) error {
-	return o.SetCreatorTenant(boil.GetDB(), insert, related)
-}
-
-// SetCreatorTenant of the user to the related item.
-// Sets o.R.CreatorTenant to related.
-// Adds o to related.R.Creator.
-func (o *User) SetCreatorTenant(exec boil.Executor, insert bool, related *Tenant) error {
-	var err error
-
-	if insert {
-		related.CreatorID = o.ID
+// LoadPersonalAccessTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPersonalAccessTokens(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
 	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"tenants\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
-			strmangle.WhereClause("\"", "\"", 2, tenantPrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.ID}
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, updateQuery)
-			fmt.Fprintln(boil.DebugWriter, values)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
-		if _, err = exec.Exec(updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
+	}
 
-		related.CreatorID = o.ID
+	if len(args) == 0 {
+		return nil
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			CreatorTenant: related,
-		}
-	} else {
-		o.R.CreatorTenant = related
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	if related.R == nil {
-		related.R = &tenantR{
-			Creator: o,
-		}
-	} else {
-		related.R.Creator = o
+	query := NewQuery(
+		qm.From(`personal_access_tokens`),
+		qm.WhereIn(`personal_access_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// AddCommentLikesG adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.CommentLikes.
-// Sets related.R.User appropriately.
-// Uses the global database handle.
-func (o *User) AddCommentLikesG(insert bool, related ...*CommentLike) error {
-	return o.AddCommentLikes(boil.GetDB(), insert, related...)
-}
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load personal_access_tokens")
+	}
 
-// AddCommentLikes adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.CommentLikes.
-// Sets related.R.User appropriately.
-func (o *User) AddCommentLikes(exec boil.Executor, insert bool, related ...*CommentLike) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"comment_likes\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, commentLikePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.TenantID, rel.UserID, rel.CommentID}
+	var resultSlice []*PersonalAccessToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice personal_access_tokens")
+	}
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
-			}
-			if _, err = exec.Exec(updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
-			}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on personal_access_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for personal_access_tokens")
+	}
 
-			rel.UserID = o.ID
+	if len(personalAccessTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
 		}
 	}
-
-	if o.R == nil {
-		o.R = &userR{
-			CommentLikes: related,
+	if singular {
+		object.R.PersonalAccessTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &personalAccessTokenR{}
+			}
+			foreign.R.User = object
 		}
-	} else {
-		o.R.CommentLikes = append(o.R.CommentLikes, related...)
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &commentLikeR{
-				User: o,
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PersonalAccessTokens = append(local.R.PersonalAccessTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &personalAccessTokenR{}
+				}
+				foreign.R.User = local
+				break
 			}
-		} else {
-			rel.R.User = o
 		}
 	}
+
 	return nil
 }
 
-// AddCommentsG adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Comments.
-// Sets related.R.User appropriately.
-// Uses the global database handle.
+// LoadAdminPlatformAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAdminPlatformAuditLogs(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`platform_audit_logs`),
+		qm.WhereIn(`platform_audit_logs.admin_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load platform_audit_logs")
+	}
+
+	var resultSlice []*PlatformAuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice platform_audit_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on platform_audit_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for platform_audit_logs")
+	}
+
+	if len(platformAuditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AdminPlatformAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &platformAuditLogR{}
+			}
+			foreign.R.Admin = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AdminID {
+				local.R.AdminPlatformAuditLogs = append(local.R.AdminPlatformAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &platformAuditLogR{}
+				}
+				foreign.R.Admin = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserIdentities(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_identities`),
+		qm.WhereIn(`user_identities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identities")
+	}
+
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identities")
+	}
+
+	if len(userIdentityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserLoginEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserLoginEvents(e boil.Executor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_login_events`),
+		qm.WhereIn(`user_login_events.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_login_events")
+	}
+
+	var resultSlice []*UserLoginEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_login_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_login_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_login_events")
+	}
+
+	if len(userLoginEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserLoginEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userLoginEventR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserLoginEvents = append(local.R.UserLoginEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &userLoginEventR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatorTenantG of the user to the related item.
+// Sets o.R.CreatorTenant to related.
+// Adds o to related.R.Creator.
+// Uses the global database handle.
+func (o *User) SetCreatorTenantG(insert bool, related *Tenant) error {
+	return o.SetCreatorTenant(boil.GetDB(), insert, related)
+}
+
+// SetCreatorTenant of the user to the related item.
+// Sets o.R.CreatorTenant to related.
+// Adds o to related.R.Creator.
+func (o *User) SetCreatorTenant(exec boil.Executor, insert bool, related *Tenant) error {
+	var err error
+
+	if insert {
+		related.CreatorID = o.ID
+
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"tenants\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+			strmangle.WhereClause("\"", "\"", 2, tenantPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+		if _, err = exec.Exec(updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.CreatorID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatorTenant: related,
+		}
+	} else {
+		o.R.CreatorTenant = related
+	}
+
+	if related.R == nil {
+		related.R = &tenantR{
+			Creator: o,
+		}
+	} else {
+		related.R.Creator = o
+	}
+	return nil
+}
+
+// AddCommentLikesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CommentLikes.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddCommentLikesG(insert bool, related ...*CommentLike) error {
+	return o.AddCommentLikes(boil.GetDB(), insert, related...)
+}
+
+// AddCommentLikes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CommentLikes.
+// Sets related.R.User appropriately.
+func (o *User) AddCommentLikes(exec boil.Executor, insert bool, related ...*CommentLike) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comment_likes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentLikePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TenantID, rel.UserID, rel.CommentID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CommentLikes: related,
+		}
+	} else {
+		o.R.CommentLikes = append(o.R.CommentLikes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentLikeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCommentsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
 func (o *User) AddCommentsG(insert bool, related ...*Comment) error {
 	return o.AddComments(boil.GetDB(), insert, related...)
 }
@@ -1044,6 +1621,250 @@ func (o *User) AddComments(exec boil.Executor, insert bool, related ...*Comment)
 	return nil
 }
 
+// AddPersonalAccessTokensG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PersonalAccessTokens.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddPersonalAccessTokensG(insert bool, related ...*PersonalAccessToken) error {
+	return o.AddPersonalAccessTokens(boil.GetDB(), insert, related...)
+}
+
+// AddPersonalAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PersonalAccessTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPersonalAccessTokens(exec boil.Executor, insert bool, related ...*PersonalAccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"personal_access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, personalAccessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PersonalAccessTokens: related,
+		}
+	} else {
+		o.R.PersonalAccessTokens = append(o.R.PersonalAccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &personalAccessTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAdminPlatformAuditLogsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AdminPlatformAuditLogs.
+// Sets related.R.Admin appropriately.
+// Uses the global database handle.
+func (o *User) AddAdminPlatformAuditLogsG(insert bool, related ...*PlatformAuditLog) error {
+	return o.AddAdminPlatformAuditLogs(boil.GetDB(), insert, related...)
+}
+
+// AddAdminPlatformAuditLogs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AdminPlatformAuditLogs.
+// Sets related.R.Admin appropriately.
+func (o *User) AddAdminPlatformAuditLogs(exec boil.Executor, insert bool, related ...*PlatformAuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AdminID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"platform_audit_logs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"admin_id"}),
+				strmangle.WhereClause("\"", "\"", 2, platformAuditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AdminID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AdminPlatformAuditLogs: related,
+		}
+	} else {
+		o.R.AdminPlatformAuditLogs = append(o.R.AdminPlatformAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &platformAuditLogR{
+				Admin: o,
+			}
+		} else {
+			rel.R.Admin = o
+		}
+	}
+	return nil
+}
+
+// AddUserIdentitiesG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddUserIdentitiesG(insert bool, related ...*UserIdentity) error {
+	return o.AddUserIdentities(boil.GetDB(), insert, related...)
+}
+
+// AddUserIdentities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.User appropriately.
+func (o *User) AddUserIdentities(exec boil.Executor, insert bool, related ...*UserIdentity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_identities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserIdentities: related,
+		}
+	} else {
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userIdentityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserLoginEventsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserLoginEvents.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddUserLoginEventsG(insert bool, related ...*UserLoginEvent) error {
+	return o.AddUserLoginEvents(boil.GetDB(), insert, related...)
+}
+
+// AddUserLoginEvents adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserLoginEvents.
+// Sets related.R.User appropriately.
+func (o *User) AddUserLoginEvents(exec boil.Executor, insert bool, related ...*UserLoginEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_login_events\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userLoginEventPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserLoginEvents: related,
+		}
+	} else {
+		o.R.UserLoginEvents = append(o.R.UserLoginEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userLoginEventR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -0,0 +1,16 @@
+package codes
+
+// 平台管理模块错误码 (2400-2599)
+var (
+	ErrPlatformAdminRequired = ErrCode{Msg: "需要平台管理员权限", Type: ErrorTypeForbidden, Code: 2400}
+	ErrAdminTargetNotFound   = ErrCode{Msg: "操作对象不存在", Type: ErrorTypeNotFound, Code: 2401}
+
+	// 租户管理 (2410-2429)
+	ErrAdminTenantAlreadySuspended = ErrCode{Msg: "租户已处于封禁状态", Type: ErrorTypeConflict, Code: 2410}
+	ErrAdminTenantNotSuspended     = ErrCode{Msg: "租户未处于封禁状态", Type: ErrorTypeConflict, Code: 2411}
+	ErrAdminPlanUserLimit          = ErrCode{Msg: "该用户已拥有此类型的计划", Type: ErrorTypeConflict, Code: 2412}
+
+	// 模拟登录 (2430-2449)
+	ErrImpersonateAdmin    = ErrCode{Msg: "不能模拟登录平台管理员", Type: ErrorTypeForbidden, Code: 2430}
+	ErrImpersonateReadOnly = ErrCode{Msg: "模拟登录仅允许只读操作", Type: ErrorTypeForbidden, Code: 2431}
+)
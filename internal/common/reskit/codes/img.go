@@ -11,12 +11,57 @@ var (
 	ErrImgCategoryExistImg    = ErrCode{
 		Msg: "当前图片分类下存在图片,请检查图库和回收站", Type: ErrorTypeExternal, Code: 2005,
 	}
-	ErrImgIllegalOperation = ErrCode{Msg: "非法的图片操作", Type: ErrorTypeExternal, Code: 2006}
+	ErrImgIllegalOperation    = ErrCode{Msg: "非法的图片操作", Type: ErrorTypeExternal, Code: 2006}
+	ErrImgUploadSlotNotFound  = ErrCode{Msg: "上传凭证不存在或已过期", Type: ErrorTypeNotFound, Code: 2007}
+	ErrImgUploadIncomplete    = ErrCode{Msg: "图片未上传或大小与申请不符", Type: ErrorTypeValidation, Code: 2008}
+	ErrImgTagNotFound         = ErrCode{Msg: "图片标签不存在", Type: ErrorTypeNotFound, Code: 2009}
+	ErrImgTagNameRepeat       = ErrCode{Msg: "图片标签名重复", Type: ErrorTypeAlreadyExists, Code: 2010}
+	ErrImgTagTooMany          = ErrCode{Msg: "图片标签过多", Type: ErrorTypeExternal, Code: 2011}
+	ErrImgCategoryJobNotFound = ErrCode{Msg: "分类迁移任务不存在", Type: ErrorTypeNotFound, Code: 2012}
+	ErrImgCategoryJobRunning  = ErrCode{Msg: "分类正在迁移中,请等待任务完成", Type: ErrorTypeExternal, Code: 2013}
+	ErrImgCategoryHasChildren = ErrCode{Msg: "当前图片分类下存在子分类", Type: ErrorTypeExternal, Code: 2014}
+	ErrImgCategoryTooDeep     = ErrCode{Msg: "图片分类层级过深", Type: ErrorTypeExternal, Code: 2015}
+	ErrImgCategoryMoveCycle   = ErrCode{Msg: "不能将分类移动到自身或其子分类下", Type: ErrorTypeValidation, Code: 2016}
+	ErrImgLockTimeout         = ErrCode{Msg: "图片正在被其他操作处理,请稍后再试", Type: ErrorTypeConflict, Code: 2017}
+	ErrImgLockLost            = ErrCode{Msg: "图片已被其他操作修改,请刷新后重试", Type: ErrorTypeConflict, Code: 2018}
 
 	// 图片处理 (1420-1439)
-	ErrImgCompress         = ErrCode{Msg: "压缩图片失败", Type: ErrorTypeInternal, Code: 2020}
-	ErrImgUploadToR3Failed = ErrCode{Msg: "上传图片到R3失败", Type: ErrorTypeInternal, Code: 2021}
+	ErrImgProcessFailed         = ErrCode{Msg: "处理图片失败", Type: ErrorTypeInternal, Code: 2020}
+	ErrImgUploadToStorageFailed = ErrCode{Msg: "上传图片到对象存储失败", Type: ErrorTypeInternal, Code: 2021}
+	ErrImgUnsupportedFormat     = ErrCode{Msg: "不支持的图片格式", Type: ErrorTypeValidation, Code: 2022}
+	ErrImgDimensionTooLarge     = ErrCode{Msg: "图片尺寸过大", Type: ErrorTypeValidation, Code: 2023}
+	ErrImgTransformInvalid      = ErrCode{Msg: "图片变换参数无效", Type: ErrorTypeValidation, Code: 2024}
+	ErrImgTransformSignature    = ErrCode{Msg: "图片变换签名无效", Type: ErrorTypeForbidden, Code: 2025}
 
 	// 图库配置 (1440-1459)
-	ErrImgR2ConfigNotFound = ErrCode{Msg: "图库R2配置不存在", Type: ErrorTypeNotFound, Code: 2040}
+	ErrImgStorageConfigNotFound   = ErrCode{Msg: "图库存储配置不存在", Type: ErrorTypeNotFound, Code: 2040}
+	ErrImgStorageConfigInvalid    = ErrCode{Msg: "图库存储配置不完整", Type: ErrorTypeValidation, Code: 2041}
+	ErrImgStorageProviderDisabled = ErrCode{Msg: "服务端未启用该存储类型", Type: ErrorTypeExternal, Code: 2042}
+	ErrImgStorageObjectNotFound   = ErrCode{Msg: "存储对象不存在", Type: ErrorTypeNotFound, Code: 2043}
+	ErrImgStorageSignatureInvalid = ErrCode{Msg: "访问链接无效或已过期", Type: ErrorTypeForbidden, Code: 2044}
+	ErrImgStorageObjectKeyInvalid = ErrCode{Msg: "非法的存储对象路径", Type: ErrorTypeValidation, Code: 2045}
+	ErrImgSettingNotFound         = ErrCode{Msg: "图片处理配置不存在", Type: ErrorTypeNotFound, Code: 2046}
+	ErrImgStorageMigrating        = ErrCode{Msg: "存储迁移进行中,请稍后再试", Type: ErrorTypeConflict, Code: 2047}
+	ErrImgMigrationNotFound       = ErrCode{Msg: "存储迁移任务不存在", Type: ErrorTypeNotFound, Code: 2048}
+	ErrImgMigrationSameTarget     = ErrCode{Msg: "迁移目标与当前存储相同", Type: ErrorTypeValidation, Code: 2049}
+	ErrImgStorageVerifyFailed     = ErrCode{Msg: "迁移后的对象校验失败", Type: ErrorTypeInternal, Code: 2050}
+	ErrImgWatermarkNotFound       = ErrCode{Msg: "水印配置不存在", Type: ErrorTypeNotFound, Code: 2051}
+	ErrImgWatermarkInvalid        = ErrCode{Msg: "水印配置不完整", Type: ErrorTypeValidation, Code: 2052}
+
+	// 相册 (1460-1479)
+	ErrImgAlbumNotFound    = ErrCode{Msg: "相册不存在", Type: ErrorTypeNotFound, Code: 2060}
+	ErrImgAlbumTitleRepeat = ErrCode{Msg: "相册名重复", Type: ErrorTypeAlreadyExists, Code: 2061}
+	ErrImgAlbumTooMany     = ErrCode{Msg: "相册过多", Type: ErrorTypeExternal, Code: 2062}
+	ErrImgAlbumImgTooMany  = ErrCode{Msg: "相册中的图片过多", Type: ErrorTypeExternal, Code: 2063}
+	ErrImgNotInAlbum       = ErrCode{Msg: "图片不在相册中", Type: ErrorTypeNotFound, Code: 2064}
+
+	// 导入导出 (1480-1499)
+	ErrImgImportJobNotFound    = ErrCode{Msg: "导入任务不存在", Type: ErrorTypeNotFound, Code: 2080}
+	ErrImgImportFetchFailed    = ErrCode{Msg: "下载远程图片失败", Type: ErrorTypeExternal, Code: 2081}
+	ErrImgImportFileTooLarge   = ErrCode{Msg: "导入的文件过大", Type: ErrorTypeValidation, Code: 2082}
+	ErrImgImportURLForbidden   = ErrCode{Msg: "不允许访问该地址", Type: ErrorTypeValidation, Code: 2083}
+	ErrImgImportArchiveInvalid = ErrCode{Msg: "压缩包无法解析", Type: ErrorTypeValidation, Code: 2084}
+	ErrImgImportTooManyFiles   = ErrCode{Msg: "导入的文件过多", Type: ErrorTypeValidation, Code: 2085}
+	ErrImgExportJobNotFound    = ErrCode{Msg: "导出任务不存在", Type: ErrorTypeNotFound, Code: 2086}
+	ErrImgExportTooManyImgs    = ErrCode{Msg: "导出的图片过多,请缩小筛选范围", Type: ErrorTypeValidation, Code: 2087}
 )
@@ -6,6 +6,7 @@ var (
 	ErrTenantHasSameName = ErrCode{Msg: "存在相同的租户名", Type: ErrorTypeConflict, Code: 1601}
 	
 	ErrTenantNotCreator = ErrCode{Msg: "当前用户不为租户创建者", Type: ErrorTypeUnauthorized, Code: 1610}
+	ErrTenantSuspended  = ErrCode{Msg: "当前租户已被平台封禁", Type: ErrorTypeForbidden, Code: 1611}
 
 	ErrTenantPlanNotFound  = ErrCode{Msg: "当前租户不存在计划", Type: ErrorTypeNotFound, Code: 1620}
 	ErrTenantPlanUserLimit = ErrCode{Msg: "当前用户可创建的该计划已达上线", Type: ErrorTypeNotFound, Code: 1621}
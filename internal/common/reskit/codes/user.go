@@ -17,9 +17,14 @@ var (
 	ErrUsernameAlreadyExists = ErrCode{Msg: "用户名已被使用", Type: ErrorTypeAlreadyExists, Code: 1021}
 
 	// OAuth相关错误 (1040-1059)
-	ErrOAuthInvalidCode     = ErrCode{Msg: "无效的OAuth授权码", Type: ErrorTypeValidation, Code: 1040}
-	ErrOAuthInvalidProvider = ErrCode{Msg: "不支持的OAuth提供商", Type: ErrorTypeValidation, Code: 1041}
-	ErrOAuthUserInfoMissing = ErrCode{Msg: "OAuth用户信息缺失", Type: ErrorTypeValidation, Code: 1042}
+	ErrOAuthInvalidCode           = ErrCode{Msg: "无效的OAuth授权码", Type: ErrorTypeValidation, Code: 1040}
+	ErrOAuthInvalidProvider       = ErrCode{Msg: "不支持的OAuth提供商", Type: ErrorTypeValidation, Code: 1041}
+	ErrOAuthUserInfoMissing       = ErrCode{Msg: "OAuth用户信息缺失", Type: ErrorTypeValidation, Code: 1042}
+	ErrOAuthEmailNotVerified      = ErrCode{Msg: "第三方邮箱未验证,请登录后手动绑定", Type: ErrorTypeConflict, Code: 1043}
+	ErrOAuthIdentityAlreadyLinked = ErrCode{Msg: "该第三方账号已绑定其他用户", Type: ErrorTypeAlreadyExists, Code: 1044}
+	ErrOAuthProviderAlreadyLinked = ErrCode{Msg: "当前用户已绑定该登录方式", Type: ErrorTypeAlreadyExists, Code: 1045}
+	ErrOAuthIdentityNotFound      = ErrCode{Msg: "未绑定该登录方式", Type: ErrorTypeNotFound, Code: 1046}
+	ErrOAuthLastLoginMethod       = ErrCode{Msg: "至少需要保留一种登录方式", Type: ErrorTypeConflict, Code: 1047}
 
 	// Token相关错误 (1060-1079)
 	ErrTokenGenerationFailed = ErrCode{Msg: "Token生成失败", Type: ErrorTypeInternal, Code: 1060}
@@ -38,6 +43,16 @@ var (
 	ErrGitHubAPIError = ErrCode{Msg: "GitHub API调用失败", Type: ErrorTypeExternal, Code: 1080}
 	ErrGoogleAPIError = ErrCode{Msg: "Google API调用失败", Type: ErrorTypeExternal, Code: 1081}
 
+	// 个人访问令牌相关错误 (1100-1119)
+	ErrPATNotFound         = ErrCode{Msg: "访问令牌不存在", Type: ErrorTypeNotFound, Code: 1100}
+	ErrPATInvalid          = ErrCode{Msg: "访问令牌无效", Type: ErrorTypeUnauthorized, Code: 1101}
+	ErrPATExpired          = ErrCode{Msg: "访问令牌已过期", Type: ErrorTypeUnauthorized, Code: 1102}
+	ErrPATInvalidScope     = ErrCode{Msg: "无效的令牌权限范围", Type: ErrorTypeValidation, Code: 1103}
+	ErrPATScopeDenied      = ErrCode{Msg: "访问令牌权限范围不足", Type: ErrorTypeForbidden, Code: 1104}
+	ErrPATSessionRequired  = ErrCode{Msg: "该操作需要登录会话,不支持访问令牌", Type: ErrorTypeForbidden, Code: 1105}
+	ErrPATLimitExceeded    = ErrCode{Msg: "访问令牌数量已达上限", Type: ErrorTypeConflict, Code: 1106}
+	ErrPATExpiresAtInvalid = ErrCode{Msg: "令牌过期时间无效", Type: ErrorTypeValidation, Code: 1107}
+
 
 	// 
 	// ErrUser
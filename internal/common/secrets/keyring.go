@@ -0,0 +1,179 @@
+package secrets
+
+import (
+	"os"
+	"regexp"
+	"saas/internal/common/utils"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+/*
+密钥配置
+
+  SECRETS_KEYS           逗号分隔的 id:base64密钥 如 k2:xxx,k1:yyy 每个密钥 32 字节
+  SECRETS_ACTIVE_KEY_ID  用于加密的密钥 id 其余密钥仅用于解密
+
+未配置 SECRETS_KEYS 时以 R2_AES256_ENCRYPTION_KEY 作为 id 为 default 的唯一密钥
+引入版本前的密文不带 id 一律使用 default 密钥解密
+
+轮换步骤
+  1.新增密钥并设为活跃 旧密钥保留在 SECRETS_KEYS 中
+  2.重新加密任务将旧密钥加密的密文改用活跃密钥加密 见 StartReencryptJob
+  3.日志中不再出现待重新加密的记录后 从 SECRETS_KEYS 中移除旧密钥
+    移除 R2_AES256_ENCRYPTION_KEY 前须配置 SECRETS_SIGNING_KEY 见 SigningKey
+*/
+
+const (
+	// DefaultKeyID 未配置密钥环时的密钥 id 同时用于解密不带 id 的旧密文
+	DefaultKeyID = "default"
+
+	ciphertextVersion = "v1"
+)
+
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// Keyring 带版本的 AES-256-GCM 密钥环 使用活跃密钥加密 其余密钥仅用于解密
+// 密文格式为 v1:<密钥id>:<base64密文>
+type Keyring struct {
+	activeID string
+	keys     map[string]*utils.AES256Encryptor
+}
+
+// NewKeyring keys 的 key 为密钥 id value 为 base64 编码的 32 字节密钥
+func NewKeyring(activeID string, keys map[string]string) (*Keyring, error) {
+	if _, ok := keys[activeID]; !ok {
+		return nil, errors.Errorf("活跃密钥 %s 不在密钥环中", activeID)
+	}
+
+	k := &Keyring{
+		activeID: activeID,
+		keys:     make(map[string]*utils.AES256Encryptor, len(keys)),
+	}
+	for id, key := range keys {
+		if !keyIDPattern.MatchString(id) {
+			return nil, errors.Errorf("非法的密钥 id %q", id)
+		}
+		encryptor, err := utils.NewAES256Encryptor(key)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "密钥 %s", id)
+		}
+		k.keys[id] = encryptor
+	}
+
+	return k, nil
+}
+
+// ActiveKeyID 当前用于加密的密钥 id
+func (k *Keyring) ActiveKeyID() string {
+	return k.activeID
+}
+
+// Encrypt 使用活跃密钥加密 密文带密钥 id
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	ciphertext, err := k.keys[k.activeID].Encrypt(plaintext)
+	if err != nil {
+		return "", err
+	}
+	return ciphertextVersion + ":" + k.activeID + ":" + ciphertext, nil
+}
+
+// Decrypt 按密文中的密钥 id 解密 不带 id 的旧密文使用 default 密钥
+func (k *Keyring) Decrypt(ciphertext string) (string, error) {
+	keyID, body := parseCiphertext(ciphertext)
+
+	encryptor, ok := k.keys[keyID]
+	if !ok {
+		return "", errors.Errorf("密钥 %s 不在密钥环中", keyID)
+	}
+	return encryptor.Decrypt(body)
+}
+
+// NeedsReencrypt 密文不是由活跃密钥加密
+func (k *Keyring) NeedsReencrypt(ciphertext string) bool {
+	keyID, _ := parseCiphertext(ciphertext)
+	return keyID != k.activeID
+}
+
+// Reencrypt 改用活跃密钥加密 已是活跃密钥时原样返回
+func (k *Keyring) Reencrypt(ciphertext string) (string, bool, error) {
+	if !k.NeedsReencrypt(ciphertext) {
+		return ciphertext, false, nil
+	}
+
+	plaintext, err := k.Decrypt(ciphertext)
+	if err != nil {
+		return "", false, err
+	}
+	reencrypted, err := k.Encrypt(plaintext)
+	if err != nil {
+		return "", false, err
+	}
+	return reencrypted, true, nil
+}
+
+// activePrefix 活跃密钥加密的密文前缀
+func (k *Keyring) activePrefix() string {
+	return ciphertextVersion + ":" + k.activeID + ":"
+}
+
+func parseCiphertext(ciphertext string) (keyID, body string) {
+	// base64 不含 ':' 因此旧密文不会被误判
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) == 3 && parts[0] == ciphertextVersion {
+		return parts[1], parts[2]
+	}
+	return DefaultKeyID, ciphertext
+}
+
+var (
+	once           sync.Once
+	defaultKeyring *Keyring
+)
+
+// Init 从环境变量加载密钥环 配置错误时 panic
+func Init() {
+	once.Do(func() {
+		keyring, err := loadKeyring()
+		if err != nil {
+			panic(errors.WithMessage(err, "secrets 密钥环初始化失败"))
+		}
+		defaultKeyring = keyring
+	})
+}
+
+// Default 进程共享的密钥环 首次调用时初始化
+func Default() *Keyring {
+	Init()
+	return defaultKeyring
+}
+
+func loadKeyring() (*Keyring, error) {
+	legacyKey := os.Getenv("R2_AES256_ENCRYPTION_KEY")
+
+	raw := strings.TrimSpace(os.Getenv("SECRETS_KEYS"))
+	if raw == "" {
+		return NewKeyring(DefaultKeyID, map[string]string{DefaultKeyID: utils.GetEnv("R2_AES256_ENCRYPTION_KEY")})
+	}
+
+	keys := make(map[string]string)
+	for _, item := range strings.Split(raw, ",") {
+		id, key, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok || id == "" || key == "" {
+			return nil, errors.Errorf("SECRETS_KEYS 格式错误 应为 id:base64密钥")
+		}
+		if _, exist := keys[id]; exist {
+			return nil, errors.Errorf("SECRETS_KEYS 中密钥 id %s 重复", id)
+		}
+		keys[id] = key
+	}
+
+	// 保留旧密钥用于解密引入版本前的密文 直到全部重新加密
+	if _, exist := keys[DefaultKeyID]; !exist && legacyKey != "" {
+		keys[DefaultKeyID] = legacyKey
+	}
+
+	return NewKeyring(utils.GetEnv("SECRETS_ACTIVE_KEY_ID"), keys)
+}
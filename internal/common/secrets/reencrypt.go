@@ -0,0 +1,151 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// reencryptDelay 启动后延迟执行 等待各模块注册加密列
+	reencryptDelay    = time.Minute
+	reencryptInterval = time.Hour
+	reencryptBatch    = 100
+)
+
+// Column 存放密文的列 由持有该表的模块注册 空值跳过
+type Column struct {
+	Table string
+	// KeyColumn 主键列 按其顺序分批处理
+	KeyColumn string
+	Column    string
+}
+
+func (c Column) String() string {
+	return c.Table + "." + c.Column
+}
+
+var (
+	columnsMu sync.Mutex
+	columns   []Column
+)
+
+// RegisterColumn 注册需要随密钥轮换重新加密的列 重复注册忽略
+func RegisterColumn(column Column) {
+	columnsMu.Lock()
+	defer columnsMu.Unlock()
+
+	for _, c := range columns {
+		if c == column {
+			return
+		}
+	}
+	columns = append(columns, column)
+}
+
+func registeredColumns() []Column {
+	columnsMu.Lock()
+	defer columnsMu.Unlock()
+
+	return append([]Column(nil), columns...)
+}
+
+// StartReencryptJob 定期将已注册列中非活跃密钥加密的密文改用活跃密钥加密
+// 更新时比对原密文 与业务写入或其他实例并发执行时以先写入的为准
+func StartReencryptJob() {
+	go func() {
+		timer := time.NewTimer(reencryptDelay)
+		defer timer.Stop()
+
+		for range timer.C {
+			for _, column := range registeredColumns() {
+				updated, failed, err := Default().ReencryptColumn(column)
+				if err != nil {
+					zap.L().Error("重新加密失败", zap.String("column", column.String()), zap.Error(err))
+					continue
+				}
+				if updated > 0 || failed > 0 {
+					zap.L().Info("重新加密完成",
+						zap.String("column", column.String()),
+						zap.String("active_key_id", Default().ActiveKeyID()),
+						zap.Int("updated", updated),
+						zap.Int("failed", failed),
+					)
+				}
+			}
+			timer.Reset(reencryptInterval)
+		}
+	}()
+}
+
+type reencryptRow struct {
+	Key        string `boil:"key"`
+	Ciphertext string `boil:"ciphertext"`
+}
+
+// ReencryptColumn 逐批重新加密一列 返回更新的行数与无法解密的行数
+// 无法解密的行通常是密钥已从密钥环中移除 记录日志后跳过
+func (k *Keyring) ReencryptColumn(column Column) (updated, failed int, err error) {
+	table := pq.QuoteIdentifier(column.Table)
+	key := pq.QuoteIdentifier(column.KeyColumn)
+	col := pq.QuoteIdentifier(column.Column)
+
+	selectSQL := fmt.Sprintf(
+		`SELECT %[2]s::text AS key, %[3]s AS ciphertext FROM %[1]s
+		WHERE %[3]s IS NOT NULL AND %[3]s <> '' AND %[3]s NOT LIKE $1 AND %[2]s::text > $2
+		ORDER BY %[2]s::text LIMIT $3`,
+		table, key, col,
+	)
+	updateSQL := fmt.Sprintf(
+		`UPDATE %[1]s SET %[3]s = $1 WHERE %[2]s = $2 AND %[3]s = $3`,
+		table, key, col,
+	)
+
+	after := ""
+	for {
+		var rows []*reencryptRow
+		if err := queries.Raw(selectSQL, strings.ReplaceAll(k.activePrefix(), "_", `\_`)+"%", after, reencryptBatch).
+			BindG(context.Background(), &rows); err != nil {
+			return updated, failed, errors.WithStack(err)
+		}
+
+		for _, row := range rows {
+			after = row.Key
+
+			ciphertext, changed, err := k.Reencrypt(row.Ciphertext)
+			if err != nil {
+				failed++
+				zap.L().Error("密文无法解密 跳过重新加密",
+					zap.String("column", column.String()),
+					zap.String("key", row.Key),
+					zap.Error(err),
+				)
+				continue
+			}
+			if !changed {
+				continue
+			}
+
+			res, err := queries.Raw(updateSQL, ciphertext, row.Key, row.Ciphertext).
+				ExecContext(context.Background(), boil.GetContextDB())
+			if err != nil {
+				return updated, failed, errors.WithStack(err)
+			}
+			if n, _ := res.RowsAffected(); n > 0 {
+				updated++
+			}
+		}
+
+		if len(rows) < reencryptBatch {
+			return updated, failed, nil
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package secrets
+
+import (
+	"crypto/sha256"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+/*
+签名密钥配置
+
+  SECRETS_SIGNING_KEY  签名链接(图片变换、本地存储预签名)使用的密钥 与加密密钥环相互独立
+
+加密密钥轮换不影响已签发的链接 更换签名密钥会使全部已签发的链接失效
+未配置时沿用 R2_AES256_ENCRYPTION_KEY 以保持已签发的链接有效 移除旧加密密钥前须先配置本项
+*/
+
+// SigningKey 按用途派生的 HMAC 密钥 不同用途的签名互不通用
+// 两者均未配置时 panic
+func SigningKey(purpose string) []byte {
+	base := os.Getenv("SECRETS_SIGNING_KEY")
+	if base == "" {
+		base = os.Getenv("R2_AES256_ENCRYPTION_KEY")
+	}
+	if base == "" {
+		panic(errors.New("环境变量 SECRETS_SIGNING_KEY 未设置或为空"))
+	}
+
+	key := sha256.Sum256([]byte(purpose + ":" + base))
+	return key[:]
+}
@@ -57,3 +57,16 @@ func GetTenantID(ctx *gin.Context) (string, error) {
 
 	return "", codes.ErrInvalidRequest.WithSlug("无租户id来源")
 }
+
+// ImpersonatorIDKey 模拟登录时 发起模拟的平台管理员id
+const ImpersonatorIDKey = "impersonator_id"
+
+func GetImpersonatorID(ctx *gin.Context) (string, bool) {
+	value, exist := ctx.Get(ImpersonatorIDKey)
+	if !exist {
+		return "", false
+	}
+
+	id, ok := value.(string)
+	return id, ok
+}
@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/types"
 	"saas/internal/common/orm"
 	"saas/internal/img/domain"
 )
@@ -15,6 +16,9 @@ func domainImgToORM(img *domain.Img) *orm.Img {
 		ID:        img.ID.String(),
 		TenantID:  img.TenantID.String(),
 		Path:      img.Path,
+		Width:     img.Width,
+		Height:    img.Height,
+		Size:      img.Size,
 		UpdatedAt: img.UpdatedAt,
 	}
 
@@ -22,6 +26,18 @@ func domainImgToORM(img *domain.Img) *orm.Img {
 	if img.Description != "" {
 		ormImg.Description = null.StringFrom(img.Description)
 	}
+	if img.ObjectPath != "" && img.ObjectPath != img.Path {
+		ormImg.ObjectPath = null.StringFrom(img.ObjectPath)
+	}
+	if img.ContentHash != "" {
+		ormImg.ContentHash = null.StringFrom(img.ContentHash)
+	}
+	if img.MimeType != "" {
+		ormImg.MimeType = null.StringFrom(img.MimeType)
+	}
+	if img.OriginalFilename != "" {
+		ormImg.OriginalFilename = null.StringFrom(img.OriginalFilename)
+	}
 
 	return ormImg
 }
@@ -32,17 +48,36 @@ func ormImgToDomain(ormImg *orm.Img) *domain.Img {
 	}
 
 	img := &domain.Img{
-		ID:        domain.ImgID(ormImg.ID),
-		TenantID:  domain.TenantID(ormImg.TenantID),
-		Path:      ormImg.Path,
-		CreatedAt: ormImg.CreatedAt,
-		UpdatedAt: ormImg.UpdatedAt,
+		ID:         domain.ImgID(ormImg.ID),
+		TenantID:   domain.TenantID(ormImg.TenantID),
+		Path:       ormImg.Path,
+		ObjectPath: ormImg.Path,
+		Width:      ormImg.Width,
+		Height:     ormImg.Height,
+		Size:       ormImg.Size,
+		CreatedAt:  ormImg.CreatedAt,
+		UpdatedAt:  ormImg.UpdatedAt,
 	}
 
 	// 处理null项
+	if ormImg.CategoryID.Valid {
+		img.CategoryID = domain.CategoryID(ormImg.CategoryID.String)
+	}
 	if ormImg.Description.Valid {
 		img.Description = ormImg.Description.String
 	}
+	if ormImg.ObjectPath.Valid {
+		img.ObjectPath = ormImg.ObjectPath.String
+	}
+	if ormImg.ContentHash.Valid {
+		img.ContentHash = ormImg.ContentHash.String
+	}
+	if ormImg.MimeType.Valid {
+		img.MimeType = ormImg.MimeType.String
+	}
+	if ormImg.OriginalFilename.Valid {
+		img.OriginalFilename = ormImg.OriginalFilename.String
+	}
 
 	if ormImg.DeletedAt.Valid {
 		img.DeletedAt = ormImg.DeletedAt.Time
@@ -75,11 +110,16 @@ func domainCategoryToORM(category *domain.Category) *orm.ImgCategory {
 	ormImg := &orm.ImgCategory{
 		ID:       category.ID.String(),
 		TenantID: category.TenantID.String(),
+		TreePath: category.TreePath,
+		Depth:    category.Depth,
 		Title:    category.Title,
 		Prefix:   category.Prefix,
 	}
 
 	// 处理null项
+	if category.ParentID != "" {
+		ormImg.ParentID = null.StringFrom(category.ParentID.String())
+	}
 
 	return ormImg
 }
@@ -92,12 +132,17 @@ func ormCategoryToDomain(ormCategory *orm.ImgCategory) *domain.Category {
 	img := &domain.Category{
 		ID:        domain.CategoryID(ormCategory.ID),
 		TenantID:  domain.TenantID(ormCategory.TenantID),
+		TreePath:  ormCategory.TreePath,
+		Depth:     ormCategory.Depth,
 		Title:     ormCategory.Title,
 		Prefix:    ormCategory.Prefix,
 		CreatedAt: ormCategory.CreatedAt,
 	}
 
 	// 处理null项
+	if ormCategory.ParentID.Valid {
+		img.ParentID = domain.CategoryID(ormCategory.ParentID.String)
+	}
 	return img
 }
 
@@ -118,46 +163,754 @@ func ormCategoriesToDomain(ormCategories []*orm.ImgCategory) []*domain.Category
 	return categories
 }
 
-func doaminR2ConfigToORM(r2Config *domain.R2Config) *orm.TenantR2Config {
-	if r2Config == nil {
+func domainStorageConfigToORM(config *domain.StorageConfig) *orm.TenantStorageConfig {
+	if config == nil {
 		return nil
 	}
 
-	ormR2Config := &orm.TenantR2Config{
-		TenantID:        r2Config.TenantID.String(),
-		AccountID:       r2Config.AccountID,
-		AccessKeyID:     r2Config.AccessKeyID,
-		PublicBucket:    r2Config.PublicBucket,
-		PublicURLPrefix: r2Config.PublicURLPrefix,
-		DeleteBucket:    r2Config.DeleteBucket,
+	ormConfig := &orm.TenantStorageConfig{
+		TenantID:        config.TenantID.String(),
+		Provider:        orm.StorageProvider(config.Provider),
+		UsePathStyle:    config.UsePathStyle,
+		PublicBucket:    config.PublicBucket,
+		PublicURLPrefix: config.PublicURLPrefix,
+		DeleteBucket:    config.DeleteBucket,
 	}
 
 	// 处理null
-	if r2Config.GetSecretAccessKey() != "" {
-		ormR2Config.SecretAccessKey = null.NewString(r2Config.GetSecretAccessKey(), true)
+	if config.AccountID != "" {
+		ormConfig.AccountID = null.StringFrom(config.AccountID)
+	}
+	if config.Endpoint != "" {
+		ormConfig.Endpoint = null.StringFrom(config.Endpoint)
+	}
+	if config.Region != "" {
+		ormConfig.Region = null.StringFrom(config.Region)
+	}
+	if config.AccessKeyID != "" {
+		ormConfig.AccessKeyID = null.StringFrom(config.AccessKeyID)
+	}
+	if config.GetSecretAccessKey() != "" {
+		ormConfig.SecretAccessKey = null.NewString(config.GetSecretAccessKey(), true)
 	}
 
-	return ormR2Config
+	return ormConfig
 }
 
-func ormR2ConfigToDomian(ormR2Config *orm.TenantR2Config) *domain.R2Config {
-	if ormR2Config == nil {
+func ormStorageConfigToDomain(ormConfig *orm.TenantStorageConfig) *domain.StorageConfig {
+	if ormConfig == nil {
 		return nil
 	}
 
-	r2Config := &domain.R2Config{
-		TenantID:        domain.TenantID(ormR2Config.TenantID),
-		AccountID:       ormR2Config.AccountID,
-		AccessKeyID:     ormR2Config.AccessKeyID,
-		PublicBucket:    ormR2Config.PublicBucket,
-		PublicURLPrefix: ormR2Config.PublicURLPrefix,
-		DeleteBucket:    ormR2Config.DeleteBucket,
+	config := &domain.StorageConfig{
+		TenantID:        domain.TenantID(ormConfig.TenantID),
+		Provider:        domain.StorageProvider(ormConfig.Provider),
+		AccountID:       ormConfig.AccountID.String,
+		Endpoint:        ormConfig.Endpoint.String,
+		Region:          ormConfig.Region.String,
+		UsePathStyle:    ormConfig.UsePathStyle,
+		AccessKeyID:     ormConfig.AccessKeyID.String,
+		PublicBucket:    ormConfig.PublicBucket,
+		PublicURLPrefix: ormConfig.PublicURLPrefix,
+		DeleteBucket:    ormConfig.DeleteBucket,
 	}
 
 	// 处理null
-	if ormR2Config.SecretAccessKey.Valid {
-		r2Config.SetSecretAccessKey(ormR2Config.SecretAccessKey.String)
+	if ormConfig.SecretAccessKey.Valid {
+		config.SetSecretAccessKey(ormConfig.SecretAccessKey.String)
+	}
+
+	return config
+}
+
+func domainImgSettingToORM(setting *domain.ImgSetting) *orm.TenantImgSetting {
+	if setting == nil {
+		return nil
+	}
+
+	widths := make(types.Int64Array, 0, len(setting.VariantWidths))
+	for _, width := range setting.VariantWidths {
+		widths = append(widths, int64(width))
+	}
+
+	return &orm.TenantImgSetting{
+		TenantID:      setting.TenantID.String(),
+		OutputFormat:  orm.ImgOutputFormat(setting.OutputFormat),
+		Quality:       int16(setting.Quality),
+		VariantWidths: widths,
+		DedupMode:     orm.ImgDedupMode(setting.DedupMode),
+	}
+}
+
+func ormImgSettingToDomain(ormSetting *orm.TenantImgSetting) *domain.ImgSetting {
+	if ormSetting == nil {
+		return nil
+	}
+
+	widths := make([]int, 0, len(ormSetting.VariantWidths))
+	for _, width := range ormSetting.VariantWidths {
+		widths = append(widths, int(width))
+	}
+
+	return &domain.ImgSetting{
+		TenantID:      domain.TenantID(ormSetting.TenantID),
+		OutputFormat:  domain.OutputFormat(ormSetting.OutputFormat),
+		Quality:       int(ormSetting.Quality),
+		VariantWidths: widths,
+		DedupMode:     domain.DedupMode(ormSetting.DedupMode),
+	}
+}
+
+func domainWatermarkSettingToORM(setting *domain.WatermarkSetting) *orm.ImgWatermarkSetting {
+	if setting == nil {
+		return nil
+	}
+
+	categoryIDs := make(types.StringArray, 0, len(setting.CategoryIDs))
+	for _, id := range setting.CategoryIDs {
+		categoryIDs = append(categoryIDs, id.String())
+	}
+
+	return &orm.ImgWatermarkSetting{
+		TenantID:     setting.TenantID.String(),
+		Enabled:      setting.Enabled,
+		Kind:         orm.ImgWatermarkKind(setting.Kind),
+		Text:         setting.Text,
+		TextColor:    setting.TextColor,
+		ImageKey:     setting.ImageKey,
+		Position:     orm.ImgWatermarkPosition(setting.Position),
+		Opacity:      int16(setting.Opacity),
+		Scale:        int16(setting.Scale),
+		CategoryIds:  categoryIDs,
+		KeepOriginal: setting.KeepOriginal,
+	}
+}
+
+func ormWatermarkSettingToDomain(ormSetting *orm.ImgWatermarkSetting) *domain.WatermarkSetting {
+	if ormSetting == nil {
+		return nil
+	}
+
+	categoryIDs := make([]domain.CategoryID, 0, len(ormSetting.CategoryIds))
+	for _, id := range ormSetting.CategoryIds {
+		categoryIDs = append(categoryIDs, domain.CategoryID(id))
+	}
+
+	return &domain.WatermarkSetting{
+		TenantID:     domain.TenantID(ormSetting.TenantID),
+		Enabled:      ormSetting.Enabled,
+		Kind:         domain.WatermarkKind(ormSetting.Kind),
+		Text:         ormSetting.Text,
+		TextColor:    ormSetting.TextColor,
+		ImageKey:     ormSetting.ImageKey,
+		Position:     domain.WatermarkPosition(ormSetting.Position),
+		Opacity:      int(ormSetting.Opacity),
+		Scale:        int(ormSetting.Scale),
+		CategoryIDs:  categoryIDs,
+		KeepOriginal: ormSetting.KeepOriginal,
+	}
+}
+
+func domainVariantToORM(variant *domain.ImgVariant) *orm.ImgVariant {
+	if variant == nil {
+		return nil
+	}
+
+	return &orm.ImgVariant{
+		ID:     variant.ID,
+		ImgID:  variant.ImgID.String(),
+		Width:  variant.Width,
+		Height: variant.Height,
+		Path:   variant.Path,
+		Size:   variant.Size,
+	}
+}
+
+func ormVariantToDomain(ormVariant *orm.ImgVariant) *domain.ImgVariant {
+	if ormVariant == nil {
+		return nil
+	}
+
+	return &domain.ImgVariant{
+		ID:        ormVariant.ID,
+		ImgID:     domain.ImgID(ormVariant.ImgID),
+		Width:     ormVariant.Width,
+		Height:    ormVariant.Height,
+		Path:      ormVariant.Path,
+		Size:      ormVariant.Size,
+		CreatedAt: ormVariant.CreatedAt,
+	}
+}
+
+func ormVariantsToDomain(ormVariants []*orm.ImgVariant) []*domain.ImgVariant {
+	list := make([]*domain.ImgVariant, 0, len(ormVariants))
+	for _, ormVariant := range ormVariants {
+		if ormVariant != nil {
+			list = append(list, ormVariantToDomain(ormVariant))
+		}
+	}
+	return list
+}
+
+func domainTagToORM(tag *domain.Tag) *orm.ImgTag {
+	if tag == nil {
+		return nil
+	}
+
+	return &orm.ImgTag{
+		ID:       tag.ID.String(),
+		TenantID: tag.TenantID.String(),
+		Name:     tag.Name,
+	}
+}
+
+func ormTagToDomain(ormTag *orm.ImgTag) *domain.Tag {
+	if ormTag == nil {
+		return nil
+	}
+
+	return &domain.Tag{
+		ID:        domain.TagID(ormTag.ID),
+		TenantID:  domain.TenantID(ormTag.TenantID),
+		Name:      ormTag.Name,
+		CreatedAt: ormTag.CreatedAt,
+	}
+}
+
+func ormTagsToDomain(ormTags []*orm.ImgTag) []*domain.Tag {
+	if len(ormTags) == 0 {
+		return nil
+	}
+	list := make([]*domain.Tag, 0, len(ormTags))
+
+	for _, ormTag := range ormTags {
+		if ormTag != nil {
+			list = append(list, ormTagToDomain(ormTag))
+		}
+	}
+
+	return list
+}
+
+func domainStorageUsageToORM(usage *domain.StorageUsage) *orm.ImgStorageUsage {
+	if usage == nil {
+		return nil
+	}
+
+	ormUsage := &orm.ImgStorageUsage{
+		TenantID: usage.TenantID.String(),
+		Bucket:   orm.ImgBucketKind(usage.Bucket),
+		Bytes:    usage.Bytes,
+		Objects:  usage.Objects,
+	}
+
+	// 处理null项
+	if usage.CategoryID != "" {
+		ormUsage.CategoryID = null.StringFrom(usage.CategoryID.String())
+	}
+	if !usage.ReconciledAt.IsZero() {
+		ormUsage.ReconciledAt = null.TimeFrom(usage.ReconciledAt)
+	}
+
+	return ormUsage
+}
+
+func ormStorageUsageToDomain(ormUsage *orm.ImgStorageUsage) *domain.StorageUsage {
+	if ormUsage == nil {
+		return nil
+	}
+
+	usage := &domain.StorageUsage{
+		TenantID:  domain.TenantID(ormUsage.TenantID),
+		Bucket:    domain.StorageBucketKind(ormUsage.Bucket),
+		Bytes:     ormUsage.Bytes,
+		Objects:   ormUsage.Objects,
+		UpdatedAt: ormUsage.UpdatedAt,
+	}
+
+	// 处理null项
+	if ormUsage.CategoryID.Valid {
+		usage.CategoryID = domain.CategoryID(ormUsage.CategoryID.String)
+	}
+	if ormUsage.ReconciledAt.Valid {
+		usage.ReconciledAt = ormUsage.ReconciledAt.Time
+	}
+
+	return usage
+}
+
+func ormStorageUsagesToDomain(ormUsages []*orm.ImgStorageUsage) []*domain.StorageUsage {
+	list := make([]*domain.StorageUsage, 0, len(ormUsages))
+	for _, ormUsage := range ormUsages {
+		if ormUsage != nil {
+			list = append(list, ormStorageUsageToDomain(ormUsage))
+		}
+	}
+	return list
+}
+
+func domainCategoryJobToORM(job *domain.CategoryJob) *orm.ImgCategoryJob {
+	if job == nil {
+		return nil
+	}
+
+	ormJob := &orm.ImgCategoryJob{
+		ID:         job.ID.String(),
+		TenantID:   job.TenantID.String(),
+		CategoryID: job.CategoryID.String(),
+		Kind:       orm.ImgCategoryJobKind(job.Kind),
+		Status:     orm.ImgJobStatus(job.Status),
+		Total:      job.Total,
+		Processed:  job.Processed,
+		Failed:     job.Failed,
+	}
+
+	// 处理null项
+	if job.TargetCategoryID != "" {
+		ormJob.TargetCategoryID = null.StringFrom(job.TargetCategoryID.String())
+	}
+	if job.LastError != "" {
+		ormJob.LastError = null.StringFrom(job.LastError)
+	}
+	if !job.FinishedAt.IsZero() {
+		ormJob.FinishedAt = null.TimeFrom(job.FinishedAt)
+	}
+
+	return ormJob
+}
+
+func ormCategoryJobToDomain(ormJob *orm.ImgCategoryJob) *domain.CategoryJob {
+	if ormJob == nil {
+		return nil
+	}
+
+	job := &domain.CategoryJob{
+		ID:         domain.CategoryJobID(ormJob.ID),
+		TenantID:   domain.TenantID(ormJob.TenantID),
+		CategoryID: domain.CategoryID(ormJob.CategoryID),
+		Kind:       domain.CategoryJobKind(ormJob.Kind),
+		Status:     domain.JobStatus(ormJob.Status),
+		Total:      ormJob.Total,
+		Processed:  ormJob.Processed,
+		Failed:     ormJob.Failed,
+		CreatedAt:  ormJob.CreatedAt,
+		UpdatedAt:  ormJob.UpdatedAt,
+	}
+
+	// 处理null项
+	if ormJob.TargetCategoryID.Valid {
+		job.TargetCategoryID = domain.CategoryID(ormJob.TargetCategoryID.String)
+	}
+	if ormJob.LastError.Valid {
+		job.LastError = ormJob.LastError.String
+	}
+	if ormJob.FinishedAt.Valid {
+		job.FinishedAt = ormJob.FinishedAt.Time
+	}
+
+	return job
+}
+
+func ormCategoryJobsToDomain(ormJobs []*orm.ImgCategoryJob) []*domain.CategoryJob {
+	list := make([]*domain.CategoryJob, 0, len(ormJobs))
+	for _, ormJob := range ormJobs {
+		if ormJob != nil {
+			list = append(list, ormCategoryJobToDomain(ormJob))
+		}
+	}
+	return list
+}
+
+func domainAlbumToORM(album *domain.Album) *orm.ImgAlbum {
+	if album == nil {
+		return nil
+	}
+
+	ormAlbum := &orm.ImgAlbum{
+		ID:       album.ID.String(),
+		TenantID: album.TenantID.String(),
+		Title:    album.Title,
+	}
+
+	// 处理null项
+	if album.Description != "" {
+		ormAlbum.Description = null.StringFrom(album.Description)
+	}
+
+	return ormAlbum
+}
+
+func ormAlbumToDomain(ormAlbum *orm.ImgAlbum) *domain.Album {
+	if ormAlbum == nil {
+		return nil
+	}
+
+	album := &domain.Album{
+		ID:        domain.AlbumID(ormAlbum.ID),
+		TenantID:  domain.TenantID(ormAlbum.TenantID),
+		Title:     ormAlbum.Title,
+		CreatedAt: ormAlbum.CreatedAt,
+		UpdatedAt: ormAlbum.UpdatedAt,
+	}
+
+	// 处理null项
+	if ormAlbum.Description.Valid {
+		album.Description = ormAlbum.Description.String
+	}
+
+	return album
+}
+
+func ormAlbumsToDomain(ormAlbums []*orm.ImgAlbum) []*domain.Album {
+	list := make([]*domain.Album, 0, len(ormAlbums))
+	for _, ormAlbum := range ormAlbums {
+		if ormAlbum != nil {
+			list = append(list, ormAlbumToDomain(ormAlbum))
+		}
+	}
+	return list
+}
+
+func ormAlbumItemsToDomain(ormItems []*orm.ImgAlbumItem) []*domain.AlbumItem {
+	list := make([]*domain.AlbumItem, 0, len(ormItems))
+	for _, ormItem := range ormItems {
+		if ormItem != nil {
+			list = append(list, &domain.AlbumItem{
+				AlbumID:  domain.AlbumID(ormItem.AlbumID),
+				ImgID:    domain.ImgID(ormItem.ImgID),
+				Position: ormItem.Position,
+				AddedAt:  ormItem.AddedAt,
+			})
+		}
+	}
+	return list
+}
+
+func domainImportJobToORM(job *domain.ImportJob) *orm.ImgImportJob {
+	if job == nil {
+		return nil
+	}
+
+	ormJob := &orm.ImgImportJob{
+		ID:        job.ID.String(),
+		TenantID:  job.TenantID.String(),
+		Source:    orm.ImgImportSource(job.Source),
+		Status:    orm.ImgJobStatus(job.Status),
+		Total:     job.Total,
+		Succeeded: job.Succeeded,
+		Failed:    job.Failed,
+	}
+
+	// 处理null项
+	if job.CategoryID != "" {
+		ormJob.CategoryID = null.StringFrom(job.CategoryID.String())
+	}
+	if job.ArchiveKey != "" {
+		ormJob.ArchiveKey = null.StringFrom(job.ArchiveKey)
+	}
+	if job.LastError != "" {
+		ormJob.LastError = null.StringFrom(job.LastError)
+	}
+	if !job.FinishedAt.IsZero() {
+		ormJob.FinishedAt = null.TimeFrom(job.FinishedAt)
+	}
+
+	return ormJob
+}
+
+func ormImportJobToDomain(ormJob *orm.ImgImportJob) *domain.ImportJob {
+	if ormJob == nil {
+		return nil
+	}
+
+	job := &domain.ImportJob{
+		ID:        domain.ImportJobID(ormJob.ID),
+		TenantID:  domain.TenantID(ormJob.TenantID),
+		Source:    domain.ImportSource(ormJob.Source),
+		Status:    domain.JobStatus(ormJob.Status),
+		Total:     ormJob.Total,
+		Succeeded: ormJob.Succeeded,
+		Failed:    ormJob.Failed,
+		CreatedAt: ormJob.CreatedAt,
+		UpdatedAt: ormJob.UpdatedAt,
+	}
+
+	// 处理null项
+	if ormJob.CategoryID.Valid {
+		job.CategoryID = domain.CategoryID(ormJob.CategoryID.String)
+	}
+	if ormJob.ArchiveKey.Valid {
+		job.ArchiveKey = ormJob.ArchiveKey.String
+	}
+	if ormJob.LastError.Valid {
+		job.LastError = ormJob.LastError.String
+	}
+	if ormJob.FinishedAt.Valid {
+		job.FinishedAt = ormJob.FinishedAt.Time
+	}
+
+	return job
+}
+
+func ormImportJobsToDomain(ormJobs []*orm.ImgImportJob) []*domain.ImportJob {
+	list := make([]*domain.ImportJob, 0, len(ormJobs))
+	for _, ormJob := range ormJobs {
+		if ormJob != nil {
+			list = append(list, ormImportJobToDomain(ormJob))
+		}
+	}
+	return list
+}
+
+func domainImportItemToORM(item *domain.ImportItem) *orm.ImgImportItem {
+	if item == nil {
+		return nil
+	}
+
+	ormItem := &orm.ImgImportItem{
+		ID:     item.ID,
+		JobID:  item.JobID.String(),
+		Seq:    item.Seq,
+		Source: item.Source,
+		Status: orm.ImgJobStatus(item.Status),
+	}
+
+	// 处理null项
+	if item.ImgID != "" {
+		ormItem.ImgID = null.StringFrom(item.ImgID.String())
+	}
+	if item.ErrorCode != 0 {
+		ormItem.ErrorCode = null.IntFrom(item.ErrorCode)
 	}
+	if item.Error != "" {
+		ormItem.Error = null.StringFrom(item.Error)
+	}
+
+	return ormItem
+}
 
-	return r2Config
+func ormImportItemToDomain(ormItem *orm.ImgImportItem) *domain.ImportItem {
+	if ormItem == nil {
+		return nil
+	}
+
+	item := &domain.ImportItem{
+		ID:        ormItem.ID,
+		JobID:     domain.ImportJobID(ormItem.JobID),
+		Seq:       ormItem.Seq,
+		Source:    ormItem.Source,
+		Status:    domain.JobStatus(ormItem.Status),
+		UpdatedAt: ormItem.UpdatedAt,
+	}
+
+	// 处理null项
+	if ormItem.ImgID.Valid {
+		item.ImgID = domain.ImgID(ormItem.ImgID.String)
+	}
+	if ormItem.ErrorCode.Valid {
+		item.ErrorCode = ormItem.ErrorCode.Int
+	}
+	if ormItem.Error.Valid {
+		item.Error = ormItem.Error.String
+	}
+
+	return item
+}
+
+func ormImportItemsToDomain(ormItems []*orm.ImgImportItem) []*domain.ImportItem {
+	list := make([]*domain.ImportItem, 0, len(ormItems))
+	for _, ormItem := range ormItems {
+		if ormItem != nil {
+			list = append(list, ormImportItemToDomain(ormItem))
+		}
+	}
+	return list
+}
+
+func domainExportJobToORM(job *domain.ExportJob) *orm.ImgExportJob {
+	if job == nil {
+		return nil
+	}
+
+	tagIDs := make(types.StringArray, 0, len(job.TagIDs))
+	for _, tagID := range job.TagIDs {
+		tagIDs = append(tagIDs, tagID.String())
+	}
+
+	ormJob := &orm.ImgExportJob{
+		ID:                   job.ID.String(),
+		TenantID:             job.TenantID.String(),
+		IncludeSubcategories: job.IncludeSubcategories,
+		TagIds:               tagIDs,
+		IncludeDeleted:       job.IncludeDeleted,
+		Status:               orm.ImgJobStatus(job.Status),
+		Total:                job.Total,
+		Exported:             job.Exported,
+		Missing:              job.Missing,
+		Size:                 job.Size,
+	}
+
+	// 处理null项
+	if job.CategoryID != "" {
+		ormJob.CategoryID = null.StringFrom(job.CategoryID.String())
+	}
+	if job.ArchiveKey != "" {
+		ormJob.ArchiveKey = null.StringFrom(job.ArchiveKey)
+	}
+	if job.LastError != "" {
+		ormJob.LastError = null.StringFrom(job.LastError)
+	}
+	if !job.FinishedAt.IsZero() {
+		ormJob.FinishedAt = null.TimeFrom(job.FinishedAt)
+	}
+	if !job.ExpiresAt.IsZero() {
+		ormJob.ExpiresAt = null.TimeFrom(job.ExpiresAt)
+	}
+
+	return ormJob
+}
+
+func ormExportJobToDomain(ormJob *orm.ImgExportJob) *domain.ExportJob {
+	if ormJob == nil {
+		return nil
+	}
+
+	job := &domain.ExportJob{
+		ID:                   domain.ExportJobID(ormJob.ID),
+		TenantID:             domain.TenantID(ormJob.TenantID),
+		IncludeSubcategories: ormJob.IncludeSubcategories,
+		IncludeDeleted:       ormJob.IncludeDeleted,
+		Status:               domain.JobStatus(ormJob.Status),
+		Total:                ormJob.Total,
+		Exported:             ormJob.Exported,
+		Missing:              ormJob.Missing,
+		Size:                 ormJob.Size,
+		CreatedAt:            ormJob.CreatedAt,
+		UpdatedAt:            ormJob.UpdatedAt,
+	}
+	for _, tagID := range ormJob.TagIds {
+		job.TagIDs = append(job.TagIDs, domain.TagID(tagID))
+	}
+
+	// 处理null项
+	if ormJob.CategoryID.Valid {
+		job.CategoryID = domain.CategoryID(ormJob.CategoryID.String)
+	}
+	if ormJob.ArchiveKey.Valid {
+		job.ArchiveKey = ormJob.ArchiveKey.String
+	}
+	if ormJob.LastError.Valid {
+		job.LastError = ormJob.LastError.String
+	}
+	if ormJob.FinishedAt.Valid {
+		job.FinishedAt = ormJob.FinishedAt.Time
+	}
+	if ormJob.ExpiresAt.Valid {
+		job.ExpiresAt = ormJob.ExpiresAt.Time
+	}
+
+	return job
+}
+
+func ormExportJobsToDomain(ormJobs []*orm.ImgExportJob) []*domain.ExportJob {
+	list := make([]*domain.ExportJob, 0, len(ormJobs))
+	for _, ormJob := range ormJobs {
+		if ormJob != nil {
+			list = append(list, ormExportJobToDomain(ormJob))
+		}
+	}
+	return list
+}
+
+func domainStorageMigrationToORM(migration *domain.StorageMigration) *orm.ImgStorageMigration {
+	if migration == nil {
+		return nil
+	}
+
+	// 目标配置与租户存储配置的列一致 复用其转换
+	target := domainStorageConfigToORM(migration.Target)
+	ormMigration := &orm.ImgStorageMigration{
+		ID:              migration.ID.String(),
+		TenantID:        migration.TenantID.String(),
+		Status:          orm.ImgJobStatus(migration.Status),
+		Provider:        target.Provider,
+		AccountID:       target.AccountID,
+		Endpoint:        target.Endpoint,
+		Region:          target.Region,
+		UsePathStyle:    target.UsePathStyle,
+		AccessKeyID:     target.AccessKeyID,
+		SecretAccessKey: target.SecretAccessKey,
+		PublicBucket:    target.PublicBucket,
+		PublicURLPrefix: target.PublicURLPrefix,
+		DeleteBucket:    target.DeleteBucket,
+		Total:           migration.Total,
+		Copied:          migration.Copied,
+		Verified:        migration.Verified,
+	}
+
+	// 处理null项
+	if migration.LastError != "" {
+		ormMigration.LastError = null.StringFrom(migration.LastError)
+	}
+	if !migration.SwitchedAt.IsZero() {
+		ormMigration.SwitchedAt = null.TimeFrom(migration.SwitchedAt)
+	}
+	if !migration.FinishedAt.IsZero() {
+		ormMigration.FinishedAt = null.TimeFrom(migration.FinishedAt)
+	}
+
+	return ormMigration
+}
+
+func ormStorageMigrationToDomain(ormMigration *orm.ImgStorageMigration) *domain.StorageMigration {
+	if ormMigration == nil {
+		return nil
+	}
+
+	migration := &domain.StorageMigration{
+		ID:       domain.StorageMigrationID(ormMigration.ID),
+		TenantID: domain.TenantID(ormMigration.TenantID),
+		Status:   domain.JobStatus(ormMigration.Status),
+		Target: ormStorageConfigToDomain(&orm.TenantStorageConfig{
+			TenantID:        ormMigration.TenantID,
+			Provider:        ormMigration.Provider,
+			AccountID:       ormMigration.AccountID,
+			Endpoint:        ormMigration.Endpoint,
+			Region:          ormMigration.Region,
+			UsePathStyle:    ormMigration.UsePathStyle,
+			AccessKeyID:     ormMigration.AccessKeyID,
+			SecretAccessKey: ormMigration.SecretAccessKey,
+			PublicBucket:    ormMigration.PublicBucket,
+			PublicURLPrefix: ormMigration.PublicURLPrefix,
+			DeleteBucket:    ormMigration.DeleteBucket,
+		}),
+		Total:     ormMigration.Total,
+		Copied:    ormMigration.Copied,
+		Verified:  ormMigration.Verified,
+		CreatedAt: ormMigration.CreatedAt,
+		UpdatedAt: ormMigration.UpdatedAt,
+	}
+
+	// 处理null项
+	if ormMigration.LastError.Valid {
+		migration.LastError = ormMigration.LastError.String
+	}
+	if ormMigration.SwitchedAt.Valid {
+		migration.SwitchedAt = ormMigration.SwitchedAt.Time
+	}
+	if ormMigration.FinishedAt.Valid {
+		migration.FinishedAt = ormMigration.FinishedAt.Time
+	}
+
+	return migration
+}
+
+func ormStorageMigrationsToDomain(ormMigrations []*orm.ImgStorageMigration) []*domain.StorageMigration {
+	list := make([]*domain.StorageMigration, 0, len(ormMigrations))
+	for _, ormMigration := range ormMigrations {
+		if ormMigration != nil {
+			list = append(list, ormStorageMigrationToDomain(ormMigration))
+		}
+	}
+	return list
 }
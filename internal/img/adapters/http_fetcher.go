@@ -0,0 +1,179 @@
+package adapters
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	fetchTimeout      = 30 * time.Second
+	fetchMaxRedirects = 5
+)
+
+// HTTPFetcher 下载远程图片 默认拒绝访问内网与本机地址 防止借导入探测内部服务
+// 本地调试时可设置 IMG_IMPORT_ALLOW_PRIVATE_NETWORK=true 放开限制
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+func NewImageFetcher() domain.ImageFetcher {
+	return newHTTPFetcher(os.Getenv("IMG_IMPORT_ALLOW_PRIVATE_NETWORK") == "true")
+}
+
+func newHTTPFetcher(allowPrivate bool) *HTTPFetcher {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if !allowPrivate {
+		// 在建立连接时校验解析后的地址 重定向与 DNS 重绑定同样受限
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return codes.ErrImgImportURLForbidden
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPFetcher{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   fetchTimeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= fetchMaxRedirects {
+					return errors.New("重定向次数过多")
+				}
+				if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+					return codes.ErrImgImportURLForbidden
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// blockedNetworks 标准库未归类但同样不可从公网访问的地址段
+var blockedNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // 本网络
+	"100.64.0.0/10",  // 运营商级 NAT 部分云厂商的元数据服务位于此段(如 100.100.100.200)
+	"192.0.0.0/24",   // IETF 协议分配 部分云厂商的元数据服务位于此段(如 192.0.0.192)
+	"198.18.0.0/15",  // 基准测试
+	"240.0.0.0/4",    // 保留地址与广播地址
+	"64:ff9b::/96",   // NAT64 可映射到任意内网 IPv4
+	"64:ff9b:1::/48", // 本地 NAT64
+	"2001:db8::/32",  // 文档示例
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// isPublicIP 链路本地地址段包含 169.254.169.254 与 fe80::a9fe:a9fe 等元数据服务地址
+// fd00:ec2::254 等唯一本地地址由 IsPrivate 覆盖
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkFetchScheme(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return codes.ErrImgImportURLForbidden.WithDetail(map[string]any{"scheme": u.Scheme})
+	}
+	return nil
+}
+
+func (f *HTTPFetcher) Fetch(rawURL string, maxSize int64) (*domain.FetchedFile, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, codes.ErrImgImportURLForbidden.WithCause(err)
+	}
+	if err := checkFetchScheme(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, codes.ErrImgImportURLForbidden.WithCause(err)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		if errors.Is(err, codes.ErrImgImportURLForbidden) {
+			return nil, codes.ErrImgImportURLForbidden
+		}
+		return nil, codes.ErrImgImportFetchFailed.WithCause(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, codes.ErrImgImportFetchFailed.WithDetail(map[string]any{"status": resp.StatusCode})
+	}
+	if resp.ContentLength > maxSize {
+		return nil, codes.ErrImgImportFileTooLarge
+	}
+
+	// 多读一个字节以判断是否超出上限
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, codes.ErrImgImportFetchFailed.WithCause(err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, codes.ErrImgImportFileTooLarge
+	}
+
+	return &domain.FetchedFile{
+		Data:     data,
+		Filename: fetchedFilename(resp),
+	}, nil
+}
+
+func fetchedFilename(resp *http.Response) string {
+	if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+		if _, params, err := mime.ParseMediaType(disposition); err == nil && params["filename"] != "" {
+			return params["filename"]
+		}
+	}
+
+	// 重定向后以最终地址为准
+	name, err := url.PathUnescape(path.Base(resp.Request.URL.Path))
+	if err != nil || name == "/" || name == "." {
+		return ""
+	}
+	return name
+}
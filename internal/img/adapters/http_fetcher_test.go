@@ -0,0 +1,171 @@
+package adapters
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"saas/internal/common/reskit/codes"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// errCode 取出业务错误码 非业务错误返回 0
+func errCode(err error) int {
+	var plain codes.ErrCode
+	if errors.As(err, &plain) {
+		return plain.Code
+	}
+	var withDetail codes.ErrCodeWithDetail
+	if errors.As(err, &withDetail) {
+		return withDetail.Code
+	}
+	var withCause codes.ErrCodeWithCause
+	if errors.As(err, &withCause) {
+		return withCause.Code
+	}
+	return 0
+}
+
+func TestHTTPFetcherBlocksPrivateNetwork(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte("secret"))
+	}))
+	defer server.Close()
+
+	_, err := newHTTPFetcher(false).Fetch(server.URL+"/a.png", 1024)
+	if got := errCode(err); got != codes.ErrImgImportURLForbidden.Code {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+	if hits.Load() != 0 {
+		t.Fatalf("request reached the private server %d times", hits.Load())
+	}
+}
+
+func TestHTTPFetcherRejectsNonHTTPScheme(t *testing.T) {
+	for _, rawURL := range []string{"file:///etc/passwd", "ftp://example.com/a.png", "gopher://example.com"} {
+		_, err := newHTTPFetcher(false).Fetch(rawURL, 1024)
+		if got := errCode(err); got != codes.ErrImgImportURLForbidden.Code {
+			t.Errorf("%s: expected forbidden error, got %v", rawURL, err)
+		}
+	}
+}
+
+func TestHTTPFetcherRedirectLimit(t *testing.T) {
+	var hits atomic.Int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		http.Redirect(w, r, server.URL+"/loop", http.StatusFound)
+	}))
+	defer server.Close()
+
+	_, err := newHTTPFetcher(true).Fetch(server.URL, 1024)
+	if got := errCode(err); got != codes.ErrImgImportFetchFailed.Code {
+		t.Fatalf("expected fetch failed error, got %v", err)
+	}
+	if hits.Load() != fetchMaxRedirects {
+		t.Fatalf("expected %d requests, got %d", fetchMaxRedirects, hits.Load())
+	}
+}
+
+func TestHTTPFetcherFollowsRedirects(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/start", http.RedirectHandler("/photo.png", http.StatusFound))
+	mux.HandleFunc("/photo.png", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("image"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	file, err := newHTTPFetcher(true).Fetch(server.URL+"/start", 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(file.Data) != "image" {
+		t.Fatalf("unexpected body %q", file.Data)
+	}
+	if file.Filename != "photo.png" {
+		t.Fatalf("expected filename from final url, got %q", file.Filename)
+	}
+}
+
+func TestHTTPFetcherSizeLimit(t *testing.T) {
+	const maxSize = 16
+	body := strings.Repeat("x", maxSize+1)
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{
+			name: "content length",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(body))
+			},
+		},
+		{
+			// 未声明长度时按实际读取量判断
+			name: "chunked",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(body[:maxSize/2]))
+				w.(http.Flusher).Flush()
+				w.Write([]byte(body[maxSize/2:]))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			_, err := newHTTPFetcher(true).Fetch(server.URL, maxSize)
+			if got := errCode(err); got != codes.ErrImgImportFileTooLarge.Code {
+				t.Fatalf("expected file too large error, got %v", err)
+			}
+		})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body[:maxSize]))
+	}))
+	defer server.Close()
+
+	if _, err := newHTTPFetcher(true).Fetch(server.URL, maxSize); err != nil {
+		t.Fatalf("body at the limit should be accepted: %v", err)
+	}
+}
+
+func TestIsPublicIP(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8":         true,
+		"1.1.1.1":         true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"10.0.0.1":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"0.0.0.0":         false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"100.100.100.200": false,
+		"192.0.0.192":     false,
+		"198.18.0.1":      false,
+		"255.255.255.255": false,
+		"::1":             false,
+		"fe80::a9fe:a9fe": false,
+		"fd00:ec2::254":   false,
+		"::ffff:10.0.0.1": false,
+		"64:ff9b::a00:1":  false,
+	}
+
+	for raw, want := range tests {
+		if got := isPublicIP(net.ParseIP(raw)); got != want {
+			t.Errorf("isPublicIP(%s) = %v, want %v", raw, got, want)
+		}
+	}
+}
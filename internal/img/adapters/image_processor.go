@@ -0,0 +1,443 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/pkg/errors"
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	// 单张图片允许解码的最大像素数 防止解压炸弹耗尽内存
+	maxDecodePixels = 50_000_000
+	// GIF 各帧像素数之和的上限 帧为调色板图像 每像素 1 字节 与单张图片 RGBA 解码的内存相当
+	maxGIFTotalPixels = 4 * maxDecodePixels
+)
+
+type ImageProcessor struct {
+}
+
+func NewImageProcessor() domain.ImageProcessor {
+	return &ImageProcessor{}
+}
+
+func (p *ImageProcessor) Process(src io.Reader, opts *domain.ProcessOptions) (*domain.ProcessedImage, error) {
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	format := domain.DetectImageFormat(data)
+	switch format {
+	case domain.ImageFormatUnknown:
+		return nil, codes.ErrImgUnsupportedFormat
+	case domain.ImageFormatSVG:
+		// 公共桶中的 SVG 会被浏览器直接渲染 移除脚本后保存
+		sanitized, err := sanitizeSVG(data)
+		if err != nil {
+			return nil, codes.ErrImgUnsupportedFormat.WithCause(err)
+		}
+		return passthrough(sanitized, format, 0, 0), nil
+	case domain.ImageFormatAVIF:
+		// 纯 Go 环境无法解码 原样保存
+		return passthrough(data, format, 0, 0), nil
+	}
+
+	cfg, err := decodeConfig(data, format)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+	if cfg.Width*cfg.Height > maxDecodePixels {
+		return nil, codes.ErrImgDimensionTooLarge.WithDetail(map[string]any{
+			"width":  cfg.Width,
+			"height": cfg.Height,
+		})
+	}
+
+	// 动图保持 GIF 原样 避免丢失动画帧
+	if format == domain.ImageFormatGIF {
+		// DecodeAll 仅限制单帧尺寸 解码前按数据块累加全部帧的像素数
+		total, err := gifTotalPixels(data)
+		if err != nil {
+			return nil, codes.ErrImgProcessFailed.WithCause(err)
+		}
+		if total > maxGIFTotalPixels {
+			return nil, codes.ErrImgDimensionTooLarge.WithDetail(map[string]any{
+				"width":  cfg.Width,
+				"height": cfg.Height,
+				"pixels": total,
+			})
+		}
+
+		g, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, codes.ErrImgProcessFailed.WithCause(err)
+		}
+		if len(g.Image) > 1 {
+			animated := passthrough(data, format, cfg.Width, cfg.Height)
+			animated.Animated = true
+			return animated, nil
+		}
+	}
+
+	img, err := decode(data, format)
+	if err != nil {
+		// x/image/webp 不支持动画 WebP 此时原样保存
+		if format == domain.ImageFormatWebP {
+			animated := passthrough(data, format, cfg.Width, cfg.Height)
+			animated.Animated = true
+			return animated, nil
+		}
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+
+	// WebP 仅支持无损编码 保持原格式时直接保存
+	if format == domain.ImageFormatWebP && opts.Target != domain.OutputFormatJPEG {
+		return passthrough(data, format, cfg.Width, cfg.Height), nil
+	}
+
+	target := resolveTargetFormat(format, opts.Target, hasAlpha(img))
+	if target == format && (format == domain.ImageFormatGIF || format == domain.ImageFormatWebP) {
+		return passthrough(data, format, cfg.Width, cfg.Height), nil
+	}
+
+	encoded, err := encode(img, target, opts.Quality)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+
+	// 同格式重新编码后反而变大 保留原文件
+	// WebP 仅能无损编码 由有损格式转换后通常更大 同样保留原文件
+	if (target == format || target == domain.ImageFormatWebP) && len(encoded) >= len(data) {
+		return passthrough(data, format, cfg.Width, cfg.Height), nil
+	}
+
+	return &domain.ProcessedImage{
+		Data:   encoded,
+		Format: target,
+		Width:  cfg.Width,
+		Height: cfg.Height,
+	}, nil
+}
+
+func (p *ImageProcessor) Resize(src *domain.ProcessedImage, width int, quality int) (*domain.ProcessedImage, error) {
+	if !src.Resizable() || width <= 0 || width >= src.Width {
+		return nil, errors.Errorf("image %s %dx%d cannot be resized to width %d", src.Format, src.Width, src.Height, width)
+	}
+
+	img, err := decode(src.Data, src.Format)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+
+	height := max(1, int(math.Round(float64(src.Height)*float64(width)/float64(src.Width))))
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	// GIF 调色板编码会明显失真 缩放版本改用 PNG
+	format := src.Format
+	if format == domain.ImageFormatGIF {
+		format = domain.ImageFormatPNG
+	}
+
+	encoded, err := encode(dst, format, quality)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+
+	return &domain.ProcessedImage{
+		Data:   encoded,
+		Format: format,
+		Width:  width,
+		Height: height,
+	}, nil
+}
+
+func (p *ImageProcessor) Transform(src []byte, opts *domain.TransformOptions) (*domain.ProcessedImage, error) {
+	format := domain.DetectImageFormat(src)
+	switch format {
+	case domain.ImageFormatUnknown, domain.ImageFormatSVG, domain.ImageFormatAVIF:
+		return nil, codes.ErrImgUnsupportedFormat
+	}
+
+	cfg, err := decodeConfig(src, format)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+	if cfg.Width*cfg.Height > maxDecodePixels {
+		return nil, codes.ErrImgDimensionTooLarge.WithDetail(map[string]any{
+			"width":  cfg.Width,
+			"height": cfg.Height,
+		})
+	}
+
+	img, err := decode(src, format)
+	if err != nil {
+		// 动画 WebP 无法解码
+		return nil, codes.ErrImgUnsupportedFormat.WithCause(err)
+	}
+
+	srcRect, width, height := transformGeometry(img.Bounds(), opts)
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, srcRect, draw.Src, nil)
+
+	target := opts.Format
+	if target == domain.ImageFormatUnknown {
+		target = format
+		// 变换结果为单帧 GIF 与 BMP 统一输出 PNG
+		if format == domain.ImageFormatGIF || format == domain.ImageFormatBMP {
+			target = domain.ImageFormatPNG
+		}
+	}
+	if target == domain.ImageFormatJPEG && hasAlpha(img) {
+		target = domain.ImageFormatPNG
+	}
+
+	encoded, err := encode(dst, target, opts.Quality)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+
+	return &domain.ProcessedImage{
+		Data:   encoded,
+		Format: target,
+		Width:  width,
+		Height: height,
+	}, nil
+}
+
+func (p *ImageProcessor) Probe(src []byte) (*domain.ProcessedImage, error) {
+	format := domain.DetectImageFormat(src)
+	switch format {
+	case domain.ImageFormatUnknown:
+		return nil, codes.ErrImgUnsupportedFormat
+	case domain.ImageFormatSVG, domain.ImageFormatAVIF:
+		return passthrough(src, format, 0, 0), nil
+	}
+
+	cfg, err := decodeConfig(src, format)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+
+	return passthrough(src, format, cfg.Width, cfg.Height), nil
+}
+
+// transformGeometry 计算源图采样区域与输出尺寸 不放大原图
+func transformGeometry(bounds image.Rectangle, opts *domain.TransformOptions) (image.Rectangle, int, int) {
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	w, h := opts.Width, opts.Height
+
+	// 只给出一边时按原图比例推算另一边
+	if w == 0 && h == 0 {
+		return bounds, srcW, srcH
+	}
+	if w == 0 || h == 0 {
+		scale := math.Min(1, scaleOf(w, srcW, h, srcH))
+		return bounds, roundDimension(float64(srcW) * scale), roundDimension(float64(srcH) * scale)
+	}
+
+	switch opts.Fit {
+	case domain.TransformFitFill:
+		return bounds, min(w, srcW), min(h, srcH)
+	case domain.TransformFitCover:
+		scale := math.Min(1, math.Max(float64(w)/float64(srcW), float64(h)/float64(srcH)))
+		outW, outH := min(w, srcW), min(h, srcH)
+		// 居中裁剪 采样区域与输出保持相同比例
+		cropW := min(srcW, roundDimension(float64(outW)/scale))
+		cropH := min(srcH, roundDimension(float64(outH)/scale))
+		x0 := bounds.Min.X + (srcW-cropW)/2
+		y0 := bounds.Min.Y + (srcH-cropH)/2
+		return image.Rect(x0, y0, x0+cropW, y0+cropH), outW, outH
+	default:
+		scale := math.Min(1, math.Min(float64(w)/float64(srcW), float64(h)/float64(srcH)))
+		return bounds, roundDimension(float64(srcW) * scale), roundDimension(float64(srcH) * scale)
+	}
+}
+
+func scaleOf(w, srcW, h, srcH int) float64 {
+	if w > 0 {
+		return float64(w) / float64(srcW)
+	}
+	return float64(h) / float64(srcH)
+}
+
+func roundDimension(v float64) int {
+	return max(1, int(math.Round(v)))
+}
+
+func passthrough(data []byte, format domain.ImageFormat, width, height int) *domain.ProcessedImage {
+	return &domain.ProcessedImage{
+		Data:   data,
+		Format: format,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// resolveTargetFormat 计算最终编码格式
+// 带透明通道的图片不转为 JPEG BMP 无压缩 保持原格式时转为 PNG
+func resolveTargetFormat(source domain.ImageFormat, target domain.OutputFormat, alpha bool) domain.ImageFormat {
+	switch target {
+	case domain.OutputFormatWebP:
+		return domain.ImageFormatWebP
+	case domain.OutputFormatJPEG:
+		if !alpha {
+			return domain.ImageFormatJPEG
+		}
+		if source == domain.ImageFormatWebP {
+			return domain.ImageFormatWebP
+		}
+		return domain.ImageFormatPNG
+	default:
+		if source == domain.ImageFormatBMP {
+			return domain.ImageFormatPNG
+		}
+		return source
+	}
+}
+
+// gifTotalPixels 遍历 GIF 数据块累加各帧的像素数 不解压帧数据
+func gifTotalPixels(data []byte) (int, error) {
+	// 文件头 6 字节 逻辑屏幕描述 7 字节
+	if len(data) < 13 {
+		return 0, errors.New("gif: truncated header")
+	}
+	pos := 13
+	if data[10]&0x80 != 0 {
+		pos += 3 << (data[10]&0x07 + 1)
+	}
+
+	total := 0
+	for pos < len(data) {
+		var err error
+		switch data[pos] {
+		case 0x2C:
+			// 图像描述 分隔符 1 字节 位置 4 字节 宽高 4 字节 标志 1 字节
+			if pos+10 > len(data) {
+				return 0, errors.New("gif: truncated image descriptor")
+			}
+			width := int(binary.LittleEndian.Uint16(data[pos+5:]))
+			height := int(binary.LittleEndian.Uint16(data[pos+7:]))
+			total += width * height
+			flags := data[pos+9]
+			pos += 10
+			if flags&0x80 != 0 {
+				pos += 3 << (flags&0x07 + 1)
+			}
+			// LZW 最小码长 1 字节
+			pos, err = skipGIFSubBlocks(data, pos+1)
+		case 0x21:
+			// 扩展 引导符与标签各 1 字节
+			pos, err = skipGIFSubBlocks(data, pos+2)
+		case 0x3B:
+			return total, nil
+		default:
+			return 0, errors.Errorf("gif: unknown block 0x%02x", data[pos])
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return total, nil
+}
+
+// skipGIFSubBlocks 跳过以长度为 0 的子块结尾的数据子块 返回其后的位置
+func skipGIFSubBlocks(data []byte, pos int) (int, error) {
+	for {
+		if pos >= len(data) {
+			return 0, errors.New("gif: truncated data sub-blocks")
+		}
+		size := int(data[pos])
+		pos++
+		if size == 0 {
+			return pos, nil
+		}
+		pos += size
+	}
+}
+
+func decodeConfig(data []byte, format domain.ImageFormat) (image.Config, error) {
+	r := bytes.NewReader(data)
+	switch format {
+	case domain.ImageFormatJPEG:
+		return jpeg.DecodeConfig(r)
+	case domain.ImageFormatPNG:
+		return png.DecodeConfig(r)
+	case domain.ImageFormatGIF:
+		return gif.DecodeConfig(r)
+	case domain.ImageFormatWebP:
+		return webp.DecodeConfig(r)
+	case domain.ImageFormatBMP:
+		return bmp.DecodeConfig(r)
+	default:
+		return image.Config{}, errors.Errorf("unsupported format %s", format)
+	}
+}
+
+func decode(data []byte, format domain.ImageFormat) (image.Image, error) {
+	r := bytes.NewReader(data)
+	switch format {
+	case domain.ImageFormatJPEG:
+		return jpeg.Decode(r)
+	case domain.ImageFormatPNG:
+		return png.Decode(r)
+	case domain.ImageFormatGIF:
+		return gif.Decode(r)
+	case domain.ImageFormatWebP:
+		return webp.Decode(r)
+	case domain.ImageFormatBMP:
+		return bmp.Decode(r)
+	default:
+		return nil, errors.Errorf("unsupported format %s", format)
+	}
+}
+
+func encode(img image.Image, format domain.ImageFormat, quality int) ([]byte, error) {
+	output := &bytes.Buffer{}
+
+	var err error
+	switch format {
+	case domain.ImageFormatJPEG:
+		if quality <= 0 || quality > 100 {
+			quality = domain.DefaultQuality
+		}
+		err = jpeg.Encode(output, img, &jpeg.Options{Quality: quality})
+	case domain.ImageFormatPNG:
+		encoder := &png.Encoder{CompressionLevel: png.BestCompression}
+		err = encoder.Encode(output, img)
+	case domain.ImageFormatGIF:
+		err = gif.Encode(output, img, nil)
+	case domain.ImageFormatWebP:
+		// nativewebp 仅支持无损 VP8L 编码 quality 不生效
+		err = nativewebp.Encode(output, img, nil)
+	default:
+		err = errors.Errorf("unsupported output format %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// hasAlpha 判断图片是否存在非不透明像素
+func hasAlpha(img image.Image) bool {
+	if opaque, ok := img.(interface{ Opaque() bool }); ok {
+		return !opaque.Opaque()
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"bytes"
+	"image"
+	"image/color/palette"
+	"image/gif"
+	"saas/internal/img/domain"
+	"testing"
+)
+
+func encodeTestGIF(t *testing.T, frames int, width, height int) []byte {
+	t.Helper()
+
+	g := &gif.GIF{}
+	for range frames {
+		g.Image = append(g.Image, image.NewPaletted(image.Rect(0, 0, width, height), palette.Plan9))
+		g.Delay = append(g.Delay, 10)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := gif.EncodeAll(buf, g); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestGIFTotalPixels(t *testing.T) {
+	data := encodeTestGIF(t, 3, 40, 30)
+
+	total, err := gifTotalPixels(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3*40*30 {
+		t.Fatalf("expected %d pixels, got %d", 3*40*30, total)
+	}
+
+	if _, err := gifTotalPixels(data[:len(data)/2]); err == nil {
+		t.Fatal("expected error for truncated gif")
+	}
+}
+
+func TestProcessKeepsAnimatedGIF(t *testing.T) {
+	data := encodeTestGIF(t, 2, 8, 8)
+
+	processed, err := NewImageProcessor().Process(bytes.NewReader(data), &domain.ProcessOptions{Target: domain.OutputFormatOriginal})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !processed.Animated || !bytes.Equal(processed.Data, data) {
+		t.Fatal("animated gif should be stored unchanged")
+	}
+}
@@ -0,0 +1,164 @@
+package adapters
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"sync"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/pkg/errors"
+	"github.com/wenlng/go-captcha-assets/resources/fonts/fzshengsksjw"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	// watermarkMarginPercent 水印与图片边缘的距离 占短边的百分比
+	watermarkMarginPercent = 3
+	// watermarkMeasureSize 测量文字宽度时使用的字号 再按比例换算到目标宽度
+	watermarkMeasureSize = 64
+	watermarkMinFontSize = 8
+)
+
+// 水印文字字体 与验证码共用 支持中文
+var (
+	watermarkFontOnce sync.Once
+	watermarkFont     *truetype.Font
+	watermarkFontErr  error
+)
+
+func loadWatermarkFont() (*truetype.Font, error) {
+	watermarkFontOnce.Do(func() {
+		watermarkFont, watermarkFontErr = fzshengsksjw.GetFont()
+	})
+	return watermarkFont, watermarkFontErr
+}
+
+// Watermark 在图片上叠加文字或图片水印 GIF 改为 PNG 编码 避免调色板失真
+func (p *ImageProcessor) Watermark(src *domain.ProcessedImage, opts *domain.WatermarkOptions) (*domain.ProcessedImage, error) {
+	if !src.Resizable() {
+		return nil, errors.Errorf("image %s %dx%d cannot be watermarked", src.Format, src.Width, src.Height)
+	}
+
+	img, err := decode(src.Data, src.Format)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+	canvas := image.NewNRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+	draw.Draw(canvas, canvas.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	// 1.按比例生成水印 过高时按图片高度缩小
+	width := max(1, canvas.Bounds().Dx()*opts.Scale/100)
+	var mark image.Image
+	switch opts.Kind {
+	case domain.WatermarkKindText:
+		mark, err = renderWatermarkText(opts.Text, opts.TextColor, width)
+	case domain.WatermarkKindImage:
+		mark, err = scaleWatermarkImage(opts.Image, width)
+	default:
+		err = errors.Errorf("unsupported watermark kind %s", opts.Kind)
+	}
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+	if mark.Bounds().Dy() > canvas.Bounds().Dy() {
+		mark = scaleImage(mark, mark.Bounds().Dx()*canvas.Bounds().Dy()/mark.Bounds().Dy(), canvas.Bounds().Dy())
+	}
+
+	// 2.按位置与不透明度叠加
+	origin := watermarkOrigin(canvas.Bounds(), mark.Bounds(), opts.Position)
+	mask := image.NewUniform(color.Alpha{A: uint8(opts.Opacity * 0xff / 100)})
+	draw.DrawMask(canvas, mark.Bounds().Sub(mark.Bounds().Min).Add(origin), mark, mark.Bounds().Min, mask, image.Point{}, draw.Over)
+
+	format := src.Format
+	if format == domain.ImageFormatGIF {
+		format = domain.ImageFormatPNG
+	}
+
+	encoded, err := encode(canvas, format, opts.Quality)
+	if err != nil {
+		return nil, codes.ErrImgProcessFailed.WithCause(err)
+	}
+
+	return &domain.ProcessedImage{
+		Data:   encoded,
+		Format: format,
+		Width:  src.Width,
+		Height: src.Height,
+	}, nil
+}
+
+// renderWatermarkText 按目标宽度换算字号后绘制文字 背景透明
+func renderWatermarkText(text string, textColor color.NRGBA, width int) (image.Image, error) {
+	f, err := loadWatermarkFont()
+	if err != nil {
+		return nil, err
+	}
+
+	measured := font.MeasureString(truetype.NewFace(f, &truetype.Options{Size: watermarkMeasureSize}), text).Ceil()
+	if measured <= 0 {
+		return nil, errors.New("empty watermark text")
+	}
+	size := max(watermarkMinFontSize, watermarkMeasureSize*float64(width)/float64(measured))
+
+	face := truetype.NewFace(f, &truetype.Options{Size: size, Hinting: font.HintingFull})
+	defer face.Close()
+
+	metrics := face.Metrics()
+	dst := image.NewNRGBA(image.Rect(0, 0,
+		font.MeasureString(face, text).Ceil(),
+		(metrics.Ascent + metrics.Descent).Ceil(),
+	))
+	drawer := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(textColor),
+		Face: face,
+		Dot:  fixed.Point26_6{Y: metrics.Ascent},
+	}
+	drawer.DrawString(text)
+
+	return dst, nil
+}
+
+func scaleWatermarkImage(data []byte, width int) (image.Image, error) {
+	format := domain.DetectImageFormat(data)
+	mark, err := decode(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := mark.Bounds()
+	height := max(1, int(math.Round(float64(bounds.Dy())*float64(width)/float64(bounds.Dx()))))
+	return scaleImage(mark, width, height), nil
+}
+
+func scaleImage(src image.Image, width, height int) image.Image {
+	dst := image.NewNRGBA(image.Rect(0, 0, max(1, width), max(1, height)))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+	return dst
+}
+
+// watermarkOrigin 水印左上角的位置 四角留出边距
+func watermarkOrigin(canvas, mark image.Rectangle, position domain.WatermarkPosition) image.Point {
+	margin := min(canvas.Dx(), canvas.Dy()) * watermarkMarginPercent / 100
+	left, top := margin, margin
+	right := max(0, canvas.Dx()-mark.Dx()-margin)
+	bottom := max(0, canvas.Dy()-mark.Dy()-margin)
+
+	switch position {
+	case domain.WatermarkTopLeft:
+		return image.Pt(left, top)
+	case domain.WatermarkTopRight:
+		return image.Pt(right, top)
+	case domain.WatermarkBottomLeft:
+		return image.Pt(left, bottom)
+	case domain.WatermarkCenter:
+		return image.Pt((canvas.Dx()-mark.Dx())/2, (canvas.Dy()-mark.Dy())/2)
+	default:
+		return image.Pt(right, bottom)
+	}
+}
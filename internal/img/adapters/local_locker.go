@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LocalLocker 进程内锁 用于单实例部署与测试 持有期间不会过期 ttl 被忽略
+// 无人持有或等待的锁会被移除 不会随 key 数量增长
+// 令牌不小于当前时间的微秒数 重启或切换锁实现后仍大于已写入记录的令牌
+type LocalLocker struct {
+	mu    sync.Mutex
+	locks map[string]*localLockEntry
+	fence domain.FencingToken
+}
+
+type localLockEntry struct {
+	// sem 容量为 1 写入表示持有
+	sem chan struct{}
+	// refs 持有者与等待者的数量 归零时移除
+	refs int
+}
+
+func NewLocalLocker() *LocalLocker {
+	return &LocalLocker{locks: make(map[string]*localLockEntry)}
+}
+
+func (l *LocalLocker) Lock(key string, _, wait time.Duration) (domain.Lock, error) {
+	l.mu.Lock()
+	entry, ok := l.locks[key]
+	if !ok {
+		entry = &localLockEntry{sem: make(chan struct{}, 1)}
+		l.locks[key] = entry
+	}
+	entry.refs++
+	l.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case entry.sem <- struct{}{}:
+	case <-timer.C:
+		l.release(key, entry)
+		return nil, codes.ErrImgLockTimeout
+	}
+
+	l.mu.Lock()
+	l.fence = max(l.fence+1, domain.FencingToken(time.Now().UnixMicro()))
+	token := l.fence
+	l.mu.Unlock()
+
+	return &localLock{locker: l, key: key, entry: entry, token: token}, nil
+}
+
+func (l *LocalLocker) release(key string, entry *localLockEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.refs--
+	if entry.refs == 0 {
+		delete(l.locks, key)
+	}
+}
+
+type localLock struct {
+	locker *LocalLocker
+	key    string
+	entry  *localLockEntry
+	token  domain.FencingToken
+	once   sync.Once
+}
+
+func (l *localLock) Token() domain.FencingToken {
+	return l.token
+}
+
+func (l *localLock) Unlock() error {
+	released := false
+	l.once.Do(func() {
+		<-l.entry.sem
+		l.locker.release(l.key, l.entry)
+		released = true
+	})
+	if !released {
+		return errors.Errorf("锁 %s 已释放", l.key)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"saas/internal/common/reskit/codes"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLocalLockerContention(t *testing.T) {
+	locker := NewLocalLocker()
+
+	var holders, maxHolders atomic.Int32
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lock, err := locker.Lock("img:1", time.Second, 5*time.Second)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			n := holders.Add(1)
+			for {
+				m := maxHolders.Load()
+				if n <= m || maxHolders.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			holders.Add(-1)
+			if err := lock.Unlock(); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxHolders.Load() != 1 {
+		t.Fatalf("expected at most one holder, got %d", maxHolders.Load())
+	}
+	if len(locker.locks) != 0 {
+		t.Fatalf("expected released entries to be removed, %d left", len(locker.locks))
+	}
+}
+
+func TestLocalLockerWaitTimeout(t *testing.T) {
+	locker := NewLocalLocker()
+
+	lock, err := locker.Lock("img:1", time.Second, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = locker.Lock("img:1", time.Second, 20*time.Millisecond)
+	if got := errCode(err); got != codes.ErrImgLockTimeout.Code {
+		t.Fatalf("expected lock timeout error, got %v", err)
+	}
+
+	// 不同 key 互不影响
+	other, err := locker.Lock("img:2", time.Second, 20*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.Unlock()
+
+	if err := lock.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+	again, err := locker.Lock("img:1", time.Second, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("lock should be available after unlock: %v", err)
+	}
+	again.Unlock()
+
+	if len(locker.locks) != 0 {
+		t.Fatalf("expected timed out waiters to be removed, %d left", len(locker.locks))
+	}
+}
+
+// 进程内锁持有期间不会因 ttl 到期被他人获取
+func TestLocalLockerDoesNotExpire(t *testing.T) {
+	locker := NewLocalLocker()
+
+	lock, err := locker.Lock("img:1", 10*time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Unlock()
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, err = locker.Lock("img:1", 10*time.Millisecond, 20*time.Millisecond)
+	if got := errCode(err); got != codes.ErrImgLockTimeout.Code {
+		t.Fatalf("expected lock timeout after ttl elapsed, got %v", err)
+	}
+}
+
+func TestLocalLockerTokensIncrease(t *testing.T) {
+	locker := NewLocalLocker()
+
+	lock, err := locker.Lock("img:1", time.Second, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev := lock.Token()
+	lock.Unlock()
+
+	for _, key := range []string{"img:1", "img:2", "img:1"} {
+		lock, err := locker.Lock(key, time.Second, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lock.Token() <= prev {
+			t.Fatalf("token %d is not greater than %d", lock.Token(), prev)
+		}
+		prev = lock.Token()
+		lock.Unlock()
+	}
+
+	// 重启后令牌仍大于此前写入的令牌 重启耗时远大于连续加锁的令牌增量
+	time.Sleep(time.Millisecond)
+	restarted, err := NewLocalLocker().Lock("img:1", time.Second, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restarted.Unlock()
+	if restarted.Token() <= prev {
+		t.Fatalf("token %d after restart is not greater than %d", restarted.Token(), prev)
+	}
+}
+
+func TestLocalLockerDoubleUnlock(t *testing.T) {
+	locker := NewLocalLocker()
+
+	lock, err := locker.Lock("img:1", time.Second, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+
+	next, err := locker.Lock("img:1", time.Second, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer next.Unlock()
+
+	if err := lock.Unlock(); err == nil {
+		t.Fatal("expected error on second unlock")
+	}
+
+	// 重复释放不影响他人持有的锁
+	_, err = locker.Lock("img:1", time.Second, 20*time.Millisecond)
+	if got := errCode(err); got != codes.ErrImgLockTimeout.Code {
+		t.Fatalf("expected lock to remain held, got %v", err)
+	}
+}
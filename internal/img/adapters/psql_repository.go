@@ -1,16 +1,19 @@
 package adapters
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"saas/internal/common/orm"
 	"saas/internal/common/reskit/codes"
+	"saas/internal/common/secrets"
 	"saas/internal/common/utils/dbkit"
 	"saas/internal/img/domain"
 	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/pkg/errors"
 )
@@ -19,6 +22,18 @@ type ImgPSQLRepository struct {
 }
 
 func NewImgPSQLRepository() domain.ImgRepository {
+	// 存储密钥随密钥轮换重新加密
+	secrets.RegisterColumn(secrets.Column{
+		Table:     orm.TableNames.TenantStorageConfigs,
+		KeyColumn: orm.TenantStorageConfigColumns.TenantID,
+		Column:    orm.TenantStorageConfigColumns.SecretAccessKey,
+	})
+	secrets.RegisterColumn(secrets.Column{
+		Table:     orm.TableNames.ImgStorageMigrations,
+		KeyColumn: orm.ImgStorageMigrationColumns.ID,
+		Column:    orm.ImgStorageMigrationColumns.SecretAccessKey,
+	})
+
 	return &ImgPSQLRepository{}
 }
 
@@ -46,10 +61,14 @@ func (repo *ImgPSQLRepository) FindByID(tenantID domain.TenantID, imgID domain.I
 	return ormImgToDomain(ormImg), err
 }
 
+// ExistByPath 路径被其他图片占用或被去重关联的图片用作存储对象时均视为存在
 func (repo *ImgPSQLRepository) ExistByPath(tenantID domain.TenantID, path string) (bool, error) {
 	exist, err := orm.Imgs(
 		orm.ImgWhere.TenantID.EQ(tenantID.String()),
-		orm.ImgWhere.Path.EQ(path),
+		qm.Expr(
+			orm.ImgWhere.Path.EQ(path),
+			qm.Or2(orm.ImgWhere.ObjectPath.EQ(null.StringFrom(path))),
+		),
 		qm.WithDeleted(),
 	).ExistsG()
 	if err != nil {
@@ -58,6 +77,41 @@ func (repo *ImgPSQLRepository) ExistByPath(tenantID domain.TenantID, path string
 	return exist, nil
 }
 
+// FindByContentHash 查询内容相同且未删除的最早一张图片
+func (repo *ImgPSQLRepository) FindByContentHash(tenantID domain.TenantID, hash string) (*domain.Img, error) {
+	ormImg, err := orm.Imgs(
+		orm.ImgWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgWhere.ContentHash.EQ(null.StringFrom(hash)),
+		qm.OrderBy(orm.ImgColumns.CreatedAt+" ASC"),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgNotFound
+		}
+		return nil, err
+	}
+
+	return ormImgToDomain(ormImg), nil
+}
+
+// CountObjectRefs 统计除 excludeID 外仍引用该存储对象的图片数
+// deleted 为 true 时统计回收站中的图片 否则统计未删除的图片
+func (repo *ImgPSQLRepository) CountObjectRefs(tenantID domain.TenantID, objectPath string, deleted bool, excludeID domain.ImgID) (int64, error) {
+	mods := []qm.QueryMod{
+		orm.ImgWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgWhere.ID.NEQ(excludeID.String()),
+		qm.Expr(
+			orm.ImgWhere.ObjectPath.EQ(null.StringFrom(objectPath)),
+			qm.Or2(qm.Expr(orm.ImgWhere.ObjectPath.IsNull(), orm.ImgWhere.Path.EQ(objectPath))),
+		),
+	}
+	if deleted {
+		mods = append(mods, qm.WithDeleted(), orm.ImgWhere.DeletedAt.IsNotNull())
+	}
+
+	return orm.Imgs(mods...).CountG()
+}
+
 func (repo *ImgPSQLRepository) Create(img *domain.Img, categoryID domain.CategoryID) (*domain.Img, error) {
 	ormImg := domainImgToORM(img)
 
@@ -85,36 +139,50 @@ func (repo *ImgPSQLRepository) Create(img *domain.Img, categoryID domain.Categor
 	return ormImgToDomain(ormImg), nil
 }
 
-func (repo *ImgPSQLRepository) Delete(tenantID domain.TenantID, imgID domain.ImgID, hard bool) error {
-	rows, err := orm.Imgs(
+func (repo *ImgPSQLRepository) Delete(tenantID domain.TenantID, imgID domain.ImgID, hard bool, token domain.FencingToken) error {
+	query := orm.Imgs(
 		orm.ImgWhere.TenantID.EQ(tenantID.String()),
 		orm.ImgWhere.ID.EQ(imgID.String()),
+		orm.ImgWhere.LockToken.LTE(int64(token)),
 		qm.WithDeleted(),
-	).DeleteAllG(hard)
+	)
+
+	var rows int64
+	var err error
+	if hard {
+		rows, err = query.DeleteAllG(true)
+	} else {
+		rows, err = query.UpdateAllG(orm.M{
+			orm.ImgColumns.DeletedAt: time.Now(),
+			orm.ImgColumns.LockToken: int64(token),
+		})
+	}
 	if err != nil {
 		return err
 	}
 	if rows == 0 {
-		return codes.ErrImgNotFound
+		return repo.fencedWriteMissed(tenantID, imgID)
 	}
 	return nil
 }
 
-func (repo *ImgPSQLRepository) Restore(tenantID domain.TenantID, imgID domain.ImgID) (*domain.Img, error) {
+func (repo *ImgPSQLRepository) Restore(tenantID domain.TenantID, imgID domain.ImgID, token domain.FencingToken) (*domain.Img, error) {
 	rows, err := orm.Imgs(
 		orm.ImgWhere.TenantID.EQ(tenantID.String()),
 		orm.ImgWhere.ID.EQ(imgID.String()),
+		orm.ImgWhere.LockToken.LTE(int64(token)),
 		qm.WithDeleted(),
 	).UpdateAllG(orm.M{
 		orm.ImgColumns.DeletedAt: nil,
 		orm.ImgColumns.UpdatedAt: time.Now(),
+		orm.ImgColumns.LockToken: int64(token),
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	if rows == 0 {
-		return nil, codes.ErrImgNotFound
+		return nil, repo.fencedWriteMissed(tenantID, imgID)
 	}
 
 	img, err := repo.FindByID(tenantID, imgID)
@@ -125,7 +193,58 @@ func (repo *ImgPSQLRepository) Restore(tenantID domain.TenantID, imgID domain.Im
 	return img, nil
 }
 
+// fencedWriteMissed 带令牌的写入未命中时 区分记录不存在与令牌已过期
+func (repo *ImgPSQLRepository) fencedWriteMissed(tenantID domain.TenantID, imgID domain.ImgID) error {
+	exist, err := orm.Imgs(
+		orm.ImgWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgWhere.ID.EQ(imgID.String()),
+		qm.WithDeleted(),
+	).ExistsG()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if exist {
+		return codes.ErrImgLockLost
+	}
+	return codes.ErrImgNotFound
+}
+
 func (repo *ImgPSQLRepository) ListByKeyset(query *domain.ListByKeysetQuery) (*domain.ListByKeysetResult, error) {
+	baseMods := imgListFilterMods(query)
+
+	switch query.Sort {
+	case domain.ImgSortSizeDesc, domain.ImgSortSizeAsc:
+		order := dbkit.SortDesc
+		if query.Sort == domain.ImgSortSizeAsc {
+			order = dbkit.SortAsc
+		}
+		return listImgsByKeyset(baseMods, query, orm.ImgColumns.Size, order,
+			func(img *domain.Img) imgSizeCursor { return imgSizeCursor{img} },
+			func(c imgSizeCursor) *domain.Img { return c.Img },
+		)
+	default:
+		// Keyset: 主排序为 created_at, tie-breaker 为 id
+		order := dbkit.SortDesc
+		if query.Sort == domain.ImgSortCreatedAtAsc {
+			order = dbkit.SortAsc
+		}
+		return listImgsByKeyset(baseMods, query, orm.ImgColumns.CreatedAt, order,
+			func(img *domain.Img) *domain.Img { return img },
+			func(img *domain.Img) *domain.Img { return img },
+		)
+	}
+}
+
+// imgSizeCursor 按文件大小排序时以 size 作为游标主列
+type imgSizeCursor struct {
+	*domain.Img
+}
+
+func (c imgSizeCursor) GetCursorPrimary() int64 {
+	return c.Size
+}
+
+func imgListFilterMods(query *domain.ListByKeysetQuery) []qm.QueryMod {
 	var baseMods []qm.QueryMod
 
 	baseMods = append(
@@ -138,25 +257,73 @@ func (repo *ImgPSQLRepository) ListByKeyset(query *domain.ListByKeysetQuery) (*d
 		baseMods = append(baseMods, qm.Where("description ILIKE ?", like))
 	}
 
-	if query.CategoryID != "" {
+	if query.CategoryID != "" && query.IncludeSubcategories {
+		// 子孙分类的物化路径以当前分类的子树路径开头
+		baseMods = append(baseMods, qm.Where(fmt.Sprintf(
+			"%[1]s IN (SELECT c.%[2]s FROM %[3]s c WHERE c.%[2]s = ? OR c.%[4]s LIKE '%%/' || ? || '/%%')",
+			orm.ImgColumns.CategoryID,
+			orm.ImgCategoryColumns.ID,
+			orm.TableNames.ImgCategories,
+			orm.ImgCategoryColumns.TreePath,
+		), query.CategoryID, query.CategoryID))
+	} else if query.CategoryID != "" {
 		baseMods = append(
 			baseMods,
 			qm.Where(fmt.Sprintf("%s = ?", orm.ImgColumns.CategoryID), query.CategoryID))
 	}
 
+	// 须同时带有全部标签
+	for _, tagID := range query.TagIDs {
+		baseMods = append(baseMods, qm.Where(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s a WHERE a.%s = %s.%s AND a.%s = ?)",
+			orm.TableNames.ImgTagAssignments,
+			orm.ImgTagAssignmentColumns.ImgID,
+			orm.TableNames.Imgs,
+			orm.ImgColumns.ID,
+			orm.ImgTagAssignmentColumns.TagID,
+		), tagID.String()))
+	}
+
+	if query.MimeType != "" {
+		baseMods = append(baseMods, orm.ImgWhere.MimeType.EQ(null.StringFrom(query.MimeType)))
+	}
+	if query.MinSize > 0 {
+		baseMods = append(baseMods, orm.ImgWhere.Size.GTE(query.MinSize))
+	}
+	if query.MaxSize > 0 {
+		baseMods = append(baseMods, orm.ImgWhere.Size.LTE(query.MaxSize))
+	}
+	if !query.CreatedFrom.IsZero() {
+		baseMods = append(baseMods, orm.ImgWhere.CreatedAt.GTE(query.CreatedFrom))
+	}
+	if !query.CreatedTo.IsZero() {
+		baseMods = append(baseMods, orm.ImgWhere.CreatedAt.LTE(query.CreatedTo))
+	}
+
 	if query.Deleted {
 		baseMods = append(baseMods, qm.WithDeleted())
 		baseMods = append(baseMods, qm.Where("deleted_at is not null"))
 	}
 
-	// Keyset: 主排序为 created_at, tie-breaker 为 id
+	return baseMods
+}
+
+// listImgsByKeyset 按指定主列做 keyset 分页 wrap/unwrap 用于切换游标主列类型
+func listImgsByKeyset[T dbkit.CursorFields[P], P any](
+	baseMods []qm.QueryMod,
+	query *domain.ListByKeysetQuery,
+	primaryCol string,
+	order dbkit.SortDirection,
+	wrap func(*domain.Img) T,
+	unwrap func(T) *domain.Img,
+) (*domain.ListByKeysetResult, error) {
 	ks := dbkit.NewKeyset(
 		orm.ImgColumns.ID,
-		orm.ImgColumns.CreatedAt,
+		primaryCol,
 		query.PrevCursor,
 		query.NextCursor,
 		query.PageSize,
-		dbkit.WithPrimaryOrder[*domain.Img](dbkit.SortDesc), // 保持与原来的 CreatedAt DESC 一致
+		dbkit.WithPrimaryOrder[T](order),
 	)
 
 	// 使用 keyset 生成包含 ORDER BY / LIMIT 的 query mods
@@ -167,10 +334,13 @@ func (repo *ImgPSQLRepository) ListByKeyset(query *domain.ListByKeysetQuery) (*d
 		return nil, err
 	}
 
-	domains := ormImgsToDomain(ormImgs)
+	items := make([]T, 0, len(ormImgs))
+	for _, img := range ormImgsToDomain(ormImgs) {
+		items = append(items, wrap(img))
+	}
 
 	// 精确判断 hasPrev/hasNext：exists 必须和 baseMods 保持一致
-	exists := func(primary time.Time, id string, checkPrev bool) (bool, error) {
+	exists := func(primary P, id string, checkPrev bool) (bool, error) {
 		var cond qm.QueryMod
 		if checkPrev {
 			cond = ks.BeforeWhere(primary, id)
@@ -183,18 +353,67 @@ func (repo *ImgPSQLRepository) ListByKeyset(query *domain.ListByKeysetQuery) (*d
 	}
 
 	// 精确构建分页结果（包含 HasPrev/HasNext, 游标）
-	pager, err := ks.BuildWithExistence(domains, exists)
+	pager, err := ks.BuildWithExistence(items, exists)
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.ListByKeysetResult{
-		Items:      pager.Items,
+	result := &domain.ListByKeysetResult{
+		Items:      make([]*domain.Img, 0, len(pager.Items)),
 		PrevCursor: pager.PrevCursor,
 		NextCursor: pager.NextCursor,
 		HasPrev:    pager.HasPrev,
 		HasNext:    pager.HasNext,
-	}, nil
+	}
+	for _, item := range pager.Items {
+		result.Items = append(result.Items, unwrap(item))
+	}
+
+	return result, nil
+}
+
+func (repo *ImgPSQLRepository) CreateVariants(variants []*domain.ImgVariant) error {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	for _, variant := range variants {
+		ormVariant := domainVariantToORM(variant)
+		if err := ormVariant.Insert(tx, boil.Infer()); err != nil {
+			return errors.WithStack(err)
+		}
+		variant.ID = ormVariant.ID
+		variant.CreatedAt = ormVariant.CreatedAt
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+func (repo *ImgPSQLRepository) ListVariants(imgIDs ...domain.ImgID) ([]*domain.ImgVariant, error) {
+	if len(imgIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(imgIDs))
+	for _, id := range imgIDs {
+		ids = append(ids, id.String())
+	}
+
+	ormVariants, err := orm.ImgVariants(
+		orm.ImgVariantWhere.ImgID.IN(ids),
+		qm.OrderBy(orm.ImgVariantColumns.Width+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormVariantsToDomain(ormVariants), nil
 }
 
 func (repo *ImgPSQLRepository) CreateCategory(category *domain.Category) error {
@@ -202,9 +421,13 @@ func (repo *ImgPSQLRepository) CreateCategory(category *domain.Category) error {
 	return ormCategory.InsertG(boil.Infer())
 }
 
+// UpdateCategory 仅更新名称与前缀 层级关系由 MoveCategory 维护
 func (repo *ImgPSQLRepository) UpdateCategory(category *domain.Category) error {
 	ormCategory := domainCategoryToORM(category)
-	rows, err := ormCategory.UpdateG(boil.Infer())
+	rows, err := ormCategory.UpdateG(boil.Whitelist(
+		orm.ImgCategoryColumns.Title,
+		orm.ImgCategoryColumns.Prefix,
+	))
 	if err != nil {
 		return err
 	}
@@ -235,6 +458,7 @@ func (repo *ImgPSQLRepository) DeleteCategory(tenantID domain.TenantID, category
 func (repo *ImgPSQLRepository) AllCategories(tenantID domain.TenantID) ([]*domain.Category, error) {
 	ormCategories, err := orm.ImgCategories(
 		orm.ImgCategoryWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgCategoryColumns.Depth+" ASC, "+orm.ImgCategoryColumns.CreatedAt+" ASC"),
 	).AllG()
 	if err != nil {
 		return nil, err
@@ -259,9 +483,11 @@ func (repo *ImgPSQLRepository) FindCategoryByID(tenantID domain.TenantID, catego
 	return ormCategoryToDomain(ormCategory), nil
 }
 
-func (repo *ImgPSQLRepository) FindCategoryByTitle(tenantID domain.TenantID, title string) (*domain.Category, error) {
+// FindCategoryByTitle 同一父分类下名称唯一 parentID 为空表示顶级分类
+func (repo *ImgPSQLRepository) FindCategoryByTitle(tenantID domain.TenantID, parentID domain.CategoryID, title string) (*domain.Category, error) {
 	ormCategory, err := orm.ImgCategories(
 		orm.ImgCategoryWhere.TenantID.EQ(tenantID.String()),
+		categoryParentWhere(parentID),
 		orm.ImgCategoryWhere.Title.EQ(title),
 	).OneG()
 	if err != nil {
@@ -286,9 +512,10 @@ func (repo *ImgPSQLRepository) CategoryExistByID(tenantID domain.TenantID, categ
 	return exist, nil
 }
 
-func (repo *ImgPSQLRepository) CategoryExistByTitle(tenantID domain.TenantID, title string) (bool, error) {
+func (repo *ImgPSQLRepository) CategoryExistByTitle(tenantID domain.TenantID, parentID domain.CategoryID, title string) (bool, error) {
 	exist, err := orm.ImgCategories(
 		orm.ImgCategoryWhere.TenantID.EQ(tenantID.String()),
+		categoryParentWhere(parentID),
 		orm.ImgCategoryWhere.Title.EQ(title),
 	).ExistsG()
 	if err != nil {
@@ -297,6 +524,86 @@ func (repo *ImgPSQLRepository) CategoryExistByTitle(tenantID domain.TenantID, ti
 	return exist, nil
 }
 
+func categoryParentWhere(parentID domain.CategoryID) qm.QueryMod {
+	if parentID == "" {
+		return orm.ImgCategoryWhere.ParentID.IsNull()
+	}
+	return orm.ImgCategoryWhere.ParentID.EQ(null.StringFrom(parentID.String()))
+}
+
+func (repo *ImgPSQLRepository) CategoryExistChildren(tenantID domain.TenantID, categoryID domain.CategoryID) (bool, error) {
+	exist, err := orm.ImgCategories(
+		orm.ImgCategoryWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgCategoryWhere.ParentID.EQ(null.StringFrom(categoryID.String())),
+	).ExistsG()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return exist, nil
+}
+
+// MaxSubtreeDepth 分类及其全部子孙分类中最大的层级
+func (repo *ImgPSQLRepository) MaxSubtreeDepth(category *domain.Category) (int, error) {
+	var row struct {
+		Depth int `boil:"depth"`
+	}
+	err := queries.Raw(fmt.Sprintf(
+		`SELECT COALESCE(MAX(%[1]s), $1) AS depth FROM %[2]s WHERE %[3]s = $2 AND %[4]s LIKE $3`,
+		orm.ImgCategoryColumns.Depth,
+		orm.TableNames.ImgCategories,
+		orm.ImgCategoryColumns.TenantID,
+		orm.ImgCategoryColumns.TreePath,
+	), category.Depth, category.TenantID.String(), category.SubtreePath()+"%").BindG(context.Background(), &row)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return max(row.Depth, category.Depth), nil
+}
+
+// MoveCategory 将分类连同子树移动到新的父分类下 category 须已设置新的 ParentID TreePath Depth
+// oldSubtreePath 为移动前的子孙路径前缀 子孙分类的路径与层级随之调整
+func (repo *ImgPSQLRepository) MoveCategory(category *domain.Category, oldSubtreePath string, depthDelta int) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	ormCategory := domainCategoryToORM(category)
+	rows, err := ormCategory.Update(tx, boil.Whitelist(
+		orm.ImgCategoryColumns.ParentID,
+		orm.ImgCategoryColumns.TreePath,
+		orm.ImgCategoryColumns.Depth,
+	))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgCategoryNotFound
+	}
+
+	_, err = queries.Raw(fmt.Sprintf(
+		`UPDATE %[1]s SET %[2]s = $1 || substr(%[2]s, $2), %[3]s = %[3]s + $3
+		WHERE %[4]s = $4 AND %[2]s LIKE $5`,
+		orm.TableNames.ImgCategories,
+		orm.ImgCategoryColumns.TreePath,
+		orm.ImgCategoryColumns.Depth,
+		orm.ImgCategoryColumns.TenantID,
+	),
+		category.SubtreePath(),
+		len(oldSubtreePath)+1,
+		depthDelta,
+		category.TenantID.String(),
+		oldSubtreePath+"%",
+	).ExecContext(context.Background(), tx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
 func (repo *ImgPSQLRepository) CountCategory(tenantID domain.TenantID) (int64, error) {
 	count, err := orm.ImgCategories(
 		orm.ImgCategoryWhere.TenantID.EQ(tenantID.String()),
@@ -320,9 +627,9 @@ func (repo *ImgPSQLRepository) IsCategoryExistImg(tenantID domain.TenantID, cate
 	return exist, nil
 }
 
-func (repo *ImgPSQLRepository) ExistTenantR2Config(tenantID domain.TenantID) (bool, error) {
-	exist, err := orm.TenantR2Configs(
-		orm.TenantR2ConfigWhere.TenantID.EQ(tenantID.String()),
+func (repo *ImgPSQLRepository) ExistTenantStorageConfig(tenantID domain.TenantID) (bool, error) {
+	exist, err := orm.TenantStorageConfigs(
+		orm.TenantStorageConfigWhere.TenantID.EQ(tenantID.String()),
 	).ExistsG()
 	if err != nil {
 		return false, errors.WithStack(err)
@@ -331,44 +638,44 @@ func (repo *ImgPSQLRepository) ExistTenantR2Config(tenantID domain.TenantID) (bo
 	return exist, nil
 }
 
-func (repo *ImgPSQLRepository) GetTenantR2Config(tenantID domain.TenantID) (*domain.R2Config, error) {
-	config, err := orm.TenantR2Configs(
-		orm.TenantR2ConfigWhere.TenantID.EQ(tenantID.String()),
+func (repo *ImgPSQLRepository) GetTenantStorageConfig(tenantID domain.TenantID) (*domain.StorageConfig, error) {
+	config, err := orm.TenantStorageConfigs(
+		orm.TenantStorageConfigWhere.TenantID.EQ(tenantID.String()),
 	).OneG()
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, codes.ErrImgR2ConfigNotFound
+			return nil, codes.ErrImgStorageConfigNotFound
 		}
 		return nil, err
 	}
 
-	return ormR2ConfigToDomian(config), nil
+	return ormStorageConfigToDomain(config), nil
 }
 
-func (repo *ImgPSQLRepository) SetTenantR2Config(config *domain.R2Config) error {
-	ormR2Config := doaminR2ConfigToORM(config)
+func (repo *ImgPSQLRepository) SetTenantStorageConfig(config *domain.StorageConfig) error {
+	ormConfig := domainStorageConfigToORM(config)
 
-	err := ormR2Config.UpsertG(
+	err := ormConfig.UpsertG(
 		true,
-		[]string{orm.TenantR2ConfigColumns.TenantID},
+		[]string{orm.TenantStorageConfigColumns.TenantID},
 		boil.Blacklist(
-			orm.TenantR2ConfigColumns.SecretAccessKey,
+			orm.TenantStorageConfigColumns.SecretAccessKey,
 		),
 		boil.Blacklist(
-			orm.TenantR2ConfigColumns.SecretAccessKey,
+			orm.TenantStorageConfigColumns.SecretAccessKey,
 		),
 	)
 
 	return err
 }
 
-func (repo *ImgPSQLRepository) SetR2SecretKey(tenantID domain.TenantID, secretKey domain.R2SecretAccessKey) error {
-	_, err := orm.TenantR2Configs(
-		orm.TenantR2ConfigWhere.TenantID.EQ(string(tenantID)),
+func (repo *ImgPSQLRepository) SetStorageSecretKey(tenantID domain.TenantID, secretKey domain.StorageSecretAccessKey) error {
+	_, err := orm.TenantStorageConfigs(
+		orm.TenantStorageConfigWhere.TenantID.EQ(string(tenantID)),
 	).UpdateAllG(
 		orm.M{
-			orm.TenantR2ConfigColumns.SecretAccessKey: string(secretKey),
+			orm.TenantStorageConfigColumns.SecretAccessKey: string(secretKey),
 		},
 	)
 
@@ -379,10 +686,10 @@ func (repo *ImgPSQLRepository) SetR2SecretKey(tenantID domain.TenantID, secretKe
 	return nil
 }
 
-func (repo *ImgPSQLRepository) IsSetR2SecretKey(tenantID domain.TenantID) (bool, error) {
-	exist, err := orm.TenantR2Configs(
-		orm.TenantR2ConfigWhere.TenantID.EQ(tenantID.String()),
-		orm.TenantR2ConfigWhere.SecretAccessKey.IsNotNull(),
+func (repo *ImgPSQLRepository) IsSetStorageSecretKey(tenantID domain.TenantID) (bool, error) {
+	exist, err := orm.TenantStorageConfigs(
+		orm.TenantStorageConfigWhere.TenantID.EQ(tenantID.String()),
+		orm.TenantStorageConfigWhere.SecretAccessKey.IsNotNull(),
 	).ExistsG()
 	if err != nil {
 		return false, errors.WithStack(err)
@@ -390,3 +697,1478 @@ func (repo *ImgPSQLRepository) IsSetR2SecretKey(tenantID domain.TenantID) (bool,
 
 	return exist, nil
 }
+
+func (repo *ImgPSQLRepository) CreateStorageMigration(migration *domain.StorageMigration) error {
+	ormMigration := domainStorageMigrationToORM(migration)
+	if err := ormMigration.InsertG(boil.Infer()); err != nil {
+		return errors.WithStack(err)
+	}
+	*migration = *ormStorageMigrationToDomain(ormMigration)
+	return nil
+}
+
+func (repo *ImgPSQLRepository) FindStorageMigration(tenantID domain.TenantID, migrationID domain.StorageMigrationID) (*domain.StorageMigration, error) {
+	ormMigration, err := orm.ImgStorageMigrations(
+		orm.ImgStorageMigrationWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgStorageMigrationWhere.ID.EQ(migrationID.String()),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgMigrationNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormStorageMigrationToDomain(ormMigration), nil
+}
+
+// ListStorageMigrations 最近的存储迁移任务
+func (repo *ImgPSQLRepository) ListStorageMigrations(tenantID domain.TenantID, limit int) ([]*domain.StorageMigration, error) {
+	ormMigrations, err := orm.ImgStorageMigrations(
+		orm.ImgStorageMigrationWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgStorageMigrationColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormStorageMigrationsToDomain(ormMigrations), nil
+}
+
+func (repo *ImgPSQLRepository) ExistActiveStorageMigration(tenantID domain.TenantID) (bool, error) {
+	exist, err := orm.ImgStorageMigrations(
+		orm.ImgStorageMigrationWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgStorageMigrationWhere.Status.IN([]orm.ImgJobStatus{orm.ImgJobStatusPending, orm.ImgJobStatusRunning}),
+	).ExistsG()
+
+	return exist, errors.WithStack(err)
+}
+
+// ClaimStorageMigrations 认领待执行或心跳超时的任务 同一任务只会被一个实例认领
+func (repo *ImgPSQLRepository) ClaimStorageMigrations(staleBefore time.Time, limit int) ([]*domain.StorageMigration, error) {
+	sql := fmt.Sprintf(
+		`UPDATE %[1]s SET %[2]s = $1, %[3]s = now()
+		WHERE %[4]s IN (
+			SELECT %[4]s FROM %[1]s
+			WHERE %[2]s = $2 OR (%[2]s = $1 AND %[3]s < $3)
+			ORDER BY %[5]s ASC
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		orm.TableNames.ImgStorageMigrations,
+		orm.ImgStorageMigrationColumns.Status,
+		orm.ImgStorageMigrationColumns.UpdatedAt,
+		orm.ImgStorageMigrationColumns.ID,
+		orm.ImgStorageMigrationColumns.CreatedAt,
+	)
+
+	var ormMigrations orm.ImgStorageMigrationSlice
+	if err := queries.Raw(sql,
+		orm.ImgJobStatusRunning,
+		orm.ImgJobStatusPending,
+		staleBefore,
+		limit,
+	).BindG(context.Background(), &ormMigrations); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormStorageMigrationsToDomain(ormMigrations), nil
+}
+
+// UpdateStorageMigration 更新任务进度与状态 同时刷新心跳
+func (repo *ImgPSQLRepository) UpdateStorageMigration(migration *domain.StorageMigration) error {
+	ormMigration := domainStorageMigrationToORM(migration)
+
+	rows, err := orm.ImgStorageMigrations(
+		orm.ImgStorageMigrationWhere.TenantID.EQ(migration.TenantID.String()),
+		orm.ImgStorageMigrationWhere.ID.EQ(migration.ID.String()),
+	).UpdateAllG(orm.M{
+		orm.ImgStorageMigrationColumns.Status:     ormMigration.Status,
+		orm.ImgStorageMigrationColumns.Total:      ormMigration.Total,
+		orm.ImgStorageMigrationColumns.Copied:     ormMigration.Copied,
+		orm.ImgStorageMigrationColumns.Verified:   ormMigration.Verified,
+		orm.ImgStorageMigrationColumns.LastError:  ormMigration.LastError,
+		orm.ImgStorageMigrationColumns.FinishedAt: ormMigration.FinishedAt,
+		orm.ImgStorageMigrationColumns.UpdatedAt:  time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgMigrationNotFound
+	}
+	return nil
+}
+
+// SwitchStorageConfig 锁定任务后切换配置 任务已不在运行中(如被取消或已切换)时不做修改
+func (repo *ImgPSQLRepository) SwitchStorageConfig(migration *domain.StorageMigration) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	ormMigration, err := orm.ImgStorageMigrations(
+		orm.ImgStorageMigrationWhere.ID.EQ(migration.ID.String()),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return codes.ErrImgMigrationNotFound
+		}
+		return errors.WithStack(err)
+	}
+	if ormMigration.Status != orm.ImgJobStatusRunning || ormMigration.SwitchedAt.Valid {
+		return codes.ErrImgIllegalOperation
+	}
+
+	// 目标配置连同密钥密文整体覆盖
+	ormConfig := domainStorageConfigToORM(migration.Target)
+	ormConfig.TenantID = migration.TenantID.String()
+	ormConfig.UpdatedAt = time.Now()
+	rows, err := ormConfig.Update(tx, boil.Blacklist(
+		orm.TenantStorageConfigColumns.TenantID,
+		orm.TenantStorageConfigColumns.CreatedAt,
+	))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgStorageConfigNotFound
+	}
+
+	switchedAt := time.Now()
+	ormMigration.SwitchedAt = null.TimeFrom(switchedAt)
+	ormMigration.UpdatedAt = switchedAt
+	if _, err := ormMigration.Update(tx, boil.Whitelist(
+		orm.ImgStorageMigrationColumns.SwitchedAt,
+		orm.ImgStorageMigrationColumns.UpdatedAt,
+	)); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.WithStack(err)
+	}
+	migration.SwitchedAt = switchedAt
+
+	return nil
+}
+
+func (repo *ImgPSQLRepository) GetTenantImgSetting(tenantID domain.TenantID) (*domain.ImgSetting, error) {
+	setting, err := orm.TenantImgSettings(
+		orm.TenantImgSettingWhere.TenantID.EQ(tenantID.String()),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgSettingNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImgSettingToDomain(setting), nil
+}
+
+func (repo *ImgPSQLRepository) SetTenantImgSetting(setting *domain.ImgSetting) error {
+	ormSetting := domainImgSettingToORM(setting)
+
+	err := ormSetting.UpsertG(
+		true,
+		[]string{orm.TenantImgSettingColumns.TenantID},
+		boil.Whitelist(
+			orm.TenantImgSettingColumns.OutputFormat,
+			orm.TenantImgSettingColumns.Quality,
+			orm.TenantImgSettingColumns.VariantWidths,
+			orm.TenantImgSettingColumns.DedupMode,
+			orm.TenantImgSettingColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	)
+
+	return errors.WithStack(err)
+}
+
+func (repo *ImgPSQLRepository) GetWatermarkSetting(tenantID domain.TenantID) (*domain.WatermarkSetting, error) {
+	setting, err := orm.ImgWatermarkSettings(
+		orm.ImgWatermarkSettingWhere.TenantID.EQ(tenantID.String()),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgWatermarkNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormWatermarkSettingToDomain(setting), nil
+}
+
+func (repo *ImgPSQLRepository) SetWatermarkSetting(setting *domain.WatermarkSetting) error {
+	ormSetting := domainWatermarkSettingToORM(setting)
+
+	err := ormSetting.UpsertG(
+		true,
+		[]string{orm.ImgWatermarkSettingColumns.TenantID},
+		boil.Whitelist(
+			orm.ImgWatermarkSettingColumns.Enabled,
+			orm.ImgWatermarkSettingColumns.Kind,
+			orm.ImgWatermarkSettingColumns.Text,
+			orm.ImgWatermarkSettingColumns.TextColor,
+			orm.ImgWatermarkSettingColumns.ImageKey,
+			orm.ImgWatermarkSettingColumns.Position,
+			orm.ImgWatermarkSettingColumns.Opacity,
+			orm.ImgWatermarkSettingColumns.Scale,
+			orm.ImgWatermarkSettingColumns.CategoryIds,
+			orm.ImgWatermarkSettingColumns.KeepOriginal,
+			orm.ImgWatermarkSettingColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	)
+
+	return errors.WithStack(err)
+}
+
+func (repo *ImgPSQLRepository) CreateTag(tag *domain.Tag) error {
+	ormTag := domainTagToORM(tag)
+	if err := ormTag.InsertG(boil.Infer()); err != nil {
+		return err
+	}
+	tag.ID = domain.TagID(ormTag.ID)
+	tag.CreatedAt = ormTag.CreatedAt
+	return nil
+}
+
+func (repo *ImgPSQLRepository) DeleteTag(tenantID domain.TenantID, tagID domain.TagID) error {
+	rows, err := orm.ImgTags(
+		orm.ImgTagWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgTagWhere.ID.EQ(tagID.String()),
+	).DeleteAllG()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return codes.ErrImgTagNotFound
+	}
+	return nil
+}
+
+func (repo *ImgPSQLRepository) AllTags(tenantID domain.TenantID) ([]*domain.Tag, error) {
+	ormTags, err := orm.ImgTags(
+		orm.ImgTagWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgTagColumns.Name+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, err
+	}
+
+	return ormTagsToDomain(ormTags), nil
+}
+
+func (repo *ImgPSQLRepository) TagExistByName(tenantID domain.TenantID, name string) (bool, error) {
+	return orm.ImgTags(
+		orm.ImgTagWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgTagWhere.Name.EQ(name),
+	).ExistsG()
+}
+
+// CountTags 不传 tagIDs 时统计租户全部标签 否则统计其中属于该租户的标签数
+func (repo *ImgPSQLRepository) CountTags(tenantID domain.TenantID, tagIDs ...domain.TagID) (int64, error) {
+	mods := []qm.QueryMod{
+		orm.ImgTagWhere.TenantID.EQ(tenantID.String()),
+	}
+	if len(tagIDs) > 0 {
+		ids := make([]string, 0, len(tagIDs))
+		for _, id := range tagIDs {
+			ids = append(ids, id.String())
+		}
+		mods = append(mods, orm.ImgTagWhere.ID.IN(ids))
+	}
+
+	return orm.ImgTags(mods...).CountG()
+}
+
+// SetImgTags 覆盖图片的全部标签 调用方需保证标签属于同一租户
+func (repo *ImgPSQLRepository) SetImgTags(imgID domain.ImgID, tagIDs []domain.TagID) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := orm.ImgTagAssignments(
+		orm.ImgTagAssignmentWhere.ImgID.EQ(imgID.String()),
+	).DeleteAll(tx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, tagID := range tagIDs {
+		assignment := &orm.ImgTagAssignment{
+			ImgID: imgID.String(),
+			TagID: tagID.String(),
+		}
+		if err := assignment.Insert(tx, boil.Infer()); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+func (repo *ImgPSQLRepository) ListImgTags(imgIDs ...domain.ImgID) (map[domain.ImgID][]*domain.Tag, error) {
+	if len(imgIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(imgIDs))
+	for _, id := range imgIDs {
+		ids = append(ids, id.String())
+	}
+
+	assignments, err := orm.ImgTagAssignments(
+		orm.ImgTagAssignmentWhere.ImgID.IN(ids),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(assignments) == 0 {
+		return nil, nil
+	}
+
+	tagIDs := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		tagIDs = append(tagIDs, assignment.TagID)
+	}
+
+	ormTags, err := orm.ImgTags(
+		orm.ImgTagWhere.ID.IN(tagIDs),
+		qm.OrderBy(orm.ImgTagColumns.Name+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tags := make(map[string]*domain.Tag, len(ormTags))
+	for _, ormTag := range ormTags {
+		tags[ormTag.ID] = ormTagToDomain(ormTag)
+	}
+
+	grouped := make(map[domain.ImgID][]*domain.Tag, len(imgIDs))
+	for _, assignment := range assignments {
+		if tag, ok := tags[assignment.TagID]; ok {
+			grouped[domain.ImgID(assignment.ImgID)] = append(grouped[domain.ImgID(assignment.ImgID)], tag)
+		}
+	}
+
+	return grouped, nil
+}
+
+// AddStorageUsage 按变化量累加用量 不存在时创建 结果不小于0
+func (repo *ImgPSQLRepository) AddStorageUsage(delta *domain.StorageUsage) error {
+	ormUsage := domainStorageUsageToORM(delta)
+
+	sql := fmt.Sprintf(
+		`INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s)
+		VALUES ($1, $2, $3::img_bucket_kind, GREATEST($4::bigint, 0), GREATEST($5::bigint, 0))
+		ON CONFLICT (%[2]s, %[3]s, %[4]s) DO UPDATE SET
+			%[5]s = GREATEST(%[1]s.%[5]s + $4::bigint, 0),
+			%[6]s = GREATEST(%[1]s.%[6]s + $5::bigint, 0),
+			%[7]s = now()`,
+		orm.TableNames.ImgStorageUsages,
+		orm.ImgStorageUsageColumns.TenantID,
+		orm.ImgStorageUsageColumns.CategoryID,
+		orm.ImgStorageUsageColumns.Bucket,
+		orm.ImgStorageUsageColumns.Bytes,
+		orm.ImgStorageUsageColumns.Objects,
+		orm.ImgStorageUsageColumns.UpdatedAt,
+	)
+
+	_, err := queries.Raw(sql,
+		ormUsage.TenantID,
+		ormUsage.CategoryID,
+		ormUsage.Bucket,
+		ormUsage.Bytes,
+		ormUsage.Objects,
+	).ExecContext(context.Background(), boil.GetContextDB())
+
+	return errors.WithStack(err)
+}
+
+// ApplyReconciledStorageUsages 以校准结果更新租户的用量记录
+// 逐行按 校准值 + (当前值 - 快照值) 写入 校准期间 AddStorageUsage 累加的增量不会丢失
+func (repo *ImgPSQLRepository) ApplyReconciledStorageUsages(tenantID domain.TenantID, reconciled []*domain.StorageUsage, snapshot []*domain.StorageUsage) error {
+	snapshotByKey := make(map[domain.StorageUsageKey]*domain.StorageUsage, len(snapshot))
+	for _, usage := range snapshot {
+		snapshotByKey[usage.Key()] = usage
+	}
+
+	// 快照中存在但校准时已无对象的记录 校准值为0
+	now := time.Now()
+	targets := make([]*domain.StorageUsage, 0, len(reconciled)+len(snapshot))
+	seen := make(map[domain.StorageUsageKey]struct{}, len(reconciled))
+	for _, usage := range reconciled {
+		seen[usage.Key()] = struct{}{}
+		targets = append(targets, usage)
+	}
+	for _, usage := range snapshot {
+		if _, ok := seen[usage.Key()]; !ok {
+			targets = append(targets, &domain.StorageUsage{
+				TenantID:   tenantID,
+				CategoryID: usage.CategoryID,
+				Bucket:     usage.Bucket,
+			})
+		}
+	}
+
+	sql := fmt.Sprintf(
+		`INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s, %[6]s, %[7]s)
+		VALUES ($1, $2, $3::img_bucket_kind, GREATEST($4::bigint, 0), GREATEST($5::bigint, 0), $8)
+		ON CONFLICT (%[2]s, %[3]s, %[4]s) DO UPDATE SET
+			%[5]s = GREATEST($4::bigint + %[1]s.%[5]s - $6::bigint, 0),
+			%[6]s = GREATEST($5::bigint + %[1]s.%[6]s - $7::bigint, 0),
+			%[7]s = $8,
+			%[8]s = now()`,
+		orm.TableNames.ImgStorageUsages,
+		orm.ImgStorageUsageColumns.TenantID,
+		orm.ImgStorageUsageColumns.CategoryID,
+		orm.ImgStorageUsageColumns.Bucket,
+		orm.ImgStorageUsageColumns.Bytes,
+		orm.ImgStorageUsageColumns.Objects,
+		orm.ImgStorageUsageColumns.ReconciledAt,
+		orm.ImgStorageUsageColumns.UpdatedAt,
+	)
+
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	for _, usage := range targets {
+		ormUsage := domainStorageUsageToORM(usage)
+
+		var snapBytes, snapObjects int64
+		if snap, ok := snapshotByKey[usage.Key()]; ok {
+			snapBytes, snapObjects = snap.Bytes, snap.Objects
+		}
+
+		if _, err := queries.Raw(sql,
+			tenantID.String(),
+			ormUsage.CategoryID,
+			ormUsage.Bucket,
+			ormUsage.Bytes,
+			ormUsage.Objects,
+			snapBytes,
+			snapObjects,
+			now,
+		).ExecContext(context.Background(), tx); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	// 清理已无对象的记录
+	if _, err := orm.ImgStorageUsages(
+		orm.ImgStorageUsageWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgStorageUsageWhere.Bytes.EQ(0),
+		orm.ImgStorageUsageWhere.Objects.EQ(0),
+	).DeleteAll(tx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+func (repo *ImgPSQLRepository) ListStorageUsages(tenantID domain.TenantID) ([]*domain.StorageUsage, error) {
+	ormUsages, err := orm.ImgStorageUsages(
+		orm.ImgStorageUsageWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgStorageUsageColumns.Bucket+" ASC, "+orm.ImgStorageUsageColumns.Bytes+" DESC"),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormStorageUsagesToDomain(ormUsages), nil
+}
+
+// AllStorageConfiguredTenants 已配置对象存储的租户
+func (repo *ImgPSQLRepository) AllStorageConfiguredTenants() ([]domain.TenantID, error) {
+	configs, err := orm.TenantStorageConfigs(
+		qm.Select(orm.TenantStorageConfigColumns.TenantID),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tenantIDs := make([]domain.TenantID, 0, len(configs))
+	for _, config := range configs {
+		tenantIDs = append(tenantIDs, domain.TenantID(config.TenantID))
+	}
+
+	return tenantIDs, nil
+}
+
+// AllImgs 租户的全部图片 含已软删除的记录
+func (repo *ImgPSQLRepository) AllImgs(tenantID domain.TenantID) ([]*domain.Img, error) {
+	ormImgs, err := orm.Imgs(
+		orm.ImgWhere.TenantID.EQ(tenantID.String()),
+		qm.WithDeleted(),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImgsToDomain(ormImgs), nil
+}
+
+func (repo *ImgPSQLRepository) DeleteVariants(imgID domain.ImgID, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	_, err := orm.ImgVariants(
+		orm.ImgVariantWhere.ImgID.EQ(imgID.String()),
+		orm.ImgVariantWhere.Path.IN(paths),
+	).DeleteAllG()
+
+	return errors.WithStack(err)
+}
+
+// UpdateImgLocation 更新图片的分类、路径与存储对象路径 以及缩放版本的路径 含已软删除的图片
+func (repo *ImgPSQLRepository) UpdateImgLocation(img *domain.Img, token domain.FencingToken) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	ormImg := domainImgToORM(img)
+	if img.CategoryID != "" {
+		ormImg.CategoryID = null.StringFrom(img.CategoryID.String())
+	}
+
+	rows, err := orm.Imgs(
+		orm.ImgWhere.TenantID.EQ(img.TenantID.String()),
+		orm.ImgWhere.ID.EQ(img.ID.String()),
+		orm.ImgWhere.LockToken.LTE(int64(token)),
+		qm.WithDeleted(),
+	).UpdateAll(tx, orm.M{
+		orm.ImgColumns.CategoryID: ormImg.CategoryID,
+		orm.ImgColumns.Path:       ormImg.Path,
+		orm.ImgColumns.ObjectPath: ormImg.ObjectPath,
+		orm.ImgColumns.UpdatedAt:  time.Now(),
+		orm.ImgColumns.LockToken:  int64(token),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return repo.fencedWriteMissed(img.TenantID, img.ID)
+	}
+
+	for _, variant := range img.Variants {
+		if _, err := orm.ImgVariants(
+			orm.ImgVariantWhere.ID.EQ(variant.ID),
+		).UpdateAll(tx, orm.M{orm.ImgVariantColumns.Path: variant.Path}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+// ListImgsByCategory 分类下的全部图片 含已软删除的记录
+func (repo *ImgPSQLRepository) ListImgsByCategory(tenantID domain.TenantID, categoryID domain.CategoryID) ([]*domain.Img, error) {
+	ormImgs, err := orm.Imgs(
+		orm.ImgWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgWhere.CategoryID.EQ(null.StringFrom(categoryID.String())),
+		qm.WithDeleted(),
+		qm.OrderBy(orm.ImgColumns.CreatedAt+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImgsToDomain(ormImgs), nil
+}
+
+func (repo *ImgPSQLRepository) CreateCategoryJob(job *domain.CategoryJob) error {
+	ormJob := domainCategoryJobToORM(job)
+	if err := ormJob.InsertG(boil.Infer()); err != nil {
+		return errors.WithStack(err)
+	}
+	*job = *ormCategoryJobToDomain(ormJob)
+	return nil
+}
+
+func (repo *ImgPSQLRepository) FindCategoryJob(tenantID domain.TenantID, jobID domain.CategoryJobID) (*domain.CategoryJob, error) {
+	ormJob, err := orm.ImgCategoryJobs(
+		orm.ImgCategoryJobWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgCategoryJobWhere.ID.EQ(jobID.String()),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgCategoryJobNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormCategoryJobToDomain(ormJob), nil
+}
+
+// ListCategoryJobs 最近的分类迁移任务
+func (repo *ImgPSQLRepository) ListCategoryJobs(tenantID domain.TenantID, limit int) ([]*domain.CategoryJob, error) {
+	ormJobs, err := orm.ImgCategoryJobs(
+		orm.ImgCategoryJobWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgCategoryJobColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormCategoryJobsToDomain(ormJobs), nil
+}
+
+func (repo *ImgPSQLRepository) ExistActiveCategoryJob(tenantID domain.TenantID, categoryID domain.CategoryID) (bool, error) {
+	exist, err := orm.ImgCategoryJobs(
+		orm.ImgCategoryJobWhere.TenantID.EQ(tenantID.String()),
+		qm.Expr(
+			orm.ImgCategoryJobWhere.CategoryID.EQ(categoryID.String()),
+			qm.Or2(orm.ImgCategoryJobWhere.TargetCategoryID.EQ(null.StringFrom(categoryID.String()))),
+		),
+		orm.ImgCategoryJobWhere.Status.IN([]orm.ImgJobStatus{orm.ImgJobStatusPending, orm.ImgJobStatusRunning}),
+	).ExistsG()
+
+	return exist, errors.WithStack(err)
+}
+
+// ClaimCategoryJobs 认领待执行或心跳超时的任务 同一任务只会被一个实例认领
+func (repo *ImgPSQLRepository) ClaimCategoryJobs(staleBefore time.Time, limit int) ([]*domain.CategoryJob, error) {
+	sql := fmt.Sprintf(
+		`UPDATE %[1]s SET %[2]s = $1, %[3]s = now()
+		WHERE %[4]s IN (
+			SELECT %[4]s FROM %[1]s
+			WHERE %[2]s = $2 OR (%[2]s = $1 AND %[3]s < $3)
+			ORDER BY %[5]s ASC
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		orm.TableNames.ImgCategoryJobs,
+		orm.ImgCategoryJobColumns.Status,
+		orm.ImgCategoryJobColumns.UpdatedAt,
+		orm.ImgCategoryJobColumns.ID,
+		orm.ImgCategoryJobColumns.CreatedAt,
+	)
+
+	var ormJobs orm.ImgCategoryJobSlice
+	if err := queries.Raw(sql,
+		orm.ImgJobStatusRunning,
+		orm.ImgJobStatusPending,
+		staleBefore,
+		limit,
+	).BindG(context.Background(), &ormJobs); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormCategoryJobsToDomain(ormJobs), nil
+}
+
+// UpdateCategoryJob 更新任务进度与状态 同时刷新心跳
+func (repo *ImgPSQLRepository) UpdateCategoryJob(job *domain.CategoryJob) error {
+	ormJob := domainCategoryJobToORM(job)
+
+	rows, err := orm.ImgCategoryJobs(
+		orm.ImgCategoryJobWhere.TenantID.EQ(job.TenantID.String()),
+		orm.ImgCategoryJobWhere.ID.EQ(job.ID.String()),
+	).UpdateAllG(orm.M{
+		orm.ImgCategoryJobColumns.Status:     ormJob.Status,
+		orm.ImgCategoryJobColumns.Total:      ormJob.Total,
+		orm.ImgCategoryJobColumns.Processed:  ormJob.Processed,
+		orm.ImgCategoryJobColumns.Failed:     ormJob.Failed,
+		orm.ImgCategoryJobColumns.LastError:  ormJob.LastError,
+		orm.ImgCategoryJobColumns.FinishedAt: ormJob.FinishedAt,
+		orm.ImgCategoryJobColumns.UpdatedAt:  time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgCategoryJobNotFound
+	}
+	return nil
+}
+
+func (repo *ImgPSQLRepository) CreateAlbum(album *domain.Album) error {
+	ormAlbum := domainAlbumToORM(album)
+	if err := ormAlbum.InsertG(boil.Infer()); err != nil {
+		return errors.WithStack(err)
+	}
+	*album = *ormAlbumToDomain(ormAlbum)
+	return nil
+}
+
+func (repo *ImgPSQLRepository) UpdateAlbum(album *domain.Album) error {
+	ormAlbum := domainAlbumToORM(album)
+	rows, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.TenantID.EQ(album.TenantID.String()),
+		orm.ImgAlbumWhere.ID.EQ(album.ID.String()),
+	).UpdateAllG(orm.M{
+		orm.ImgAlbumColumns.Title:       ormAlbum.Title,
+		orm.ImgAlbumColumns.Description: ormAlbum.Description,
+		orm.ImgAlbumColumns.UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgAlbumNotFound
+	}
+	return nil
+}
+
+// DeleteAlbum 删除相册及其图片关联 图片本身不受影响
+func (repo *ImgPSQLRepository) DeleteAlbum(tenantID domain.TenantID, albumID domain.AlbumID) error {
+	rows, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgAlbumWhere.ID.EQ(albumID.String()),
+	).DeleteAllG()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgAlbumNotFound
+	}
+	return nil
+}
+
+func (repo *ImgPSQLRepository) FindAlbum(tenantID domain.TenantID, albumID domain.AlbumID) (*domain.Album, error) {
+	ormAlbum, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgAlbumWhere.ID.EQ(albumID.String()),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgAlbumNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormAlbumToDomain(ormAlbum), nil
+}
+
+func (repo *ImgPSQLRepository) FindAlbumByTitle(tenantID domain.TenantID, title string) (*domain.Album, error) {
+	ormAlbum, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgAlbumWhere.Title.EQ(title),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgAlbumNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormAlbumToDomain(ormAlbum), nil
+}
+
+func (repo *ImgPSQLRepository) AllAlbums(tenantID domain.TenantID) ([]*domain.Album, error) {
+	ormAlbums, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgAlbumColumns.CreatedAt+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormAlbumsToDomain(ormAlbums), nil
+}
+
+func (repo *ImgPSQLRepository) CountAlbums(tenantID domain.TenantID) (int64, error) {
+	count, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.TenantID.EQ(tenantID.String()),
+	).CountG()
+	return count, errors.WithStack(err)
+}
+
+func (repo *ImgPSQLRepository) CountAlbumImgs(albumID domain.AlbumID) (int64, error) {
+	count, err := orm.ImgAlbumItems(
+		orm.ImgAlbumItemWhere.AlbumID.EQ(albumID.String()),
+	).CountG()
+	return count, errors.WithStack(err)
+}
+
+// CountImgsByIDs 统计租户下未删除的图片数量 用于校验图片归属
+func (repo *ImgPSQLRepository) CountImgsByIDs(tenantID domain.TenantID, imgIDs ...domain.ImgID) (int64, error) {
+	ids := make([]string, 0, len(imgIDs))
+	for _, id := range imgIDs {
+		ids = append(ids, id.String())
+	}
+
+	count, err := orm.Imgs(
+		orm.ImgWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgWhere.ID.IN(ids),
+	).CountG()
+	return count, errors.WithStack(err)
+}
+
+// lockAlbum 锁定相册行 串行化同一相册的图片位置变更
+func lockAlbum(tx boil.Executor, albumID domain.AlbumID) error {
+	_, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.ID.EQ(albumID.String()),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return codes.ErrImgAlbumNotFound
+		}
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// AddAlbumImgs 按顺序将图片追加到相册末尾 已在相册中的图片保持原位置
+func (repo *ImgPSQLRepository) AddAlbumImgs(albumID domain.AlbumID, imgIDs []domain.ImgID) error {
+	ctx := context.Background()
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	if err := lockAlbum(tx, albumID); err != nil {
+		return err
+	}
+
+	var last struct {
+		Position int64 `boil:"position"`
+	}
+	if err := queries.Raw(fmt.Sprintf(
+		`SELECT COALESCE(MAX(%s), 0) AS position FROM %s WHERE %s = $1`,
+		orm.ImgAlbumItemColumns.Position,
+		orm.TableNames.ImgAlbumItems,
+		orm.ImgAlbumItemColumns.AlbumID,
+	), albumID.String()).Bind(ctx, tx, &last); err != nil {
+		return errors.WithStack(err)
+	}
+
+	insert := fmt.Sprintf(
+		`INSERT INTO %s (%s, %s, %s) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`,
+		orm.TableNames.ImgAlbumItems,
+		orm.ImgAlbumItemColumns.AlbumID,
+		orm.ImgAlbumItemColumns.ImgID,
+		orm.ImgAlbumItemColumns.Position,
+	)
+	position := last.Position
+	for _, imgID := range imgIDs {
+		res, err := queries.Raw(insert, albumID.String(), imgID.String(), position+domain.AlbumPositionGap).Exec(tx)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if rows, _ := res.RowsAffected(); rows > 0 {
+			position += domain.AlbumPositionGap
+		}
+	}
+
+	if err := touchAlbum(tx, albumID); err != nil {
+		return err
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+func (repo *ImgPSQLRepository) RemoveAlbumImgs(albumID domain.AlbumID, imgIDs []domain.ImgID) error {
+	ctx := context.Background()
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	ids := make([]string, 0, len(imgIDs))
+	for _, id := range imgIDs {
+		ids = append(ids, id.String())
+	}
+
+	if _, err := orm.ImgAlbumItems(
+		orm.ImgAlbumItemWhere.AlbumID.EQ(albumID.String()),
+		orm.ImgAlbumItemWhere.ImgID.IN(ids),
+	).DeleteAll(tx); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := touchAlbum(tx, albumID); err != nil {
+		return err
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+func touchAlbum(tx boil.Executor, albumID domain.AlbumID) error {
+	_, err := orm.ImgAlbums(
+		orm.ImgAlbumWhere.ID.EQ(albumID.String()),
+	).UpdateAll(tx, orm.M{orm.ImgAlbumColumns.UpdatedAt: time.Now()})
+	return errors.WithStack(err)
+}
+
+// MoveAlbumImg 将图片移动到 beforeImgID 之前 beforeImgID 为空表示移动到末尾
+// 新位置取相邻两张图片位置的中间值 间隔用尽时先按当前顺序重新分配全部位置
+func (repo *ImgPSQLRepository) MoveAlbumImg(albumID domain.AlbumID, imgID, beforeImgID domain.ImgID) error {
+	ctx := context.Background()
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	if err := lockAlbum(tx, albumID); err != nil {
+		return err
+	}
+
+	if _, err := findAlbumItem(tx, albumID, imgID); err != nil {
+		return err
+	}
+
+	position, ok, err := albumPositionBefore(tx, albumID, imgID, beforeImgID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err := renumberAlbumItems(tx, albumID); err != nil {
+			return err
+		}
+		if position, _, err = albumPositionBefore(tx, albumID, imgID, beforeImgID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := orm.ImgAlbumItems(
+		orm.ImgAlbumItemWhere.AlbumID.EQ(albumID.String()),
+		orm.ImgAlbumItemWhere.ImgID.EQ(imgID.String()),
+	).UpdateAll(tx, orm.M{orm.ImgAlbumItemColumns.Position: position}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := touchAlbum(tx, albumID); err != nil {
+		return err
+	}
+
+	return errors.WithStack(tx.Commit())
+}
+
+func findAlbumItem(tx boil.Executor, albumID domain.AlbumID, imgID domain.ImgID) (*orm.ImgAlbumItem, error) {
+	item, err := orm.ImgAlbumItems(
+		orm.ImgAlbumItemWhere.AlbumID.EQ(albumID.String()),
+		orm.ImgAlbumItemWhere.ImgID.EQ(imgID.String()),
+	).One(tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgNotInAlbum
+		}
+		return nil, errors.WithStack(err)
+	}
+	return item, nil
+}
+
+// albumPositionBefore 计算 beforeImgID 之前的空闲位置 相邻位置之间无空间时 ok 为 false
+func albumPositionBefore(tx boil.Executor, albumID domain.AlbumID, imgID, beforeImgID domain.ImgID) (int64, bool, error) {
+	others := []qm.QueryMod{
+		orm.ImgAlbumItemWhere.AlbumID.EQ(albumID.String()),
+		orm.ImgAlbumItemWhere.ImgID.NEQ(imgID.String()),
+	}
+
+	// 移动到末尾
+	if beforeImgID == "" {
+		last, err := orm.ImgAlbumItems(append(others,
+			qm.OrderBy(orm.ImgAlbumItemColumns.Position+" DESC"),
+		)...).One(tx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.AlbumPositionGap, true, nil
+		}
+		if err != nil {
+			return 0, false, errors.WithStack(err)
+		}
+		return last.Position + domain.AlbumPositionGap, true, nil
+	}
+
+	before, err := findAlbumItem(tx, albumID, beforeImgID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	prevPosition := before.Position - 2*domain.AlbumPositionGap
+	prev, err := orm.ImgAlbumItems(append(others,
+		orm.ImgAlbumItemWhere.Position.LT(before.Position),
+		qm.OrderBy(orm.ImgAlbumItemColumns.Position+" DESC"),
+	)...).One(tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, false, errors.WithStack(err)
+	}
+	if prev != nil {
+		prevPosition = prev.Position
+	}
+
+	if before.Position-prevPosition < 2 {
+		return 0, false, nil
+	}
+	return prevPosition + (before.Position-prevPosition)/2, true, nil
+}
+
+// renumberAlbumItems 按当前顺序重新分配相册内全部图片的位置
+func renumberAlbumItems(tx boil.Executor, albumID domain.AlbumID) error {
+	_, err := queries.Raw(fmt.Sprintf(
+		`UPDATE %[1]s t SET %[2]s = r.rn * $2
+		FROM (
+			SELECT %[3]s, row_number() OVER (ORDER BY %[2]s, %[3]s) AS rn
+			FROM %[1]s WHERE %[4]s = $1
+		) r
+		WHERE t.%[4]s = $1 AND t.%[3]s = r.%[3]s`,
+		orm.TableNames.ImgAlbumItems,
+		orm.ImgAlbumItemColumns.Position,
+		orm.ImgAlbumItemColumns.ImgID,
+		orm.ImgAlbumItemColumns.AlbumID,
+	), albumID.String(), domain.AlbumPositionGap).Exec(tx)
+
+	return errors.WithStack(err)
+}
+
+// ListAlbumImgs 按相册顺序分页列出未删除的图片 游标主列为 position
+func (repo *ImgPSQLRepository) ListAlbumImgs(query *domain.ListAlbumImgsQuery) (*domain.ListByKeysetResult, error) {
+	baseMods := []qm.QueryMod{
+		orm.ImgAlbumItemWhere.AlbumID.EQ(query.AlbumID.String()),
+		// 回收站中的图片不在相册中展示 恢复后保持原位置
+		qm.Where(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %[1]s i WHERE i.%[2]s = %[3]s.%[4]s AND i.%[5]s IS NULL)",
+			orm.TableNames.Imgs,
+			orm.ImgColumns.ID,
+			orm.TableNames.ImgAlbumItems,
+			orm.ImgAlbumItemColumns.ImgID,
+			orm.ImgColumns.DeletedAt,
+		)),
+	}
+
+	ks := dbkit.NewKeyset(
+		orm.ImgAlbumItemColumns.ImgID,
+		orm.ImgAlbumItemColumns.Position,
+		query.PrevCursor,
+		query.NextCursor,
+		query.PageSize,
+		dbkit.WithPrimaryOrder[*domain.AlbumItem](dbkit.SortAsc),
+	)
+
+	ormItems, err := orm.ImgAlbumItems(ks.ApplyKeysetMods(baseMods)...).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	exists := func(primary int64, id string, checkPrev bool) (bool, error) {
+		var cond qm.QueryMod
+		if checkPrev {
+			cond = ks.BeforeWhere(primary, id)
+		} else {
+			cond = ks.AfterWhere(primary, id)
+		}
+		checkMods := append([]qm.QueryMod{}, baseMods...)
+		checkMods = append(checkMods, cond, qm.Limit(1))
+		return orm.ImgAlbumItems(checkMods...).ExistsG()
+	}
+
+	pager, err := ks.BuildWithExistence(ormAlbumItemsToDomain(ormItems), exists)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 按相册顺序组装图片
+	ids := make([]string, 0, len(pager.Items))
+	for _, item := range pager.Items {
+		ids = append(ids, item.ImgID.String())
+	}
+	ormImgs, err := orm.Imgs(orm.ImgWhere.ID.IN(ids)).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	imgs := make(map[domain.ImgID]*domain.Img, len(ormImgs))
+	for _, img := range ormImgsToDomain(ormImgs) {
+		imgs[img.ID] = img
+	}
+
+	result := &domain.ListByKeysetResult{
+		Items:      make([]*domain.Img, 0, len(pager.Items)),
+		PrevCursor: pager.PrevCursor,
+		NextCursor: pager.NextCursor,
+		HasPrev:    pager.HasPrev,
+		HasNext:    pager.HasNext,
+	}
+	for _, item := range pager.Items {
+		if img, ok := imgs[item.ImgID]; ok {
+			result.Items = append(result.Items, img)
+		}
+	}
+
+	return result, nil
+}
+
+// CreateImportJob 创建导入任务及其文件 压缩包来源的文件在首次执行时解压后创建
+func (repo *ImgPSQLRepository) CreateImportJob(job *domain.ImportJob) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	ormJob := domainImportJobToORM(job)
+	if err := ormJob.Insert(tx, boil.Infer()); err != nil {
+		return errors.WithStack(err)
+	}
+
+	items := job.Items
+	*job = *ormImportJobToDomain(ormJob)
+	for _, item := range items {
+		item.JobID = job.ID
+	}
+	if err := insertImportItems(tx, items); err != nil {
+		return err
+	}
+	job.Items = items
+
+	return errors.WithStack(tx.Commit())
+}
+
+// CreateImportItems 追加任务的文件并更新文件总数
+func (repo *ImgPSQLRepository) CreateImportItems(job *domain.ImportJob, items []*domain.ImportItem) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer tx.Rollback()
+
+	for _, item := range items {
+		item.JobID = job.ID
+	}
+	if err := insertImportItems(tx, items); err != nil {
+		return err
+	}
+
+	rows, err := orm.ImgImportJobs(
+		orm.ImgImportJobWhere.ID.EQ(job.ID.String()),
+	).UpdateAll(tx, orm.M{
+		orm.ImgImportJobColumns.Total:     job.Total + len(items),
+		orm.ImgImportJobColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgImportJobNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.WithStack(err)
+	}
+	job.Total += len(items)
+	job.Items = append(job.Items, items...)
+
+	return nil
+}
+
+func insertImportItems(tx boil.Executor, items []*domain.ImportItem) error {
+	for _, item := range items {
+		ormItem := domainImportItemToORM(item)
+		if err := ormItem.Insert(tx, boil.Infer()); err != nil {
+			return errors.WithStack(err)
+		}
+		*item = *ormImportItemToDomain(ormItem)
+	}
+	return nil
+}
+
+func (repo *ImgPSQLRepository) FindImportJob(tenantID domain.TenantID, jobID domain.ImportJobID) (*domain.ImportJob, error) {
+	ormJob, err := orm.ImgImportJobs(
+		orm.ImgImportJobWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgImportJobWhere.ID.EQ(jobID.String()),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgImportJobNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImportJobToDomain(ormJob), nil
+}
+
+// ListImportJobs 最近的导入任务 不含文件
+func (repo *ImgPSQLRepository) ListImportJobs(tenantID domain.TenantID, limit int) ([]*domain.ImportJob, error) {
+	ormJobs, err := orm.ImgImportJobs(
+		orm.ImgImportJobWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgImportJobColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImportJobsToDomain(ormJobs), nil
+}
+
+func (repo *ImgPSQLRepository) ListImportItems(jobID domain.ImportJobID) ([]*domain.ImportItem, error) {
+	ormItems, err := orm.ImgImportItems(
+		orm.ImgImportItemWhere.JobID.EQ(jobID.String()),
+		qm.OrderBy(orm.ImgImportItemColumns.Seq+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImportItemsToDomain(ormItems), nil
+}
+
+// ClaimImportJobs 认领待执行或心跳超时的任务 同一任务只会被一个实例认领
+func (repo *ImgPSQLRepository) ClaimImportJobs(staleBefore time.Time, limit int) ([]*domain.ImportJob, error) {
+	sql := fmt.Sprintf(
+		`UPDATE %[1]s SET %[2]s = $1, %[3]s = now()
+		WHERE %[4]s IN (
+			SELECT %[4]s FROM %[1]s
+			WHERE %[2]s = $2 OR (%[2]s = $1 AND %[3]s < $3)
+			ORDER BY %[5]s ASC
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		orm.TableNames.ImgImportJobs,
+		orm.ImgImportJobColumns.Status,
+		orm.ImgImportJobColumns.UpdatedAt,
+		orm.ImgImportJobColumns.ID,
+		orm.ImgImportJobColumns.CreatedAt,
+	)
+
+	var ormJobs orm.ImgImportJobSlice
+	if err := queries.Raw(sql,
+		orm.ImgJobStatusRunning,
+		orm.ImgJobStatusPending,
+		staleBefore,
+		limit,
+	).BindG(context.Background(), &ormJobs); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImportJobsToDomain(ormJobs), nil
+}
+
+// UpdateImportJob 更新任务进度与状态 同时刷新心跳
+func (repo *ImgPSQLRepository) UpdateImportJob(job *domain.ImportJob) error {
+	ormJob := domainImportJobToORM(job)
+
+	rows, err := orm.ImgImportJobs(
+		orm.ImgImportJobWhere.TenantID.EQ(job.TenantID.String()),
+		orm.ImgImportJobWhere.ID.EQ(job.ID.String()),
+	).UpdateAllG(orm.M{
+		orm.ImgImportJobColumns.Status:     ormJob.Status,
+		orm.ImgImportJobColumns.Total:      ormJob.Total,
+		orm.ImgImportJobColumns.Succeeded:  ormJob.Succeeded,
+		orm.ImgImportJobColumns.Failed:     ormJob.Failed,
+		orm.ImgImportJobColumns.ArchiveKey: ormJob.ArchiveKey,
+		orm.ImgImportJobColumns.LastError:  ormJob.LastError,
+		orm.ImgImportJobColumns.FinishedAt: ormJob.FinishedAt,
+		orm.ImgImportJobColumns.UpdatedAt:  time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgImportJobNotFound
+	}
+	return nil
+}
+
+func (repo *ImgPSQLRepository) ListExpiredImportJobs(before time.Time, limit int) ([]*domain.ImportJob, error) {
+	ormJobs, err := orm.ImgImportJobs(
+		orm.ImgImportJobWhere.ArchiveKey.IsNotNull(),
+		orm.ImgImportJobWhere.Status.EQ(orm.ImgJobStatusFailed),
+		orm.ImgImportJobWhere.FinishedAt.LT(null.TimeFrom(before)),
+		qm.OrderBy(orm.ImgImportJobColumns.FinishedAt+" ASC"),
+		qm.Limit(limit),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImportJobsToDomain(ormJobs), nil
+}
+
+func (repo *ImgPSQLRepository) ClearExpiredImportArchive(jobID domain.ImportJobID, before time.Time) error {
+	_, err := orm.ImgImportJobs(
+		orm.ImgImportJobWhere.ID.EQ(jobID.String()),
+		orm.ImgImportJobWhere.Status.EQ(orm.ImgJobStatusFailed),
+		orm.ImgImportJobWhere.FinishedAt.LT(null.TimeFrom(before)),
+	).UpdateAllG(orm.M{
+		orm.ImgImportJobColumns.ArchiveKey: nil,
+		orm.ImgImportJobColumns.UpdatedAt:  time.Now(),
+	})
+	return errors.WithStack(err)
+}
+
+func (repo *ImgPSQLRepository) UpdateImportItem(item *domain.ImportItem) error {
+	ormItem := domainImportItemToORM(item)
+
+	_, err := orm.ImgImportItems(
+		orm.ImgImportItemWhere.ID.EQ(item.ID),
+	).UpdateAllG(orm.M{
+		orm.ImgImportItemColumns.Status:    ormItem.Status,
+		orm.ImgImportItemColumns.ImgID:     ormItem.ImgID,
+		orm.ImgImportItemColumns.ErrorCode: ormItem.ErrorCode,
+		orm.ImgImportItemColumns.Error:     ormItem.Error,
+		orm.ImgImportItemColumns.UpdatedAt: time.Now(),
+	})
+	return errors.WithStack(err)
+}
+
+// ResetFailedImportItems 将失败的文件重置为待处理 返回重置的数量
+func (repo *ImgPSQLRepository) ResetFailedImportItems(jobID domain.ImportJobID) (int64, error) {
+	rows, err := orm.ImgImportItems(
+		orm.ImgImportItemWhere.JobID.EQ(jobID.String()),
+		orm.ImgImportItemWhere.Status.EQ(orm.ImgJobStatusFailed),
+	).UpdateAllG(orm.M{
+		orm.ImgImportItemColumns.Status:    orm.ImgJobStatusPending,
+		orm.ImgImportItemColumns.ErrorCode: null.Int{},
+		orm.ImgImportItemColumns.Error:     null.String{},
+		orm.ImgImportItemColumns.UpdatedAt: time.Now(),
+	})
+	return rows, errors.WithStack(err)
+}
+
+func (repo *ImgPSQLRepository) CreateExportJob(job *domain.ExportJob) error {
+	ormJob := domainExportJobToORM(job)
+	if err := ormJob.InsertG(boil.Infer()); err != nil {
+		return errors.WithStack(err)
+	}
+	*job = *ormExportJobToDomain(ormJob)
+	return nil
+}
+
+func (repo *ImgPSQLRepository) FindExportJob(tenantID domain.TenantID, jobID domain.ExportJobID) (*domain.ExportJob, error) {
+	ormJob, err := orm.ImgExportJobs(
+		orm.ImgExportJobWhere.TenantID.EQ(tenantID.String()),
+		orm.ImgExportJobWhere.ID.EQ(jobID.String()),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrImgExportJobNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return ormExportJobToDomain(ormJob), nil
+}
+
+// ListExportJobs 最近的导出任务
+func (repo *ImgPSQLRepository) ListExportJobs(tenantID domain.TenantID, limit int) ([]*domain.ExportJob, error) {
+	ormJobs, err := orm.ImgExportJobs(
+		orm.ImgExportJobWhere.TenantID.EQ(tenantID.String()),
+		qm.OrderBy(orm.ImgExportJobColumns.CreatedAt+" DESC"),
+		qm.Limit(limit),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormExportJobsToDomain(ormJobs), nil
+}
+
+// ClaimExportJobs 认领待执行或心跳超时的任务 同一任务只会被一个实例认领
+func (repo *ImgPSQLRepository) ClaimExportJobs(staleBefore time.Time, limit int) ([]*domain.ExportJob, error) {
+	sql := fmt.Sprintf(
+		`UPDATE %[1]s SET %[2]s = $1, %[3]s = now()
+		WHERE %[4]s IN (
+			SELECT %[4]s FROM %[1]s
+			WHERE %[2]s = $2 OR (%[2]s = $1 AND %[3]s < $3)
+			ORDER BY %[5]s ASC
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		orm.TableNames.ImgExportJobs,
+		orm.ImgExportJobColumns.Status,
+		orm.ImgExportJobColumns.UpdatedAt,
+		orm.ImgExportJobColumns.ID,
+		orm.ImgExportJobColumns.CreatedAt,
+	)
+
+	var ormJobs orm.ImgExportJobSlice
+	if err := queries.Raw(sql,
+		orm.ImgJobStatusRunning,
+		orm.ImgJobStatusPending,
+		staleBefore,
+		limit,
+	).BindG(context.Background(), &ormJobs); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormExportJobsToDomain(ormJobs), nil
+}
+
+// UpdateExportJob 更新任务进度与状态 同时刷新心跳
+func (repo *ImgPSQLRepository) UpdateExportJob(job *domain.ExportJob) error {
+	ormJob := domainExportJobToORM(job)
+
+	rows, err := orm.ImgExportJobs(
+		orm.ImgExportJobWhere.TenantID.EQ(job.TenantID.String()),
+		orm.ImgExportJobWhere.ID.EQ(job.ID.String()),
+	).UpdateAllG(orm.M{
+		orm.ImgExportJobColumns.Status:     ormJob.Status,
+		orm.ImgExportJobColumns.Total:      ormJob.Total,
+		orm.ImgExportJobColumns.Exported:   ormJob.Exported,
+		orm.ImgExportJobColumns.Missing:    ormJob.Missing,
+		orm.ImgExportJobColumns.Size:       ormJob.Size,
+		orm.ImgExportJobColumns.ArchiveKey: ormJob.ArchiveKey,
+		orm.ImgExportJobColumns.LastError:  ormJob.LastError,
+		orm.ImgExportJobColumns.FinishedAt: ormJob.FinishedAt,
+		orm.ImgExportJobColumns.ExpiresAt:  ormJob.ExpiresAt,
+		orm.ImgExportJobColumns.UpdatedAt:  time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if rows == 0 {
+		return codes.ErrImgExportJobNotFound
+	}
+	return nil
+}
+
+func (repo *ImgPSQLRepository) ListExpiredExportJobs(before time.Time, limit int) ([]*domain.ExportJob, error) {
+	ormJobs, err := orm.ImgExportJobs(
+		orm.ImgExportJobWhere.ArchiveKey.IsNotNull(),
+		orm.ImgExportJobWhere.ExpiresAt.LT(null.TimeFrom(before)),
+		qm.OrderBy(orm.ImgExportJobColumns.ExpiresAt+" ASC"),
+		qm.Limit(limit),
+	).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormExportJobsToDomain(ormJobs), nil
+}
+
+// ListExportImgs 复用列表的分类与标签筛选 包含回收站时同时列出已软删除的图片
+func (repo *ImgPSQLRepository) ListExportImgs(job *domain.ExportJob, limit int) ([]*domain.Img, error) {
+	mods := imgListFilterMods(&domain.ListByKeysetQuery{
+		TenantID:             job.TenantID,
+		CategoryID:           job.CategoryID,
+		IncludeSubcategories: job.IncludeSubcategories,
+		TagIDs:               job.TagIDs,
+	})
+	if job.IncludeDeleted {
+		mods = append(mods, qm.WithDeleted())
+	}
+	mods = append(mods,
+		qm.OrderBy(orm.ImgColumns.CreatedAt+" ASC, "+orm.ImgColumns.ID+" ASC"),
+		qm.Limit(limit),
+	)
+
+	ormImgs, err := orm.Imgs(mods...).AllG()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ormImgsToDomain(ormImgs), nil
+}
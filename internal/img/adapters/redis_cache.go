@@ -2,12 +2,14 @@ package adapters
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"saas/internal/common/reskit/codes"
 	"saas/internal/common/utils"
 	"saas/internal/img/domain"
-	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -16,6 +18,10 @@ type ImgRedisCache struct {
 }
 
 func NewImgRedisCache() domain.ImgMsgQueue {
+	return &ImgRedisCache{client: newRedisClient()}
+}
+
+func newRedisClient() *redis.Client {
 	host := utils.GetEnv("REDIS_HOST")
 	port := utils.GetEnv("REDIS_PORT")
 	password := utils.GetEnv("REDIS_PASSWORD")
@@ -36,48 +42,77 @@ func NewImgRedisCache() domain.ImgMsgQueue {
 		panic(err)
 	}
 
-	return &ImgRedisCache{client: client}
+	return client
 }
 
-const (
-	keyImgDeleteQueueKey = "img:delete"
-	deleteImgExpire      = time.Hour * 24 * 7
-)
+const keyImgUploadSlotKey = "img:upload_slot"
 
-func (c *ImgRedisCache) buildDeletedQueueKey(tenantID domain.TenantID, imgID domain.ImgID) string {
-	return fmt.Sprintf("%s:%s:%s", utils.GetRedisKey(keyImgDeleteQueueKey), tenantID, imgID)
+// redisUploadSlot 上传凭证在 redis 中的存储结构
+type redisUploadSlot struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	CategoryID  string    `json:"category_id,omitempty"`
+	Path        string    `json:"path"`
+	Description string    `json:"description,omitempty"`
+	Filename    string    `json:"filename,omitempty"`
+	Format      string    `json:"format"`
+	Size        int64     `json:"size"`
+	ExpiresAt   time.Time `json:"expires_at"`
 }
 
-// AddToDeleteQueue 软删除时写入 redis 并设置过期
-func (c *ImgRedisCache) AddToDeleteQueue(tenantID domain.TenantID, imgID domain.ImgID) error {
-	key := c.buildDeletedQueueKey(tenantID, imgID)
-	return c.client.SetEx(context.Background(), key, "", deleteImgExpire).Err()
+func (c *ImgRedisCache) buildUploadSlotKey(tenantID domain.TenantID, slotID domain.UploadSlotID) string {
+	return fmt.Sprintf("%s:%s:%s", utils.GetRedisKey(keyImgUploadSlotKey), tenantID, slotID)
 }
 
-// ListenDeleteQueue 后台监听 key 过期事件
-func (c *ImgRedisCache) ListenDeleteQueue(onExpire func(tenantID domain.TenantID, imgID domain.ImgID)) {
-	pubsub := c.client.PSubscribe(context.Background(), "__keyevent@0__:expired")
-	defer pubsub.Close() // 确保资源释放
-
-	preKey := utils.GetRedisKey(keyImgDeleteQueueKey) + ":"
-
-	for msg := range pubsub.Channel() {
-		// 解析 key: img:delete:tenantID:imgID
-		if strings.HasPrefix(msg.Payload, preKey) {
-			// 获取 preKey 后面的数据 tenantID:imgID
-			suffix := strings.TrimPrefix(msg.Payload, preKey)
-			parts := strings.Split(suffix, ":")
-			if len(parts) == 2 {
-				tenantID := parts[0]
-				imgID := parts[1]
-				onExpire(domain.TenantID(tenantID), domain.ImgID(imgID))
-			}
+func (c *ImgRedisCache) SaveUploadSlot(slot *domain.UploadSlot, expire time.Duration) error {
+	data, err := json.Marshal(&redisUploadSlot{
+		ID:          slot.ID.String(),
+		TenantID:    slot.TenantID.String(),
+		CategoryID:  slot.CategoryID.String(),
+		Path:        slot.Path,
+		Description: slot.Description,
+		Filename:    slot.OriginalFilename,
+		Format:      slot.Format.String(),
+		Size:        slot.Size,
+		ExpiresAt:   slot.ExpiresAt,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	key := c.buildUploadSlotKey(slot.TenantID, slot.ID)
+	return c.client.SetEx(context.Background(), key, data, expire).Err()
+}
+
+func (c *ImgRedisCache) GetUploadSlot(tenantID domain.TenantID, slotID domain.UploadSlotID) (*domain.UploadSlot, error) {
+	key := c.buildUploadSlotKey(tenantID, slotID)
+	data, err := c.client.Get(context.Background(), key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, codes.ErrImgUploadSlotNotFound
 		}
+		return nil, errors.WithStack(err)
 	}
+
+	slot := new(redisUploadSlot)
+	if err := json.Unmarshal(data, slot); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.UploadSlot{
+		ID:               domain.UploadSlotID(slot.ID),
+		TenantID:         domain.TenantID(slot.TenantID),
+		CategoryID:       domain.CategoryID(slot.CategoryID),
+		Path:             slot.Path,
+		Description:      slot.Description,
+		OriginalFilename: slot.Filename,
+		Format:           domain.ImageFormat(slot.Format),
+		Size:             slot.Size,
+		ExpiresAt:        slot.ExpiresAt,
+	}, nil
 }
 
-// RemoveFromDeleteQueue 从删除队列中移除指定图片ID
-func (c *ImgRedisCache) RemoveFromDeleteQueue(tenantID domain.TenantID, imgID domain.ImgID) error {
-	key := c.buildDeletedQueueKey(tenantID, imgID)
+func (c *ImgRedisCache) RemoveUploadSlot(tenantID domain.TenantID, slotID domain.UploadSlotID) error {
+	key := c.buildUploadSlotKey(tenantID, slotID)
 	return c.client.Del(context.Background(), key).Err()
 }
@@ -0,0 +1,298 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/common/utils"
+	"saas/internal/img/domain"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// 延迟删除队列
+// queue: 有序集合 score 为到期时间 到期后由任意实例认领
+// processing: 有序集合 score 为租约到期时间 实例崩溃导致租约过期的任务重新入队 保证至少处理一次
+// attempts: 哈希 记录失败次数 超过上限后移入租户的死信哈希
+const (
+	keyImgDeleteQueue      = "img:delete_queue"
+	keyImgDeleteProcessing = "img:delete_processing"
+	keyImgDeleteAttempts   = "img:delete_attempts"
+	keyImgDeleteDead       = "img:delete_dead"
+	// keyImgDeleteLegacy 旧版按 key 过期触发的删除任务 启动时迁移到 queue
+	keyImgDeleteLegacy = "img:delete"
+
+	deleteImgExpire         = time.Hour * 24 * 7
+	deleteQueuePollInterval = 5 * time.Second
+	deleteQueueBatchSize    = 100
+	deleteQueueLease        = 5 * time.Minute
+	deleteQueueMaxAttempts  = 5
+	deleteQueueMaxBackoff   = time.Hour
+	// deleteQueueDeferDelay 存储迁移期间任务延后的间隔 不计入失败次数
+	deleteQueueDeferDelay = 10 * time.Minute
+)
+
+// claimDeleteTasksScript 原子地回收过期租约并认领到期任务 同一任务同一时刻只会被一个实例认领
+// KEYS[1] queue KEYS[2] processing ARGV[1] 当前时间 ARGV[2] 租约到期时间 ARGV[3] 认领上限
+var claimDeleteTasksScript = redis.NewScript(`
+local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, member in ipairs(expired) do
+	redis.call('ZREM', KEYS[2], member)
+	redis.call('ZADD', KEYS[1], ARGV[1], member)
+end
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, member in ipairs(due) do
+	redis.call('ZREM', KEYS[1], member)
+	redis.call('ZADD', KEYS[2], ARGV[2], member)
+end
+return due
+`)
+
+// redisDeleteDeadLetter 死信在 redis 中的存储结构
+type redisDeleteDeadLetter struct {
+	ImgID    string    `json:"img_id"`
+	Attempts int64     `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+func buildDeleteTaskMember(tenantID domain.TenantID, imgID domain.ImgID) string {
+	return tenantID.String() + ":" + imgID.String()
+}
+
+func parseDeleteTaskMember(member string) (domain.TenantID, domain.ImgID, bool) {
+	tenantID, imgID, ok := strings.Cut(member, ":")
+	if !ok || tenantID == "" || imgID == "" {
+		return "", "", false
+	}
+	return domain.TenantID(tenantID), domain.ImgID(imgID), true
+}
+
+func (c *ImgRedisCache) buildDeleteDeadKey(tenantID domain.TenantID) string {
+	return fmt.Sprintf("%s:%s", utils.GetRedisKey(keyImgDeleteDead), tenantID)
+}
+
+// AddToDeleteQueue 软删除后加入延迟删除队列 到期后彻底删除
+func (c *ImgRedisCache) AddToDeleteQueue(tenantID domain.TenantID, imgID domain.ImgID) error {
+	return c.scheduleDelete(context.Background(), tenantID, imgID, time.Now().Add(deleteImgExpire))
+}
+
+func (c *ImgRedisCache) scheduleDelete(ctx context.Context, tenantID domain.TenantID, imgID domain.ImgID, dueAt time.Time) error {
+	return c.client.ZAdd(ctx, utils.GetRedisKey(keyImgDeleteQueue), redis.Z{
+		Score:  float64(dueAt.UnixMilli()),
+		Member: buildDeleteTaskMember(tenantID, imgID),
+	}).Err()
+}
+
+// ListenDeleteQueue 轮询认领到期任务 handler 返回错误时按退避重试 超过上限移入死信
+func (c *ImgRedisCache) ListenDeleteQueue(handler func(tenantID domain.TenantID, imgID domain.ImgID) error) {
+	ctx := context.Background()
+
+	if err := c.migrateLegacyDeleteTasks(ctx); err != nil {
+		zap.L().Error("延迟删除队列：迁移旧版删除任务失败", zap.Error(err))
+	}
+
+	ticker := time.NewTicker(deleteQueuePollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for {
+			members, err := c.claimDeleteTasks(ctx)
+			if err != nil {
+				zap.L().Error("延迟删除队列：认领任务失败", zap.Error(err))
+				break
+			}
+
+			for _, member := range members {
+				c.processDeleteTask(ctx, member, handler)
+			}
+
+			// 未取满说明已无到期任务 等待下一轮
+			if len(members) < deleteQueueBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (c *ImgRedisCache) claimDeleteTasks(ctx context.Context) ([]string, error) {
+	now := time.Now()
+	return claimDeleteTasksScript.Run(ctx, c.client,
+		[]string{utils.GetRedisKey(keyImgDeleteQueue), utils.GetRedisKey(keyImgDeleteProcessing)},
+		now.UnixMilli(),
+		now.Add(deleteQueueLease).UnixMilli(),
+		deleteQueueBatchSize,
+	).StringSlice()
+}
+
+func (c *ImgRedisCache) processDeleteTask(ctx context.Context, member string, handler func(tenantID domain.TenantID, imgID domain.ImgID) error) {
+	tenantID, imgID, ok := parseDeleteTaskMember(member)
+	if !ok {
+		c.client.ZRem(ctx, utils.GetRedisKey(keyImgDeleteProcessing), member)
+		return
+	}
+
+	err := handler(tenantID, imgID)
+	if errors.Is(err, codes.ErrImgStorageMigrating) {
+		if err := c.deferDeleteTask(ctx, member); err != nil {
+			zap.L().Error("延迟删除队列：延后任务出错",
+				zap.String("tenant_id", tenantID.String()),
+				zap.String("img_id", imgID.String()),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+	if err != nil {
+		if err := c.failDeleteTask(ctx, tenantID, imgID, member, err); err != nil {
+			zap.L().Error("延迟删除队列：记录失败任务出错",
+				zap.String("tenant_id", tenantID.String()),
+				zap.String("img_id", imgID.String()),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+
+	// 确认完成
+	if _, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, utils.GetRedisKey(keyImgDeleteProcessing), member)
+		pipe.HDel(ctx, utils.GetRedisKey(keyImgDeleteAttempts), member)
+		return nil
+	}); err != nil {
+		zap.L().Error("延迟删除队列：确认任务失败",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("img_id", imgID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// deferDeleteTask 存储迁移期间不删除对象 任务重新入队且不增加失败次数
+func (c *ImgRedisCache) deferDeleteTask(ctx context.Context, member string) error {
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, utils.GetRedisKey(keyImgDeleteProcessing), member)
+		pipe.ZAdd(ctx, utils.GetRedisKey(keyImgDeleteQueue), redis.Z{
+			Score:  float64(time.Now().Add(deleteQueueDeferDelay).UnixMilli()),
+			Member: member,
+		})
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+// failDeleteTask 未超过上限时按 1、4、9... 分钟退避后重新入队 否则移入死信
+func (c *ImgRedisCache) failDeleteTask(ctx context.Context, tenantID domain.TenantID, imgID domain.ImgID, member string, cause error) error {
+	attempts, err := c.client.HIncrBy(ctx, utils.GetRedisKey(keyImgDeleteAttempts), member, 1).Result()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if attempts < deleteQueueMaxAttempts {
+		backoff := min(time.Duration(attempts*attempts)*time.Minute, deleteQueueMaxBackoff)
+		_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.ZRem(ctx, utils.GetRedisKey(keyImgDeleteProcessing), member)
+			pipe.ZAdd(ctx, utils.GetRedisKey(keyImgDeleteQueue), redis.Z{
+				Score:  float64(time.Now().Add(backoff).UnixMilli()),
+				Member: member,
+			})
+			return nil
+		})
+		return errors.WithStack(err)
+	}
+
+	data, err := json.Marshal(&redisDeleteDeadLetter{
+		ImgID:    imgID.String(),
+		Attempts: attempts,
+		Error:    cause.Error(),
+		FailedAt: time.Now(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, utils.GetRedisKey(keyImgDeleteProcessing), member)
+		pipe.HDel(ctx, utils.GetRedisKey(keyImgDeleteAttempts), member)
+		pipe.HSet(ctx, c.buildDeleteDeadKey(tenantID), imgID.String(), data)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+// RemoveFromDeleteQueue 从删除队列、处理中任务与死信中移除指定图片
+func (c *ImgRedisCache) RemoveFromDeleteQueue(tenantID domain.TenantID, imgID domain.ImgID) error {
+	ctx := context.Background()
+	member := buildDeleteTaskMember(tenantID, imgID)
+
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, utils.GetRedisKey(keyImgDeleteQueue), member)
+		pipe.ZRem(ctx, utils.GetRedisKey(keyImgDeleteProcessing), member)
+		pipe.HDel(ctx, utils.GetRedisKey(keyImgDeleteAttempts), member)
+		pipe.HDel(ctx, c.buildDeleteDeadKey(tenantID), imgID.String())
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (c *ImgRedisCache) ListDeleteDeadLetters(tenantID domain.TenantID) ([]*domain.DeleteDeadLetter, error) {
+	values, err := c.client.HGetAll(context.Background(), c.buildDeleteDeadKey(tenantID)).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	letters := make([]*domain.DeleteDeadLetter, 0, len(values))
+	for _, value := range values {
+		letter := new(redisDeleteDeadLetter)
+		if err := json.Unmarshal([]byte(value), letter); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		letters = append(letters, &domain.DeleteDeadLetter{
+			TenantID: tenantID,
+			ImgID:    domain.ImgID(letter.ImgID),
+			Attempts: int(letter.Attempts),
+			Error:    letter.Error,
+			FailedAt: letter.FailedAt,
+		})
+	}
+
+	return letters, nil
+}
+
+// migrateLegacyDeleteTasks 将旧版 img:delete:{tenantID}:{imgID} 过期 key 按剩余时间迁移到队列
+func (c *ImgRedisCache) migrateLegacyDeleteTasks(ctx context.Context) error {
+	prefix := utils.GetRedisKey(keyImgDeleteLegacy) + ":"
+
+	iter := c.client.Scan(ctx, 0, prefix+"*", 1000).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		tenantID, imgID, ok := parseDeleteTaskMember(strings.TrimPrefix(key, prefix))
+		if !ok {
+			continue
+		}
+
+		ttl, err := c.client.TTL(ctx, key).Result()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		// key 已过期或未设置过期时间时立即处理
+		dueAt := time.Now()
+		if ttl > 0 {
+			dueAt = dueAt.Add(ttl)
+		}
+
+		if err := c.scheduleDelete(ctx, tenantID, imgID, dueAt); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := c.client.Del(ctx, key).Err(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.WithStack(iter.Err())
+}
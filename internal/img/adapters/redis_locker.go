@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/common/utils"
+	"saas/internal/img/domain"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// 分布式锁
+// lock:<key>: 值为持有者随机标识 带过期时间 持有期间每 ttl/3 续期一次
+// lock_fence: 全局递增计数 每次加锁成功时自增作为 fencing token
+// 令牌不小于 redis 当前时间的微秒数 计数丢失或切换为进程内锁后令牌仍大于已写入记录的令牌
+const (
+	keyImgLock      = "img:lock"
+	keyImgLockFence = "img:lock_fence"
+
+	lockRetryMin = 20 * time.Millisecond
+	lockRetryMax = 500 * time.Millisecond
+)
+
+// acquireLockScript 加锁成功时返回新的 fencing token 已被持有时返回 0
+// KEYS[1] 锁 KEYS[2] 计数 ARGV[1] 持有者标识 ARGV[2] 过期毫秒数
+var acquireLockScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+	local now = redis.call('TIME')
+	local floor = tonumber(now[1]) * 1000000 + tonumber(now[2])
+	local token = redis.call('INCR', KEYS[2])
+	if token < floor then
+		redis.call('SET', KEYS[2], string.format('%d', floor))
+		token = floor
+	end
+	return token
+end
+return 0
+`)
+
+// releaseLockScript 仅删除自己持有的锁 KEYS[1] 锁 ARGV[1] 持有者标识
+var releaseLockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// extendLockScript 仅续期自己持有的锁 KEYS[1] 锁 ARGV[1] 持有者标识 ARGV[2] 过期毫秒数
+var extendLockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+type RedisLocker struct {
+	client *redis.Client
+}
+
+// NewImgLocker 默认使用 redis 单实例部署或本地调试时可设置 IMG_LOCK_PROVIDER=local 使用进程内锁
+func NewImgLocker() domain.Locker {
+	if os.Getenv("IMG_LOCK_PROVIDER") == "local" {
+		return NewLocalLocker()
+	}
+	return &RedisLocker{client: newRedisClient()}
+}
+
+func (l *RedisLocker) Lock(key string, ttl, wait time.Duration) (domain.Lock, error) {
+	ctx := context.Background()
+
+	owner := make([]byte, 16)
+	if _, err := rand.Read(owner); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	lock := &redisLock{
+		client: l.client,
+		key:    utils.GetRedisKey(keyImgLock) + ":" + key,
+		owner:  hex.EncodeToString(owner),
+		ttl:    ttl,
+		done:   make(chan struct{}),
+	}
+
+	deadline := time.Now().Add(wait)
+	backoff := lockRetryMin
+	for {
+		token, err := acquireLockScript.Run(ctx, l.client,
+			[]string{lock.key, utils.GetRedisKey(keyImgLockFence)},
+			lock.owner,
+			ttl.Milliseconds(),
+		).Int64()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if token > 0 {
+			lock.token = domain.FencingToken(token)
+			break
+		}
+
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, codes.ErrImgLockTimeout
+		}
+		time.Sleep(backoff)
+		backoff = min(backoff*2, lockRetryMax)
+	}
+
+	go lock.keepAlive()
+
+	return lock, nil
+}
+
+type redisLock struct {
+	client *redis.Client
+	key    string
+	owner  string
+	ttl    time.Duration
+	token  domain.FencingToken
+
+	once sync.Once
+	done chan struct{}
+}
+
+func (l *redisLock) Token() domain.FencingToken {
+	return l.token
+}
+
+func (l *redisLock) Unlock() error {
+	l.once.Do(func() { close(l.done) })
+
+	released, err := releaseLockScript.Run(context.Background(), l.client, []string{l.key}, l.owner).Int64()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if released == 0 {
+		return errors.Errorf("锁 %s 已过期或被其他持有者获取", l.key)
+	}
+	return nil
+}
+
+// keepAlive 持有期间定期续期 续期失败说明锁已丢失 停止续期由 Unlock 报告
+func (l *redisLock) keepAlive() {
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+			extended, err := extendLockScript.Run(context.Background(), l.client,
+				[]string{l.key}, l.owner, l.ttl.Milliseconds(),
+			).Int64()
+			if err != nil {
+				// 网络抖动时下次重试 锁在 ttl 内仍有效
+				zap.L().Warn("分布式锁续期失败", zap.String("key", l.key), zap.Error(err))
+				continue
+			}
+			if extended == 0 {
+				zap.L().Error("分布式锁已丢失", zap.String("key", l.key), zap.Int64("token", int64(l.token)))
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"context"
+	"saas/internal/common/utils"
+	"saas/internal/img/domain"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// 存储配置变更通知
+// 各实例在进程内缓存租户的存储客户端 配置变更后通过该频道通知所有实例丢弃缓存
+const (
+	keyImgStorageInvalidation = "img:storage_invalidation"
+
+	storageInvalidationRetryInterval = 5 * time.Second
+)
+
+func (c *ImgRedisCache) PublishStorageInvalidation(tenantID domain.TenantID) error {
+	err := c.client.Publish(context.Background(), utils.GetRedisKey(keyImgStorageInvalidation), tenantID.String()).Err()
+	return errors.WithStack(err)
+}
+
+func (c *ImgRedisCache) SubscribeStorageInvalidation(handler func(tenantID domain.TenantID)) {
+	ctx := context.Background()
+
+	pubsub := c.client.Subscribe(ctx, utils.GetRedisKey(keyImgStorageInvalidation))
+	defer pubsub.Close()
+
+	for {
+		msg, err := pubsub.Receive(ctx)
+		if err != nil {
+			// 下次 Receive 时自动重连并重新订阅
+			zap.L().Error("存储配置变更通知：接收消息失败", zap.Error(err))
+			time.Sleep(storageInvalidationRetryInterval)
+			continue
+		}
+
+		switch msg := msg.(type) {
+		case *redis.Subscription:
+			// 订阅建立或重连成功 断开期间的通知可能已丢失 丢弃全部缓存
+			if msg.Kind == "subscribe" {
+				handler("")
+			}
+		case *redis.Message:
+			handler(domain.TenantID(msg.Payload))
+		}
+	}
+}
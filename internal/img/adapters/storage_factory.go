@@ -0,0 +1,44 @@
+package adapters
+
+import (
+	"os"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/common/secrets"
+	"saas/internal/img/domain"
+)
+
+type ObjectStorageFactory struct {
+	localRoot    string
+	localBaseURL string
+	localSignKey []byte
+}
+
+// NewObjectStorageFactory 本地存储仅在设置了 IMG_LOCAL_STORAGE_ROOT 与 IMG_LOCAL_STORAGE_BASE_URL 时可用
+// 生产环境通常不设置 从而禁止租户将图片写入服务器磁盘
+func NewObjectStorageFactory() domain.ObjectStorageFactory {
+	return &ObjectStorageFactory{
+		localRoot:    os.Getenv("IMG_LOCAL_STORAGE_ROOT"),
+		localBaseURL: os.Getenv("IMG_LOCAL_STORAGE_BASE_URL"),
+		localSignKey: secrets.SigningKey("img-local-presign"),
+	}
+}
+
+func (f *ObjectStorageFactory) New(config *domain.StorageConfig) (domain.ObjectStorage, error) {
+	switch config.Provider {
+	case domain.StorageProviderR2:
+		return newR2ObjectStorage(config)
+	case domain.StorageProviderS3:
+		return newS3CompatibleObjectStorage(config)
+	case domain.StorageProviderLocal:
+		if f.localRoot == "" || f.localBaseURL == "" {
+			return nil, codes.ErrImgStorageProviderDisabled.WithDetail(map[string]any{
+				"provider": config.Provider,
+			})
+		}
+		return newLocalObjectStorage(f.localRoot, f.localBaseURL, f.localSignKey, config.TenantID), nil
+	default:
+		return nil, codes.ErrImgStorageConfigInvalid.WithDetail(map[string]any{
+			"provider": config.Provider,
+		})
+	}
+}
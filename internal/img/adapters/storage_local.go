@@ -0,0 +1,262 @@
+package adapters
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LocalObjectStorage 本地磁盘存储 对象保存在 {root}/{tenant_id}/{bucket}/{key}
+// 对外访问由本服务的 /v1/img_local 路由提供
+type LocalObjectStorage struct {
+	root    string
+	baseURL string
+	signKey []byte
+}
+
+func newLocalObjectStorage(root, baseURL string, signKey []byte, tenantID domain.TenantID) *LocalObjectStorage {
+	return &LocalObjectStorage{
+		root:    filepath.Join(root, tenantID.String()),
+		baseURL: strings.TrimRight(baseURL, "/") + "/" + tenantID.String(),
+		signKey: signKey,
+	}
+}
+
+// objectPath 将 bucket 与 key 映射为磁盘路径 拒绝越出租户目录的路径
+func (s *LocalObjectStorage) objectPath(bucket, key string) (string, error) {
+	if bucket == "" || strings.ContainsAny(bucket, `/\`) || bucket == "." || bucket == ".." {
+		return "", codes.ErrImgStorageObjectKeyInvalid
+	}
+	if key == "" || path.IsAbs(key) || strings.Contains(key, `\`) {
+		return "", codes.ErrImgStorageObjectKeyInvalid
+	}
+	cleaned := path.Clean(key)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", codes.ErrImgStorageObjectKeyInvalid
+	}
+
+	return filepath.Join(s.root, bucket, filepath.FromSlash(cleaned)), nil
+}
+
+func (s *LocalObjectStorage) Put(bucket, key string, body io.Reader, contentType string) error {
+	dst, err := s.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 先写临时文件再重命名 避免读到写了一半的对象
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".upload-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file %s/%s: %w", bucket, key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(tmp.Name(), dst))
+}
+
+func (s *LocalObjectStorage) Copy(srcBucket, srcKey, dstBucket, dstKey string) error {
+	src, err := s.Get(srcBucket, srcKey)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	return s.Put(dstBucket, dstKey, src, "")
+}
+
+func (s *LocalObjectStorage) Delete(bucket, key string) error {
+	dst, err := s.objectPath(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	// 与 S3 语义保持一致 删除不存在的对象不报错
+	if err := os.Remove(dst); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete file %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
+
+func (s *LocalObjectStorage) Get(bucket, key string) (io.ReadCloser, error) {
+	dst, err := s.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(dst)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, codes.ErrImgStorageObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to open file %s/%s: %w", bucket, key, err)
+	}
+	return file, nil
+}
+
+func (s *LocalObjectStorage) Presign(bucket, key string, expire time.Duration) (string, error) {
+	if _, err := s.objectPath(bucket, key); err != nil {
+		return "", err
+	}
+
+	expires := time.Now().Add(expire).Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", s.sign(bucket, key, expires))
+
+	return fmt.Sprintf("%s/%s/%s?%s", s.baseURL, bucket, escapeObjectKey(key), query.Encode()), nil
+}
+
+func (s *LocalObjectStorage) PresignPut(bucket, key, contentType string, size int64, expire time.Duration) (string, error) {
+	if _, err := s.objectPath(bucket, key); err != nil {
+		return "", err
+	}
+
+	expires := time.Now().Add(expire).Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", s.signPut(bucket, key, contentType, size, expires))
+
+	return fmt.Sprintf("%s/%s/%s?%s", s.baseURL, bucket, escapeObjectKey(key), query.Encode()), nil
+}
+
+func (s *LocalObjectStorage) Stat(bucket, key string) (*domain.ObjectInfo, error) {
+	dst, err := s.objectPath(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(dst)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, codes.ErrImgStorageObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to stat file %s/%s: %w", bucket, key, err)
+	}
+
+	return &domain.ObjectInfo{
+		Key:          key,
+		Size:         info.Size(),
+		LastModified: info.ModTime(),
+	}, nil
+}
+
+// List 按 key 字典序分页 token 为上一页最后一个 key
+func (s *LocalObjectStorage) List(bucket, prefix, token string, limit int) (*domain.ObjectList, error) {
+	if bucket == "" || strings.ContainsAny(bucket, `/\`) || bucket == "." || bucket == ".." {
+		return nil, codes.ErrImgStorageObjectKeyInvalid
+	}
+	bucketDir := filepath.Join(s.root, bucket)
+
+	objects := make([]*domain.ObjectInfo, 0)
+	err := filepath.WalkDir(bucketDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bucketDir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) || (token != "" && key <= token) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, &domain.ObjectInfo{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files in bucket %s: %w", bucket, err)
+	}
+
+	slices.SortFunc(objects, func(a, b *domain.ObjectInfo) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	list := &domain.ObjectList{Objects: objects}
+	if limit > 0 && len(objects) > limit {
+		list.Objects = objects[:limit]
+		list.NextToken = objects[limit-1].Key
+	}
+
+	return list, nil
+}
+
+func (s *LocalObjectStorage) PublicURLPrefix(bucket string) string {
+	return s.baseURL + "/" + bucket
+}
+
+func (s *LocalObjectStorage) VerifyPresign(bucket, key string, expires int64, signature string) error {
+	if time.Now().Unix() > expires {
+		return codes.ErrImgStorageSignatureInvalid
+	}
+	if !hmac.Equal([]byte(s.sign(bucket, key, expires)), []byte(signature)) {
+		return codes.ErrImgStorageSignatureInvalid
+	}
+	return nil
+}
+
+func (s *LocalObjectStorage) VerifyPresignPut(bucket, key, contentType string, size int64, expires int64, signature string) error {
+	if time.Now().Unix() > expires {
+		return codes.ErrImgStorageSignatureInvalid
+	}
+	if !hmac.Equal([]byte(s.signPut(bucket, key, contentType, size, expires)), []byte(signature)) {
+		return codes.ErrImgStorageSignatureInvalid
+	}
+	return nil
+}
+
+// signPut 与读取签名区分 并绑定 Content-Type 与大小
+func (s *LocalObjectStorage) signPut(bucket, key, contentType string, size int64, expires int64) string {
+	mac := hmac.New(sha256.New, s.signKey)
+	mac.Write([]byte("PUT\n" + s.baseURL + "/" + bucket + "/" + key + "\n" + contentType + "\n" +
+		strconv.FormatInt(size, 10) + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalObjectStorage) sign(bucket, key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.signKey)
+	mac.Write([]byte(s.baseURL + "/" + bucket + "/" + key + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,223 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/pkg/errors"
+)
+
+// S3ObjectStorage 基于 S3 协议的对象存储 R2、AWS S3、MinIO 等共用此实现
+type S3ObjectStorage struct {
+	client        *s3.Client
+	presignClient *s3.PresignClient
+}
+
+type s3Options struct {
+	endpoint     string
+	region       string
+	accessKeyID  string
+	secretKey    string
+	usePathStyle bool
+}
+
+func newR2ObjectStorage(cfg *domain.StorageConfig) (*S3ObjectStorage, error) {
+	return newS3ObjectStorage(&s3Options{
+		endpoint:    fmt.Sprintf("https://%s.r2.cloudflarestorage.com", cfg.AccountID),
+		region:      "auto", // R2 不使用区域，但 SDK 需要
+		accessKeyID: cfg.AccessKeyID,
+		secretKey:   cfg.GetSecretAccessKey(),
+	})
+}
+
+func newS3CompatibleObjectStorage(cfg *domain.StorageConfig) (*S3ObjectStorage, error) {
+	return newS3ObjectStorage(&s3Options{
+		endpoint:     cfg.Endpoint,
+		region:       cfg.Region,
+		accessKeyID:  cfg.AccessKeyID,
+		secretKey:    cfg.GetSecretAccessKey(),
+		usePathStyle: cfg.UsePathStyle,
+	})
+}
+
+func newS3ObjectStorage(opts *s3Options) (*S3ObjectStorage, error) {
+	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
+		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(opts.accessKeyID, opts.secretKey, "")),
+		config.WithRegion(opts.region),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load AWS config")
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		// 端点为空时使用 AWS 按区域推导的默认端点
+		if opts.endpoint != "" {
+			o.BaseEndpoint = aws.String(opts.endpoint)
+		}
+		// MinIO 等自建服务通常不支持虚拟主机风格
+		o.UsePathStyle = opts.usePathStyle
+	})
+
+	return &S3ObjectStorage{
+		client:        client,
+		presignClient: s3.NewPresignClient(client),
+	}, nil
+}
+
+func (s *S3ObjectStorage) Put(bucket, key string, body io.Reader, contentType string) error {
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+
+	if _, err := s.client.PutObject(context.TODO(), input); err != nil {
+		return fmt.Errorf("failed to upload file to %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
+
+func (s *S3ObjectStorage) Copy(srcBucket, srcKey, dstBucket, dstKey string) error {
+	_, err := s.client.CopyObject(context.TODO(), &s3.CopyObjectInput{
+		Bucket:     aws.String(dstBucket),
+		CopySource: aws.String(srcBucket + "/" + escapeObjectKey(srcKey)),
+		Key:        aws.String(dstKey),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy object %s/%s to %s/%s: %w", srcBucket, srcKey, dstBucket, dstKey, err)
+	}
+	return nil
+}
+
+func (s *S3ObjectStorage) Delete(bucket, key string) error {
+	_, err := s.client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object %s/%s: %w", bucket, key, err)
+	}
+	return nil
+}
+
+func (s *S3ObjectStorage) Get(bucket, key string) (io.ReadCloser, error) {
+	output, err := s.client.GetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, codes.ErrImgStorageObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get object %s/%s: %w", bucket, key, err)
+	}
+	return output.Body, nil
+}
+
+func (s *S3ObjectStorage) Presign(bucket, key string, expire time.Duration) (string, error) {
+	presignResult, err := s.presignClient.PresignGetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, func(options *s3.PresignOptions) {
+		options.Expires = expire
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to presign object %s/%s: %w", bucket, key, err)
+	}
+	return presignResult.URL, nil
+}
+
+func (s *S3ObjectStorage) PresignPut(bucket, key, contentType string, size int64, expire time.Duration) (string, error) {
+	presignResult, err := s.presignClient.PresignPutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(key),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+	}, func(options *s3.PresignOptions) {
+		options.Expires = expire
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to presign put object %s/%s: %w", bucket, key, err)
+	}
+	return presignResult.URL, nil
+}
+
+func (s *S3ObjectStorage) Stat(bucket, key string) (*domain.ObjectInfo, error) {
+	output, err := s.client.HeadObject(context.TODO(), &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, codes.ErrImgStorageObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to head object %s/%s: %w", bucket, key, err)
+	}
+
+	return &domain.ObjectInfo{
+		Key:          key,
+		Size:         aws.ToInt64(output.ContentLength),
+		LastModified: aws.ToTime(output.LastModified),
+	}, nil
+}
+
+func (s *S3ObjectStorage) List(bucket, prefix, token string, limit int) (*domain.ObjectList, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if token != "" {
+		input.ContinuationToken = aws.String(token)
+	}
+	if limit > 0 {
+		input.MaxKeys = aws.Int32(int32(limit))
+	}
+
+	output, err := s.client.ListObjectsV2(context.TODO(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects in bucket %s: %w", bucket, err)
+	}
+
+	list := &domain.ObjectList{
+		Objects: make([]*domain.ObjectInfo, 0, len(output.Contents)),
+	}
+	for _, object := range output.Contents {
+		list.Objects = append(list.Objects, &domain.ObjectInfo{
+			Key:          aws.ToString(object.Key),
+			Size:         aws.ToInt64(object.Size),
+			LastModified: aws.ToTime(object.LastModified),
+		})
+	}
+	if aws.ToBool(output.IsTruncated) {
+		list.NextToken = aws.ToString(output.NextContinuationToken)
+	}
+
+	return list, nil
+}
+
+// escapeObjectKey CopySource 需要对 key 做 url 编码 但保留路径分隔符
+func escapeObjectKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	svgNamespace   = "http://www.w3.org/2000/svg"
+	xlinkNamespace = "http://www.w3.org/1999/xlink"
+	xmlNamespace   = "http://www.w3.org/XML/1998/namespace"
+)
+
+// svgAllowedElements 保留的 SVG 元素 script、foreignObject、动画等可执行或嵌入外部文档的元素连同子树一并移除
+var svgAllowedElements = map[string]bool{
+	"svg": true, "g": true, "defs": true, "symbol": true, "use": true, "a": true,
+	"title": true, "desc": true, "style": true,
+	"path": true, "rect": true, "circle": true, "ellipse": true, "line": true, "polyline": true, "polygon": true,
+	"text": true, "tspan": true, "textPath": true,
+	"linearGradient": true, "radialGradient": true, "stop": true,
+	"pattern": true, "clipPath": true, "mask": true, "marker": true, "image": true,
+	"filter": true, "feBlend": true, "feColorMatrix": true, "feComponentTransfer": true, "feComposite": true,
+	"feConvolveMatrix": true, "feDiffuseLighting": true, "feDisplacementMap": true, "feDistantLight": true,
+	"feDropShadow": true, "feFlood": true, "feFuncA": true, "feFuncB": true, "feFuncG": true, "feFuncR": true,
+	"feGaussianBlur": true, "feImage": true, "feMerge": true, "feMergeNode": true, "feMorphology": true,
+	"feOffset": true, "fePointLight": true, "feSpecularLighting": true, "feSpotLight": true,
+	"feTile": true, "feTurbulence": true,
+}
+
+// svgAllowedDataImages image 与 feImage 可内嵌的位图 内嵌 svg 不被允许
+var svgAllowedDataImages = []string{"data:image/png;", "data:image/jpeg;", "data:image/gif;", "data:image/webp;"}
+
+// sanitizeSVG 按白名单重新序列化 SVG 公共桶中的 SVG 会被浏览器直接渲染 须移除脚本
+// 丢弃事件属性、指向文档外的链接、注释、处理指令(含 xml-stylesheet)与 DOCTYPE 未声明的实体引用视为无效文件
+func sanitizeSVG(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	output := &bytes.Buffer{}
+	// open 已输出且未闭合的元素 skip 正在移除的子树深度
+	var open []string
+	skip := 0
+	closed := false
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skip > 0 {
+				skip++
+				continue
+			}
+			if closed {
+				return nil, errors.New("svg: content after root element")
+			}
+			allowed := (t.Name.Space == "" || t.Name.Space == svgNamespace) && svgAllowedElements[t.Name.Local]
+			if len(open) == 0 && (!allowed || t.Name.Local != "svg") {
+				return nil, errors.Errorf("svg: unexpected root element %q", t.Name.Local)
+			}
+			if !allowed {
+				skip = 1
+				continue
+			}
+			writeSVGStartElement(output, t, len(open) == 0)
+			open = append(open, t.Name.Local)
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			output.WriteString("</" + open[len(open)-1] + ">")
+			open = open[:len(open)-1]
+			closed = len(open) == 0
+		case xml.CharData:
+			if skip > 0 || len(open) == 0 {
+				continue
+			}
+			if err := xml.EscapeText(output, t); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	if !closed {
+		return nil, errors.New("svg: missing root element")
+	}
+
+	return output.Bytes(), nil
+}
+
+func writeSVGStartElement(output *bytes.Buffer, element xml.StartElement, root bool) {
+	output.WriteString("<" + element.Name.Local)
+	// 命名空间声明统一写在根元素上 文件中的声明全部丢弃 避免切换到 XHTML 命名空间
+	if root {
+		output.WriteString(` xmlns="` + svgNamespace + `" xmlns:xlink="` + xlinkNamespace + `"`)
+	}
+
+	for _, attr := range element.Attr {
+		name, ok := svgAttrName(element.Name.Local, attr)
+		if !ok {
+			continue
+		}
+		output.WriteString(" " + name + `="`)
+		xml.EscapeText(output, []byte(attr.Value))
+		output.WriteString(`"`)
+	}
+	output.WriteString(">")
+}
+
+// svgAttrName 返回属性输出时的名称 不允许的属性返回 false
+func svgAttrName(element string, attr xml.Attr) (string, bool) {
+	switch attr.Name.Space {
+	case "":
+		local := strings.ToLower(attr.Name.Local)
+		if local == "xmlns" || strings.HasPrefix(local, "on") {
+			return "", false
+		}
+		if local == "href" && !svgAllowedHref(element, attr.Value) {
+			return "", false
+		}
+		return attr.Name.Local, true
+	case xlinkNamespace:
+		if attr.Name.Local != "href" || !svgAllowedHref(element, attr.Value) {
+			return "", false
+		}
+		return "xlink:href", true
+	case xmlNamespace:
+		if attr.Name.Local != "space" && attr.Name.Local != "lang" {
+			return "", false
+		}
+		return "xml:" + attr.Name.Local, true
+	default:
+		return "", false
+	}
+}
+
+// svgAllowedHref 仅允许指向文档内的片段 image 与 feImage 另可内嵌位图
+func svgAllowedHref(element, href string) bool {
+	href = strings.ToLower(strings.TrimSpace(href))
+	if strings.HasPrefix(href, "#") {
+		return true
+	}
+	if element != "image" && element != "feImage" {
+		return false
+	}
+	for _, prefix := range svgAllowedDataImages {
+		if strings.HasPrefix(href, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeSVGRemovesScripts(t *testing.T) {
+	src := `<?xml version="1.0"?>
+<?xml-stylesheet href="http://evil.example/x.xsl" type="text/xsl"?>
+<!DOCTYPE svg>
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:h="http://www.w3.org/1999/xhtml" width="10" height="10" onload="alert(1)">
+	<!-- comment -->
+	<script>alert(2)</script>
+	<foreignObject><h:script>alert(3)</h:script></foreignObject>
+	<h:iframe src="javascript:alert(4)"/>
+	<a href="javascript:alert(5)"><text OnClick="alert(6)">hi</text></a>
+	<a xlink:href="#shape"><rect id="shape" width="5" height="5" fill="red"/></a>
+	<use xlink:href="http://evil.example/sprite.svg#x"/>
+	<animate attributeName="href" values="javascript:alert(7)"/>
+	<set attributeName="onclick" to="alert(8)"/>
+	<image href="data:image/svg+xml;base64,PHN2Zz48L3N2Zz4="/>
+	<image href="data:image/png;base64,iVBORw0KGgo="/>
+	<g xmlns="http://www.w3.org/1999/xhtml"><script>alert(9)</script></g>
+	<style>rect { fill: blue; } &lt;/style&gt;&lt;script&gt;</style>
+</svg>`
+
+	out, err := sanitizeSVG([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(out)
+
+	for _, banned := range []string{"alert", "javascript", "evil.example", "<script", "foreignObject", "iframe", "animate", "<set", "onload", "OnClick", "<!--", "<?", "DOCTYPE", "xhtml", "image/svg+xml"} {
+		if strings.Contains(got, banned) {
+			t.Errorf("sanitized svg still contains %q:\n%s", banned, got)
+		}
+	}
+	for _, kept := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="10" height="10">`,
+		`<a xlink:href="#shape"><rect id="shape" width="5" height="5" fill="red"></rect></a>`,
+		`<image href="data:image/png;base64,iVBORw0KGgo="></image>`,
+		`<text>hi</text>`,
+		`&lt;/style&gt;&lt;script&gt;`,
+	} {
+		if !strings.Contains(got, kept) {
+			t.Errorf("sanitized svg is missing %q:\n%s", kept, got)
+		}
+	}
+}
+
+func TestSanitizeSVGRejectsInvalid(t *testing.T) {
+	tests := map[string]string{
+		"html root":       `<html><svg xmlns="http://www.w3.org/2000/svg"></svg></html>`,
+		"script root":     `<script xmlns="http://www.w3.org/2000/svg">alert(1)</script>`,
+		"unknown entity":  `<!DOCTYPE svg [<!ENTITY x "y">]><svg xmlns="http://www.w3.org/2000/svg">&x;</svg>`,
+		"mismatched tags": `<svg xmlns="http://www.w3.org/2000/svg"><g></svg></g>`,
+		"second root":     `<svg xmlns="http://www.w3.org/2000/svg"></svg><script>alert(1)</script>`,
+		"unclosed":        `<svg xmlns="http://www.w3.org/2000/svg"><g>`,
+	}
+
+	for name, src := range tests {
+		if _, err := sanitizeSVG([]byte(src)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// LocalTransformCache 图片变换结果的本地磁盘缓存 文件保存在 {root}/{tenant_id}/{img_path}/{key}
+type LocalTransformCache struct {
+	root string
+}
+
+// NewTransformCache 设置了 IMG_TRANSFORM_CACHE_DIR 时使用本地磁盘缓存
+// 未设置时返回 nil 由服务将变换结果缓存到租户公共桶
+func NewTransformCache() domain.TransformCache {
+	root := os.Getenv("IMG_TRANSFORM_CACHE_DIR")
+	if root == "" {
+		return nil
+	}
+	return &LocalTransformCache{root: root}
+}
+
+// dir 将图片路径映射为缓存目录 拒绝越出租户目录的路径
+func (c *LocalTransformCache) dir(tenantID domain.TenantID, imgPath string) (string, error) {
+	if imgPath == "" || path.IsAbs(imgPath) || strings.Contains(imgPath, `\`) {
+		return "", codes.ErrImgStorageObjectKeyInvalid
+	}
+	cleaned := path.Clean(imgPath)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", codes.ErrImgStorageObjectKeyInvalid
+	}
+
+	return filepath.Join(c.root, tenantID.String(), filepath.FromSlash(cleaned)), nil
+}
+
+func (c *LocalTransformCache) Get(tenantID domain.TenantID, imgPath, key string) ([]byte, error) {
+	dir, err := c.dir(tenantID, imgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, codes.ErrImgStorageObjectNotFound
+		}
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+func (c *LocalTransformCache) Put(tenantID domain.TenantID, imgPath, key string, data []byte) error {
+	dir, err := c.dir(tenantID, imgPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// 先写临时文件再重命名 避免并发请求读到写了一半的缓存
+	tmp, err := os.CreateTemp(dir, ".cache-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.WithStack(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.Rename(tmp.Name(), filepath.Join(dir, key)))
+}
+
+func (c *LocalTransformCache) Purge(tenantID domain.TenantID, imgPath string) error {
+	dir, err := c.dir(tenantID, imgPath)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(os.RemoveAll(dir))
+}
@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// AlbumPositionGap 相册图片位置的间隔 预留空间以便插入时无需移动其他图片
+const AlbumPositionGap int64 = 1 << 16
+
+// Album 相册 有序引用任意分类下的图片 与图片多对多关联
+type Album struct {
+	ID          AlbumID
+	TenantID    TenantID
+	Title       string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// AlbumItem 相册中的图片 按 Position 升序排列
+type AlbumItem struct {
+	AlbumID  AlbumID
+	ImgID    ImgID
+	Position int64
+	AddedAt  time.Time
+}
+
+func (item *AlbumItem) GetID() string {
+	return item.ImgID.String()
+}
+
+func (item *AlbumItem) GetCursorPrimary() int64 {
+	return item.Position
+}
+
+type ListAlbumImgsQuery struct {
+	TenantID   TenantID
+	AlbumID    AlbumID
+	PrevCursor string
+	NextCursor string
+	PageSize   int
+}
@@ -0,0 +1,33 @@
+package domain
+
+// BulkAction 批量操作类型
+type BulkAction string
+
+const (
+	// BulkActionDelete 软删除 移入回收站
+	BulkActionDelete BulkAction = "delete"
+	// BulkActionHardDelete 直接删除 不进入回收站
+	BulkActionHardDelete BulkAction = "hard_delete"
+	// BulkActionRestore 从回收站恢复
+	BulkActionRestore BulkAction = "restore"
+	// BulkActionClear 移除回收站中的图片
+	BulkActionClear BulkAction = "clear"
+	// BulkActionMove 移动到其他分类 CategoryID 为空表示移出分类
+	BulkActionMove BulkAction = "move"
+)
+
+// MaxBulkImgs 单次批量操作的图片数上限
+const MaxBulkImgs = 100
+
+type BulkOperation struct {
+	TenantID   TenantID
+	Action     BulkAction
+	ImgIDs     []ImgID
+	CategoryID CategoryID
+}
+
+// BulkResult 单张图片的操作结果 Err 为空表示成功
+type BulkResult struct {
+	ImgID ImgID
+	Err   error
+}
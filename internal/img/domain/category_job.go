@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+type CategoryJobID string
+
+func (c CategoryJobID) String() string {
+	return string(c)
+}
+
+// JobStatus 后台任务状态
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+func (s JobStatus) IsActive() bool {
+	return s == JobStatusPending || s == JobStatusRunning
+}
+
+// CategoryJobKind 分类迁移任务类型
+type CategoryJobKind string
+
+const (
+	// CategoryJobKindRename 分类前缀已修改 将图片迁移到新前缀下
+	CategoryJobKindRename CategoryJobKind = "rename"
+	// CategoryJobKindDeleteMove 将图片移动到目标分类后删除分类
+	CategoryJobKindDeleteMove CategoryJobKind = "delete_move"
+)
+
+// CategoryJob 分类迁移任务 逐张迁移图片(含回收站中的图片) 中断或失败后可从剩余图片继续
+type CategoryJob struct {
+	ID         CategoryJobID
+	TenantID   TenantID
+	CategoryID CategoryID
+	Kind       CategoryJobKind
+	// TargetCategoryID delete_move 的目标分类 为空表示移出分类
+	TargetCategoryID CategoryID
+	Status           JobStatus
+	Total            int
+	Processed        int
+	Failed           int
+	LastError        string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	FinishedAt       time.Time
+}
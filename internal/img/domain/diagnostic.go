@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// StorageProbePrefix 连接测试时写入的探测对象前缀 测试结束即删除
+const StorageProbePrefix = "_probe/"
+
+type StorageCheckName string
+
+const (
+	// StorageCheckClient 根据配置构建客户端
+	StorageCheckClient    StorageCheckName = "client"
+	StorageCheckWrite     StorageCheckName = "write"
+	StorageCheckRead      StorageCheckName = "read"
+	StorageCheckDelete    StorageCheckName = "delete"
+	StorageCheckPublicURL StorageCheckName = "public_url"
+)
+
+type StorageCheckStatus string
+
+const (
+	StorageCheckPassed StorageCheckStatus = "passed"
+	StorageCheckFailed StorageCheckStatus = "failed"
+	// StorageCheckSkipped 前置检查失败或该存储类型不适用
+	StorageCheckSkipped StorageCheckStatus = "skipped"
+)
+
+type StorageCheck struct {
+	Name StorageCheckName
+	// Bucket 构建客户端时为空
+	Bucket   string
+	Status   StorageCheckStatus
+	Error    string
+	Duration time.Duration
+}
+
+// StorageDiagnosticReport 存储连接测试报告 各项检查按执行顺序排列
+type StorageDiagnosticReport struct {
+	Provider StorageProvider
+	// Stored 是否测试的已保存配置
+	Stored    bool
+	Checks    []*StorageCheck
+	CheckedAt time.Time
+}
+
+// OK 全部检查通过或跳过
+func (r *StorageDiagnosticReport) OK() bool {
+	for _, check := range r.Checks {
+		if check.Status == StorageCheckFailed {
+			return false
+		}
+	}
+	return true
+}
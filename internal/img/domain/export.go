@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+type ExportJobID string
+
+func (e ExportJobID) String() string {
+	return string(e)
+}
+
+const (
+	// MaxExportImgs 单个导出任务的图片上限
+	MaxExportImgs = 10000
+	// ExportArchiveTTL 压缩包的保留时间 过期后删除
+	ExportArchiveTTL = 24 * time.Hour
+	// ExportDownloadURLExpire 下载链接的有效期 不超过压缩包的剩余保留时间
+	ExportDownloadURLExpire = time.Hour
+	// ExportStagingPrefix 压缩包在租户回收站桶中的暂存前缀
+	ExportStagingPrefix = "_export/"
+	// ExportManifestName 压缩包内元数据清单的文件名
+	ExportManifestName = "manifest.json"
+	// ExportImgDir ExportRecycleDir 压缩包内图库与回收站图片的目录
+	ExportImgDir     = "images/"
+	ExportRecycleDir = "recycle/"
+)
+
+// ExportJob 图片导出任务 打包为 ZIP 暂存在回收站桶 中断后重新打包
+type ExportJob struct {
+	ID       ExportJobID
+	TenantID TenantID
+	// CategoryID 为空表示不按分类筛选
+	CategoryID           CategoryID
+	IncludeSubcategories bool
+	// TagIDs 须同时带有全部标签
+	TagIDs []TagID
+	// IncludeDeleted 是否包含回收站中的图片
+	IncludeDeleted bool
+	Status         JobStatus
+	Total          int
+	Exported       int
+	// Missing 存储对象缺失的图片数量 仅记录在清单中
+	Missing    int
+	Size       int64
+	ArchiveKey string
+	LastError  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+	ExpiresAt  time.Time
+	// DownloadURL 压缩包的预签名下载链接 仅在导出完成且未过期时返回
+	DownloadURL string
+}
+
+// IsDownloadable 导出完成且压缩包尚未过期
+func (job *ExportJob) IsDownloadable(now time.Time) bool {
+	return job.Status == JobStatusSucceeded && job.ArchiveKey != "" && now.Before(job.ExpiresAt)
+}
+
+// ExportManifest 压缩包内的元数据清单
+type ExportManifest struct {
+	JobID                ExportJobID           `json:"job_id"`
+	TenantID             TenantID              `json:"tenant_id"`
+	CategoryID           CategoryID            `json:"category_id,omitempty"`
+	IncludeSubcategories bool                  `json:"include_subcategories"`
+	TagIDs               []TagID               `json:"tag_ids,omitempty"`
+	IncludeDeleted       bool                  `json:"include_deleted"`
+	ExportedAt           time.Time             `json:"exported_at"`
+	Images               []*ExportManifestItem `json:"images"`
+}
+
+type ExportManifestItem struct {
+	ID ImgID `json:"id"`
+	// File 压缩包内的文件路径 存储对象缺失时为空
+	File             string     `json:"file,omitempty"`
+	Path             string     `json:"path"`
+	CategoryID       CategoryID `json:"category_id,omitempty"`
+	Description      string     `json:"description,omitempty"`
+	OriginalFilename string     `json:"original_filename,omitempty"`
+	Width            int        `json:"width,omitempty"`
+	Height           int        `json:"height,omitempty"`
+	Size             int64      `json:"size"`
+	MimeType         string     `json:"mime_type,omitempty"`
+	ContentHash      string     `json:"content_hash,omitempty"`
+	Tags             []string   `json:"tags,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+	Missing          bool       `json:"missing,omitempty"`
+}
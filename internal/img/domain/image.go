@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"slices"
+)
+
+type ImageFormat string
+
+func (f ImageFormat) String() string {
+	return string(f)
+}
+
+const (
+	ImageFormatUnknown ImageFormat = ""
+	ImageFormatJPEG    ImageFormat = "jpeg"
+	ImageFormatPNG     ImageFormat = "png"
+	ImageFormatGIF     ImageFormat = "gif"
+	ImageFormatWebP    ImageFormat = "webp"
+	ImageFormatAVIF    ImageFormat = "avif"
+	ImageFormatBMP     ImageFormat = "bmp"
+	ImageFormatSVG     ImageFormat = "svg"
+)
+
+func (f ImageFormat) Ext() string {
+	switch f {
+	case ImageFormatJPEG:
+		return ".jpg"
+	case ImageFormatUnknown:
+		return ""
+	default:
+		return "." + string(f)
+	}
+}
+
+func (f ImageFormat) ContentType() string {
+	switch f {
+	case ImageFormatSVG:
+		return "image/svg+xml"
+	case ImageFormatUnknown:
+		return "application/octet-stream"
+	default:
+		return "image/" + string(f)
+	}
+}
+
+// ImageFormatFromContentType 根据 MIME 类型识别图片格式 无法识别时返回 ImageFormatUnknown
+func ImageFormatFromContentType(contentType string) ImageFormat {
+	if contentType == "image/jpg" {
+		return ImageFormatJPEG
+	}
+	for _, f := range []ImageFormat{
+		ImageFormatJPEG,
+		ImageFormatPNG,
+		ImageFormatGIF,
+		ImageFormatWebP,
+		ImageFormatAVIF,
+		ImageFormatBMP,
+		ImageFormatSVG,
+	} {
+		if f.ContentType() == contentType {
+			return f
+		}
+	}
+	return ImageFormatUnknown
+}
+
+// DetectImageFormat 根据文件头识别图片格式
+// http.DetectContentType 无法识别 avif 与 svg 需单独处理
+func DetectImageFormat(head []byte) ImageFormat {
+	switch http.DetectContentType(head) {
+	case "image/jpeg":
+		return ImageFormatJPEG
+	case "image/png":
+		return ImageFormatPNG
+	case "image/gif":
+		return ImageFormatGIF
+	case "image/webp":
+		return ImageFormatWebP
+	case "image/bmp":
+		return ImageFormatBMP
+	case "image/avif":
+		return ImageFormatAVIF
+	}
+
+	// ISO BMFF: 4字节长度 + "ftyp" + 主品牌
+	if len(head) >= 12 && string(head[4:8]) == "ftyp" {
+		brand := string(head[8:12])
+		if brand == "avif" || brand == "avis" {
+			return ImageFormatAVIF
+		}
+	}
+
+	if bytes.Contains(bytes.ToLower(head), []byte("<svg")) {
+		return ImageFormatSVG
+	}
+
+	return ImageFormatUnknown
+}
+
+// OutputFormat 上传时的目标编码格式
+type OutputFormat string
+
+const (
+	// OutputFormatOriginal 保持原格式 仅做同格式压缩
+	OutputFormatOriginal OutputFormat = "original"
+	OutputFormatWebP     OutputFormat = "webp"
+	OutputFormatJPEG     OutputFormat = "jpeg"
+)
+
+var AllOutputFormats = []OutputFormat{
+	OutputFormatOriginal,
+	OutputFormatWebP,
+	OutputFormatJPEG,
+}
+
+func (f OutputFormat) IsValid() bool {
+	return slices.Contains(AllOutputFormats, f)
+}
+
+const (
+	DefaultOutputFormat = OutputFormatOriginal
+	DefaultQuality      = 75
+)
+
+// DedupMode 上传内容与已有图片重复时的处理方式
+type DedupMode string
+
+const (
+	DedupModeOff DedupMode = "off"
+	// DedupModeReuse 直接返回已有图片 不创建新记录
+	DedupModeReuse DedupMode = "reuse"
+	// DedupModeLink 以新路径创建记录 与已有图片共享存储对象
+	DedupModeLink DedupMode = "link"
+)
+
+var AllDedupModes = []DedupMode{
+	DedupModeOff,
+	DedupModeReuse,
+	DedupModeLink,
+}
+
+func (m DedupMode) IsValid() bool {
+	return slices.Contains(AllDedupModes, m)
+}
+
+const DefaultDedupMode = DedupModeReuse
+
+// DefaultVariantWidths 默认生成的响应式宽度
+var DefaultVariantWidths = []int{160, 480, 1280}
+
+// ImgSetting 租户级图片处理配置
+type ImgSetting struct {
+	TenantID     TenantID
+	OutputFormat OutputFormat
+	// Quality 仅对有损编码(JPEG)生效
+	Quality int
+	// VariantWidths 上传时生成的缩放宽度 为空则不生成
+	VariantWidths []int
+	DedupMode     DedupMode
+}
+
+func DefaultImgSetting(tenantID TenantID) *ImgSetting {
+	return &ImgSetting{
+		TenantID:      tenantID,
+		OutputFormat:  DefaultOutputFormat,
+		Quality:       DefaultQuality,
+		VariantWidths: slices.Clone(DefaultVariantWidths),
+		DedupMode:     DefaultDedupMode,
+	}
+}
+
+type ProcessOptions struct {
+	Target  OutputFormat
+	Quality int
+}
+
+type ProcessedImage struct {
+	Data   []byte
+	Format ImageFormat
+	// Width Height 对无法解码的格式(svg/avif)为0
+	Width    int
+	Height   int
+	Animated bool
+}
+
+// Resizable 矢量图、无法解码的格式与动图不生成缩放版本
+func (p *ProcessedImage) Resizable() bool {
+	return p.Width > 0 && p.Height > 0 && !p.Animated &&
+		p.Format != ImageFormatSVG && p.Format != ImageFormatAVIF
+}
+
+// ImageProcessor 图片处理管线 识别格式并按目标格式编码
+// 动图与透明通道不会因目标格式而丢失 无法满足时保持原格式
+type ImageProcessor interface {
+	Process(src io.Reader, opts *ProcessOptions) (*ProcessedImage, error)
+	// Resize 按宽度等比缩放 调用方需保证 src.Resizable() 且 width 小于原图宽度
+	Resize(src *ProcessedImage, width int, quality int) (*ProcessedImage, error)
+	// Transform 按 URL 参数缩放、裁剪与转换格式 动图仅取首帧
+	Transform(src []byte, opts *TransformOptions) (*ProcessedImage, error)
+	// Watermark 叠加文字或图片水印 调用方需保证 src.Resizable()
+	Watermark(src *ProcessedImage, opts *WatermarkOptions) (*ProcessedImage, error)
+	// Probe 识别格式与尺寸 不重新编码 无法解码的格式(svg/avif)尺寸为0
+	Probe(src []byte) (*ProcessedImage, error)
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+type ImportJobID string
+
+func (i ImportJobID) String() string {
+	return string(i)
+}
+
+// ImportSource 导入来源
+type ImportSource string
+
+const (
+	ImportSourceURLs ImportSource = "urls"
+	ImportSourceZip  ImportSource = "zip"
+)
+
+const (
+	// MaxImportItems 单个导入任务的文件上限
+	MaxImportItems = 500
+	// MaxImportFileSize 单个文件的大小上限 与直传一致
+	MaxImportFileSize = MaxDirectUploadSize
+	// MaxImportArchiveSize 压缩包的大小上限
+	MaxImportArchiveSize = 500 << 20
+	// ImportArchiveRetention 失败任务的压缩包保留时间 期间可重试 过期后删除
+	ImportArchiveRetention = 7 * 24 * time.Hour
+	// ImportStagingPrefix 压缩包在租户回收站桶中的暂存前缀
+	ImportStagingPrefix = "_import/"
+)
+
+// ImportJob 图片导入任务 逐个文件走普通上传流程 已处理的文件不再重复导入
+type ImportJob struct {
+	ID         ImportJobID
+	TenantID   TenantID
+	CategoryID CategoryID
+	Source     ImportSource
+	// ArchiveKey 压缩包的暂存路径 仅 zip 来源
+	ArchiveKey string
+	Status     JobStatus
+	Total      int
+	Succeeded  int
+	Failed     int
+	LastError  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time
+	Items      []*ImportItem
+}
+
+// ImportItem 导入任务中单个文件的结果 Status 不使用 running
+type ImportItem struct {
+	ID    string
+	JobID ImportJobID
+	Seq   int
+	// Source URL 或压缩包内的文件路径
+	Source    string
+	Status    JobStatus
+	ImgID     ImgID
+	ErrorCode int
+	Error     string
+	UpdatedAt time.Time
+}
+
+// FetchedFile 下载得到的远程文件
+type FetchedFile struct {
+	Data []byte
+	// Filename 优先取自 Content-Disposition 其次为 URL 路径的最后一段
+	Filename string
+}
+
+// ImageFetcher 下载远程文件 仅允许 http/https
+// 超过 maxSize 时返回 codes.ErrImgImportFileTooLarge
+type ImageFetcher interface {
+	Fetch(rawURL string, maxSize int64) (*FetchedFile, error)
+}
+
+// IsIgnoredArchiveEntry 压缩包中的目录、隐藏文件与系统生成的元数据不参与导入
+func IsIgnoredArchiveEntry(name string) bool {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if strings.HasSuffix(name, "/") || strings.HasPrefix(name, "__MACOSX/") {
+		return true
+	}
+	return strings.HasPrefix(path.Base(name), ".")
+}
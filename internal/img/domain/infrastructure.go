@@ -1,35 +1,159 @@
 package domain
 
+import "time"
+
 type ImgRepository interface {
 	FindByID(tenantID TenantID, imgID ImgID, deleted ...bool) (*Img, error)
 	ExistByPath(tenantID TenantID, path string) (bool, error)
+	// FindByContentHash 未找到时返回 codes.ErrImgNotFound
+	FindByContentHash(tenantID TenantID, hash string) (*Img, error)
+	CountObjectRefs(tenantID TenantID, objectPath string, deleted bool, excludeID ImgID) (int64, error)
 
 	Create(img *Img, categoryID CategoryID) (*Img, error)
-	Delete(tenantID TenantID, imgID ImgID, hard bool) error
-	Restore(tenantID TenantID, imgID ImgID) (*Img, error)
+	// Delete、Restore 与 UpdateImgLocation 携带图片锁的 fencing token
+	// 令牌小于记录上已提交的令牌时不写入 返回 codes.ErrImgLockLost
+	Delete(tenantID TenantID, imgID ImgID, hard bool, token FencingToken) error
+	Restore(tenantID TenantID, imgID ImgID, token FencingToken) (*Img, error)
 	ListByKeyset(query *ListByKeysetQuery) (*ListByKeysetResult, error)
 
+	// UpdateImgLocation 更新图片的分类、路径与存储对象路径 以及缩放版本的路径
+	UpdateImgLocation(img *Img, token FencingToken) error
+	// AllImgs 租户的全部图片 含已软删除的记录
+	AllImgs(tenantID TenantID) ([]*Img, error)
+
+	CreateVariants(variants []*ImgVariant) error
+	ListVariants(imgIDs ...ImgID) ([]*ImgVariant, error)
+	DeleteVariants(imgID ImgID, paths []string) error
+
 	CreateCategory(category *Category) error
 	UpdateCategory(category *Category) error
 	DeleteCategory(tenantID TenantID, categoryID CategoryID) error
 	AllCategories(tenantID TenantID) ([]*Category, error)
 	FindCategoryByID(tenantID TenantID, categoryID CategoryID) (*Category, error)
-	FindCategoryByTitle(tenantID TenantID, title string) (*Category, error)
-	CategoryExistByTitle(tenantID TenantID, title string) (bool, error)
+	// FindCategoryByTitle 同一父分类下名称唯一 parentID 为空表示顶级分类
+	FindCategoryByTitle(tenantID TenantID, parentID CategoryID, title string) (*Category, error)
+	CategoryExistByTitle(tenantID TenantID, parentID CategoryID, title string) (bool, error)
 	CategoryExistByID(tenantID TenantID, categoryID CategoryID) (bool, error)
 	CountCategory(tenantID TenantID) (int64, error)
 	IsCategoryExistImg(tenantID TenantID, categoryID CategoryID) (bool, error)
+	CategoryExistChildren(tenantID TenantID, categoryID CategoryID) (bool, error)
+	// MaxSubtreeDepth 分类及其全部子孙分类中最大的层级
+	MaxSubtreeDepth(category *Category) (int, error)
+	// MoveCategory 更新分类的父分类 子孙分类的物化路径与层级随之调整
+	MoveCategory(category *Category, oldSubtreePath string, depthDelta int) error
+
+	// ListImgsByCategory 分类下的全部图片 含已软删除的记录
+	ListImgsByCategory(tenantID TenantID, categoryID CategoryID) ([]*Img, error)
+	CreateCategoryJob(job *CategoryJob) error
+	FindCategoryJob(tenantID TenantID, jobID CategoryJobID) (*CategoryJob, error)
+	ListCategoryJobs(tenantID TenantID, limit int) ([]*CategoryJob, error)
+	// ExistActiveCategoryJob 分类作为来源或目标时是否有未完成的迁移任务
+	ExistActiveCategoryJob(tenantID TenantID, categoryID CategoryID) (bool, error)
+	// ClaimCategoryJobs 认领待执行或心跳早于 staleBefore 的任务
+	ClaimCategoryJobs(staleBefore time.Time, limit int) ([]*CategoryJob, error)
+	UpdateCategoryJob(job *CategoryJob) error
+
+	// CreateImportJob 同时创建 job.Items
+	CreateImportJob(job *ImportJob) error
+	// CreateImportItems 追加任务的文件并累加文件总数
+	CreateImportItems(job *ImportJob, items []*ImportItem) error
+	FindImportJob(tenantID TenantID, jobID ImportJobID) (*ImportJob, error)
+	ListImportJobs(tenantID TenantID, limit int) ([]*ImportJob, error)
+	ListImportItems(jobID ImportJobID) ([]*ImportItem, error)
+	// ClaimImportJobs 认领待执行或心跳早于 staleBefore 的任务
+	ClaimImportJobs(staleBefore time.Time, limit int) ([]*ImportJob, error)
+	UpdateImportJob(job *ImportJob) error
+	UpdateImportItem(item *ImportItem) error
+	ResetFailedImportItems(jobID ImportJobID) (int64, error)
+	// ListExpiredImportJobs 结束早于 before 仍保留压缩包的失败任务
+	ListExpiredImportJobs(before time.Time, limit int) ([]*ImportJob, error)
+	// ClearExpiredImportArchive 任务仍为结束早于 before 的失败任务时清空压缩包路径 期间已被重试时不修改
+	ClearExpiredImportArchive(jobID ImportJobID, before time.Time) error
+
+	CreateExportJob(job *ExportJob) error
+	FindExportJob(tenantID TenantID, jobID ExportJobID) (*ExportJob, error)
+	ListExportJobs(tenantID TenantID, limit int) ([]*ExportJob, error)
+	// ClaimExportJobs 认领待执行或心跳早于 staleBefore 的任务
+	ClaimExportJobs(staleBefore time.Time, limit int) ([]*ExportJob, error)
+	UpdateExportJob(job *ExportJob) error
+	// ListExpiredExportJobs 压缩包已过期但尚未删除的任务
+	ListExpiredExportJobs(before time.Time, limit int) ([]*ExportJob, error)
+	// ListExportImgs 按任务的筛选条件列出图片 按创建时间升序 最多返回 limit 张
+	ListExportImgs(job *ExportJob, limit int) ([]*Img, error)
+
+	CreateAlbum(album *Album) error
+	UpdateAlbum(album *Album) error
+	DeleteAlbum(tenantID TenantID, albumID AlbumID) error
+	FindAlbum(tenantID TenantID, albumID AlbumID) (*Album, error)
+	FindAlbumByTitle(tenantID TenantID, title string) (*Album, error)
+	AllAlbums(tenantID TenantID) ([]*Album, error)
+	CountAlbums(tenantID TenantID) (int64, error)
+	CountAlbumImgs(albumID AlbumID) (int64, error)
+	// CountImgsByIDs 统计租户下未删除的图片数量
+	CountImgsByIDs(tenantID TenantID, imgIDs ...ImgID) (int64, error)
+	// AddAlbumImgs 按顺序追加到相册末尾 已在相册中的图片保持原位置
+	AddAlbumImgs(albumID AlbumID, imgIDs []ImgID) error
+	RemoveAlbumImgs(albumID AlbumID, imgIDs []ImgID) error
+	// MoveAlbumImg 将图片移动到 beforeImgID 之前 beforeImgID 为空表示移动到末尾
+	MoveAlbumImg(albumID AlbumID, imgID, beforeImgID ImgID) error
+	ListAlbumImgs(query *ListAlbumImgsQuery) (*ListByKeysetResult, error)
 
-	ExistTenantR2Config(tenantID TenantID) (bool, error)
-	SetTenantR2Config(config *R2Config) error
-	GetTenantR2Config(tenantID TenantID) (*R2Config, error)
+	CreateTag(tag *Tag) error
+	DeleteTag(tenantID TenantID, tagID TagID) error
+	AllTags(tenantID TenantID) ([]*Tag, error)
+	TagExistByName(tenantID TenantID, name string) (bool, error)
+	CountTags(tenantID TenantID, tagIDs ...TagID) (int64, error)
+	SetImgTags(imgID ImgID, tagIDs []TagID) error
+	ListImgTags(imgIDs ...ImgID) (map[ImgID][]*Tag, error)
 
-	SetR2SecretKey(tenantID TenantID, secretKey R2SecretAccessKey) error
-	IsSetR2SecretKey(tenantID TenantID) (bool, error)
+	// AddStorageUsage 按变化量累加用量
+	AddStorageUsage(delta *StorageUsage) error
+	// ApplyReconciledStorageUsages 以校准结果更新租户的用量记录
+	// 校准期间累加的增量(当前值与 snapshot 之差)保留在结果上 不会被覆盖
+	ApplyReconciledStorageUsages(tenantID TenantID, reconciled []*StorageUsage, snapshot []*StorageUsage) error
+	ListStorageUsages(tenantID TenantID) ([]*StorageUsage, error)
+	AllStorageConfiguredTenants() ([]TenantID, error)
+
+	ExistTenantStorageConfig(tenantID TenantID) (bool, error)
+	SetTenantStorageConfig(config *StorageConfig) error
+	GetTenantStorageConfig(tenantID TenantID) (*StorageConfig, error)
+
+	SetStorageSecretKey(tenantID TenantID, secretKey StorageSecretAccessKey) error
+	IsSetStorageSecretKey(tenantID TenantID) (bool, error)
+
+	CreateStorageMigration(migration *StorageMigration) error
+	FindStorageMigration(tenantID TenantID, migrationID StorageMigrationID) (*StorageMigration, error)
+	ListStorageMigrations(tenantID TenantID, limit int) ([]*StorageMigration, error)
+	ExistActiveStorageMigration(tenantID TenantID) (bool, error)
+	// ClaimStorageMigrations 认领待执行或心跳早于 staleBefore 的任务
+	ClaimStorageMigrations(staleBefore time.Time, limit int) ([]*StorageMigration, error)
+	UpdateStorageMigration(migration *StorageMigration) error
+	// SwitchStorageConfig 在同一事务中将目标配置写入租户存储配置并记录切换时间
+	SwitchStorageConfig(migration *StorageMigration) error
+
+	GetTenantImgSetting(tenantID TenantID) (*ImgSetting, error)
+	SetTenantImgSetting(setting *ImgSetting) error
+
+	GetWatermarkSetting(tenantID TenantID) (*WatermarkSetting, error)
+	SetWatermarkSetting(setting *WatermarkSetting) error
 }
 
 type ImgMsgQueue interface {
 	AddToDeleteQueue(tenantID TenantID, imgID ImgID) error
-	ListenDeleteQueue(onExpire func(tenantID TenantID, imgID ImgID))
+	// ListenDeleteQueue 到期任务只会被一个实例认领 handler 返回错误时重试 超过上限移入死信
+	// 返回 codes.ErrImgStorageMigrating 时延后执行 不计入重试次数
+	ListenDeleteQueue(handler func(tenantID TenantID, imgID ImgID) error)
 	RemoveFromDeleteQueue(tenantID TenantID, imgID ImgID) error
+	ListDeleteDeadLetters(tenantID TenantID) ([]*DeleteDeadLetter, error)
+
+	SaveUploadSlot(slot *UploadSlot, expire time.Duration) error
+	// GetUploadSlot 不存在或已过期时返回 codes.ErrImgUploadSlotNotFound
+	GetUploadSlot(tenantID TenantID, slotID UploadSlotID) (*UploadSlot, error)
+	RemoveUploadSlot(tenantID TenantID, slotID UploadSlotID) error
+
+	// PublishStorageInvalidation 通知所有实例丢弃租户的存储客户端缓存
+	PublishStorageInvalidation(tenantID TenantID) error
+	// SubscribeStorageInvalidation 阻塞接收通知 断开后自动重连
+	// tenantID 为空表示订阅刚建立或重连 期间的通知可能丢失 应丢弃全部缓存
+	SubscribeStorageInvalidation(handler func(tenantID TenantID))
 }
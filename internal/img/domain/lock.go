@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// FencingToken 每次加锁递增且不小于当前时间的微秒数 持有者将其随写入一并提交 由存储方拒绝更小的令牌 防止锁过期后的旧持有者覆盖写入
+// 图片记录的令牌保存在 imgs.lock_token
+type FencingToken int64
+
+type Lock interface {
+	Token() FencingToken
+	// Unlock 锁已过期或被他人持有时返回错误 不影响他人持有的锁
+	Unlock() error
+}
+
+// Locker 跨实例的互斥锁 持有期间自动续期 进程崩溃后最迟 ttl 到期释放
+type Locker interface {
+	// Lock 阻塞等待 超过 wait 仍未获取时返回 codes.ErrImgLockTimeout
+	Lock(key string, ttl, wait time.Duration) (Lock, error)
+}
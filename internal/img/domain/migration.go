@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+type StorageMigrationID string
+
+func (m StorageMigrationID) String() string {
+	return string(m)
+}
+
+// StorageMigration 存储迁移任务 将当前存储中的全部对象复制到目标存储 校验通过后切换配置
+// 切换前失败会删除已复制到目标存储的对象 当前配置保持不变 原存储中的对象始终保留
+type StorageMigration struct {
+	ID       StorageMigrationID
+	TenantID TenantID
+	Status   JobStatus
+	// Target 目标存储配置 密钥为密文
+	Target     *StorageConfig
+	Total      int
+	Copied     int
+	Verified   int
+	LastError  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	SwitchedAt time.Time
+	FinishedAt time.Time
+}
+
+// IsSwitched 是否已切换到目标存储
+func (m *StorageMigration) IsSwitched() bool {
+	return !m.SwitchedAt.IsZero()
+}
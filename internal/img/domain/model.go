@@ -1,17 +1,35 @@
 package domain
 
 import (
+	"fmt"
+	"path"
+	"strings"
 	"time"
 )
 
 type Img struct {
-	ID           ImgID
-	TenantID     TenantID
-	Path         string
-	Description  string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	DeletedAt    time.Time
+	ID         ImgID
+	TenantID   TenantID
+	CategoryID CategoryID
+	Path       string
+	// ObjectPath 存储对象的路径 去重关联的图片与源图片共享同一对象 否则与 Path 相同
+	ObjectPath string
+	// ContentHash 处理后内容的 sha256 直传图片为上传内容的 sha256
+	ContentHash string
+	// Width Height 对无法解码的格式(svg/avif)与直传图片为0
+	Width            int
+	Height           int
+	Size             int64
+	MimeType         string
+	OriginalFilename string
+	Description      string
+	Tags             []*Tag
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        time.Time
+	Variants         []*ImgVariant
+	// Deduplicated 本次上传命中重复内容 直接返回了已有图片
+	Deduplicated bool
 	publicPreURL string
 }
 
@@ -41,6 +59,58 @@ func (img *Img) GetCursorPrimary() time.Time {
 	return img.CreatedAt
 }
 
+// ImgVariant 图片的响应式缩放版本 与原图存放在同一目录
+type ImgVariant struct {
+	ID        string
+	ImgID     ImgID
+	Width     int
+	Height    int
+	Path      string
+	Size      int64
+	CreatedAt time.Time
+}
+
+// VariantPath 缩放版本的存储路径 如 a/b.png -> a/b@480w.png
+// 用户路径为 slug 不含 @ 因此不会与原图路径冲突
+func VariantPath(originalPath string, width int, ext string) string {
+	base := strings.TrimSuffix(originalPath, path.Ext(originalPath))
+	return fmt.Sprintf("%s@%dw%s", base, width, ext)
+}
+
+// IsLinked 是否为共享其他图片存储对象的去重关联
+func (img *Img) IsLinked() bool {
+	return img.ObjectPath != img.Path
+}
+
+// ObjectPaths 原图及其全部缩放版本的存储路径
+func (img *Img) ObjectPaths() []string {
+	paths := make([]string, 0, len(img.Variants)+1)
+	paths = append(paths, img.ObjectPath)
+	for _, variant := range img.Variants {
+		paths = append(paths, variant.Path)
+	}
+	return paths
+}
+
+// DeleteDeadLetter 多次重试仍未能彻底删除的回收站图片
+type DeleteDeadLetter struct {
+	TenantID TenantID
+	ImgID    ImgID
+	Attempts int
+	Error    string
+	FailedAt time.Time
+}
+
+// ImgSort 图片列表排序方式
+type ImgSort string
+
+const (
+	ImgSortCreatedAtDesc ImgSort = "created_at_desc"
+	ImgSortCreatedAtAsc  ImgSort = "created_at_asc"
+	ImgSortSizeDesc      ImgSort = "size_desc"
+	ImgSortSizeAsc       ImgSort = "size_asc"
+)
+
 type ListByKeysetQuery struct {
 	TenantID   TenantID
 	CategoryID CategoryID
@@ -49,6 +119,19 @@ type ListByKeysetQuery struct {
 	Keyword    string
 	PageSize   int
 	Deleted    bool
+	// IncludeSubcategories 同时列出子孙分类下的图片
+	IncludeSubcategories bool
+	// TagIDs 须同时带有全部标签
+	TagIDs   []TagID
+	MimeType string
+	// MinSize MaxSize 为0表示不限制
+	MinSize int64
+	MaxSize int64
+	// CreatedFrom CreatedTo 为零值表示不限制
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Sort 为空时按创建时间倒序 切换排序方式后需从第一页开始
+	Sort ImgSort
 }
 
 type ListByKeysetResult struct {
@@ -59,17 +142,45 @@ type ListByKeysetResult struct {
 	HasNext    bool
 }
 
-type Category struct {
-	ID        CategoryID
+// Tag 租户内的图片标签 与图片多对多关联
+type Tag struct {
+	ID        TagID
 	TenantID  TenantID
-	Title     string
+	Name      string
+	CreatedAt time.Time
+}
+
+type Category struct {
+	ID       CategoryID
+	TenantID TenantID
+	// ParentID 为空表示顶级分类
+	ParentID CategoryID
+	// TreePath 祖先分类id组成的物化路径 如 /a/b/ 顶级分类为 /
+	TreePath string
+	Depth    int
+	Title    string
+	// Prefix 存储路径前缀 不随层级拼接
 	Prefix    string
 	CreatedAt time.Time
 }
 
-type R2Config struct {
+// SubtreePath 子孙分类的 TreePath 均以此开头
+func (c *Category) SubtreePath() string {
+	return c.TreePath + c.ID.String() + "/"
+}
+
+// IsAncestorOf 当前分类是否为 other 的祖先
+func (c *Category) IsAncestorOf(other *Category) bool {
+	return strings.HasPrefix(other.TreePath, c.SubtreePath())
+}
+
+type StorageConfig struct {
 	TenantID        TenantID
+	Provider        StorageProvider
 	AccountID       string
+	Endpoint        string
+	Region          string
+	UsePathStyle    bool
 	AccessKeyID     string
 	secretAccessKey string
 	PublicBucket    string
@@ -77,12 +188,17 @@ type R2Config struct {
 	DeleteBucket    string
 }
 
-func (r *R2Config) GetSecretAccessKey() string {
-	return r.secretAccessKey
+func (c *StorageConfig) GetSecretAccessKey() string {
+	return c.secretAccessKey
+}
+
+func (c *StorageConfig) SetSecretAccessKey(key string) {
+	c.secretAccessKey = key
 }
 
-func (r *R2Config) SetSecretAccessKey(key string) {
-	r.secretAccessKey = key
+// NeedCredentials 本地存储无需访问密钥
+func (c *StorageConfig) NeedCredentials() bool {
+	return c.Provider != StorageProviderLocal
 }
 
-type R2SecretAccessKey string
+type StorageSecretAccessKey string
@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// MaxReconcileReportItems 报告中每类问题最多列出的条数 计数不受限制
+const MaxReconcileReportItems = 1000
+
+// OrphanObject 桶中存在但没有图片记录引用的对象
+type OrphanObject struct {
+	Bucket       StorageBucketKind
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// DanglingImg 图片记录引用的对象在应在的桶中不存在
+// 图片未删除时应在公共桶 已软删除时应在回收站桶
+type DanglingImg struct {
+	ImgID  ImgID
+	Path   string
+	Bucket StorageBucketKind
+	// MissingOriginal 原图缺失 否则仅缺失部分缩放版本
+	MissingOriginal bool
+	MissingPaths    []string
+	// FoundInOther 原图缺失但在另一个桶中存在 修复时复制回应在的桶
+	FoundInOther bool
+}
+
+// ReconcileReport 桶与图片记录的比对结果
+type ReconcileReport struct {
+	TenantID      TenantID
+	Apply         bool
+	OrphanCount   int
+	DanglingCount int
+	// Orphans Dangling 最多列出 MaxReconcileReportItems 条
+	Orphans  []*OrphanObject
+	Dangling []*DanglingImg
+	// Repaired 执行修复成功的条数 仅 Apply 时有效
+	RepairedOrphans  int
+	RepairedDangling int
+	StartedAt        time.Time
+	FinishedAt       time.Time
+}
+
+func (r *ReconcileReport) AddOrphan(orphan *OrphanObject) {
+	r.OrphanCount++
+	if len(r.Orphans) < MaxReconcileReportItems {
+		r.Orphans = append(r.Orphans, orphan)
+	}
+}
+
+func (r *ReconcileReport) AddDangling(dangling *DanglingImg) {
+	r.DanglingCount++
+	if len(r.Dangling) < MaxReconcileReportItems {
+		r.Dangling = append(r.Dangling, dangling)
+	}
+}
@@ -5,23 +5,115 @@ import (
 )
 
 type ImgService interface {
-	Upload(src io.Reader, img *Img, categoryID CategoryID) error
+	Upload(src io.Reader, img *Img, categoryID CategoryID) (*Img, error)
 	Delete(tenantID TenantID, imgID ImgID, hard ...bool) error
 	ListByKeyset(query *ListByKeysetQuery) (*ListByKeysetResult, error)
 	ClearRecycleBin(tenantID TenantID, imgID ImgID) error
 	ListenDeleteQueue()
 	RestoreFromRecycleBin(tenantID TenantID, imgID ImgID) error
+	// Bulk 批量操作 返回每张图片的结果
+	Bulk(op *BulkOperation) ([]*BulkResult, error)
+	// MoveToCategory 将图片移动到其他分类 categoryID 为空表示移出分类
+	MoveToCategory(tenantID TenantID, imgID ImgID, categoryID CategoryID) error
+	// ListDeleteDeadLetters 多次重试仍未能彻底删除的回收站图片 可手动清空回收站重试
+	ListDeleteDeadLetters(tenantID TenantID) ([]*DeleteDeadLetter, error)
+
+	// 直传
+	CreateUploadSlot(slot *UploadSlot) (*PresignedUpload, error)
+	ConfirmUpload(tenantID TenantID, slotID UploadSlotID) (*Img, error)
+
+	// 导入
+	// CreateURLImport 从远程地址导入到指定分类 categoryID 为空表示不分类
+	CreateURLImport(tenantID TenantID, categoryID CategoryID, urls []string) (*ImportJob, error)
+	// CreateZipImport 暂存压缩包后异步解压导入
+	CreateZipImport(tenantID TenantID, categoryID CategoryID, src io.Reader, size int64) (*ImportJob, error)
+	// GetImportJob 含逐个文件的结果
+	GetImportJob(tenantID TenantID, jobID ImportJobID) (*ImportJob, error)
+	ListImportJobs(tenantID TenantID) ([]*ImportJob, error)
+	// RetryImportJob 重新导入失败的文件
+	RetryImportJob(tenantID TenantID, jobID ImportJobID) (*ImportJob, error)
+
+	// 导出
+	// CreateExport 按筛选条件异步打包图片与元数据清单
+	CreateExport(job *ExportJob) (*ExportJob, error)
+	// GetExportJob 导出完成且未过期时附带下载链接
+	GetExportJob(tenantID TenantID, jobID ExportJobID) (*ExportJob, error)
+	ListExportJobs(tenantID TenantID) ([]*ExportJob, error)
 
 	//	分类
 	CreateCategory(category *Category) error
-	UpdateCategory(category *Category) error
+	// UpdateCategory 修改前缀且分类下存在图片时返回迁移任务 否则任务为空
+	UpdateCategory(category *Category) (*CategoryJob, error)
 	DeleteCategory(tenantID TenantID, categoryID CategoryID) error
+	// DeleteCategoryAndMove 将图片移动到目标分类后删除分类 分类下无图片时直接删除且任务为空
+	DeleteCategoryAndMove(tenantID TenantID, categoryID, targetID CategoryID) (*CategoryJob, error)
+	GetCategoryJob(tenantID TenantID, jobID CategoryJobID) (*CategoryJob, error)
+	ListCategoryJobs(tenantID TenantID) ([]*CategoryJob, error)
+	// RetryCategoryJob 从剩余未迁移的图片继续执行失败的任务
+	RetryCategoryJob(tenantID TenantID, jobID CategoryJobID) (*CategoryJob, error)
 	AllCategories(tenantID TenantID) (categories []*Category, err error)
+	// MoveCategory 将分类连同子分类移动到新的父分类下 parentID 为空表示移动为顶级分类
+	MoveCategory(tenantID TenantID, categoryID, parentID CategoryID) error
+
+	// 相册
+	CreateAlbum(album *Album) error
+	UpdateAlbum(album *Album) error
+	DeleteAlbum(tenantID TenantID, albumID AlbumID) error
+	AllAlbums(tenantID TenantID) ([]*Album, error)
+	// AddAlbumImgs 按顺序追加到相册末尾 已在相册中的图片保持原位置
+	AddAlbumImgs(tenantID TenantID, albumID AlbumID, imgIDs []ImgID) error
+	RemoveAlbumImgs(tenantID TenantID, albumID AlbumID, imgIDs []ImgID) error
+	// MoveAlbumImg 将图片移动到 beforeImgID 之前 beforeImgID 为空表示移动到末尾
+	MoveAlbumImg(tenantID TenantID, albumID AlbumID, imgID, beforeImgID ImgID) error
+	ListAlbumImgs(query *ListAlbumImgsQuery) (*ListByKeysetResult, error)
+
+	// 标签
+	CreateTag(tag *Tag) error
+	DeleteTag(tenantID TenantID, tagID TagID) error
+	AllTags(tenantID TenantID) ([]*Tag, error)
+	// SetImgTags 覆盖图片的全部标签
+	SetImgTags(tenantID TenantID, imgID ImgID, tagIDs []TagID) error
 
 	// 配置
-	SetR2Config(config *R2Config) error
-	GetR2Config(tenantID TenantID) (*R2Config, error)
+	SetStorageConfig(config *StorageConfig) error
+	GetStorageConfig(tenantID TenantID) (*StorageConfig, error)
+
+	SetStorageSecretKey(tenantID TenantID, secretKey StorageSecretAccessKey) error
+	IsSetStorageSecretKey(tenantID TenantID) (bool, error)
+	// TestStorageConfig 测试存储连接与权限 config 为空时测试已保存的配置
+	TestStorageConfig(tenantID TenantID, config *StorageConfig) (*StorageDiagnosticReport, error)
+
+	// CreateStorageMigration 将全部对象迁移到新的存储配置 校验通过后切换 密钥为明文
+	CreateStorageMigration(target *StorageConfig) (*StorageMigration, error)
+	GetStorageMigration(tenantID TenantID, migrationID StorageMigrationID) (*StorageMigration, error)
+	ListStorageMigrations(tenantID TenantID) ([]*StorageMigration, error)
+
+	// GetStorageUsage 返回按分类与桶统计的存储用量
+	GetStorageUsage(tenantID TenantID) (*StorageUsageReport, error)
+	// ReconcileStorageUsage 按桶内实际对象校准存储用量
+	ReconcileStorageUsage(tenantID TenantID) (*StorageUsageReport, error)
+
+	// ReconcileObjects 比对桶中对象与图片记录 apply 为 false 时仅报告
+	ReconcileObjects(tenantID TenantID, apply bool) (*ReconcileReport, error)
+
+	// GetImgSetting 未配置时返回默认配置
+	GetImgSetting(tenantID TenantID) (*ImgSetting, error)
+	SetImgSetting(setting *ImgSetting) error
+
+	// GetWatermarkSetting 未配置时返回默认配置
+	GetWatermarkSetting(tenantID TenantID) (*WatermarkSetting, error)
+	// SetWatermarkSetting 水印图片沿用已保存的 通过 SetWatermarkImage 替换
+	SetWatermarkSetting(setting *WatermarkSetting) error
+	// SetWatermarkImage 上传水印图片 返回更新后的配置
+	SetWatermarkImage(tenantID TenantID, src io.Reader) (*WatermarkSetting, error)
+
+	// TransformURL 为图片生成带签名的变换链接
+	TransformURL(tenantID TenantID, imgID ImgID, opts *TransformOptions) (string, error)
+	// Transform 校验签名后返回变换结果 优先读取缓存
+	Transform(tenantID TenantID, imgPath string, opts *TransformOptions, signature string) (*ProcessedImage, error)
 
-	SetR2SecretKey(tenantID TenantID, secretKey R2SecretAccessKey) error
-	IsSetR2SecretKey(tenantID TenantID) (bool, error)
+	// ReadLocalObject 读取本地存储中的对象 非公共桶需携带有效签名
+	ReadLocalObject(tenantID TenantID, bucket, key string, expires int64, signature string) (io.ReadCloser, error)
+	// WriteLocalObject 接收本地存储的直传 须携带有效的上传签名
+	WriteLocalObject(tenantID TenantID, bucket, key, contentType string, size int64, expires int64, signature string, body io.Reader) error
 }
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"io"
+	"slices"
+	"time"
+)
+
+type StorageProvider string
+
+func (p StorageProvider) String() string {
+	return string(p)
+}
+
+const (
+	// StorageProviderR2 Cloudflare R2 端点由 AccountID 推导
+	StorageProviderR2 StorageProvider = "r2"
+	// StorageProviderS3 AWS S3 或 MinIO 等 S3 兼容服务
+	StorageProviderS3 StorageProvider = "s3"
+	// StorageProviderLocal 本地磁盘 用于开发与测试环境
+	StorageProviderLocal StorageProvider = "local"
+)
+
+var AllStorageProviders = []StorageProvider{
+	StorageProviderR2,
+	StorageProviderS3,
+	StorageProviderLocal,
+}
+
+func (p StorageProvider) IsValid() bool {
+	return slices.Contains(AllStorageProviders, p)
+}
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+type ObjectList struct {
+	Objects []*ObjectInfo
+	// NextToken 为空表示已无更多对象
+	NextToken string
+}
+
+// ObjectStorage 对象存储的统一抽象 屏蔽 R2、S3 兼容服务与本地磁盘的差异
+type ObjectStorage interface {
+	Put(bucket, key string, body io.Reader, contentType string) error
+	Copy(srcBucket, srcKey, dstBucket, dstKey string) error
+	Delete(bucket, key string) error
+	// Get 调用方负责关闭返回的 io.ReadCloser
+	Get(bucket, key string) (io.ReadCloser, error)
+	Presign(bucket, key string, expire time.Duration) (string, error)
+	// PresignPut 生成直传链接 上传时须携带相同的 Content-Type 与 Content-Length
+	PresignPut(bucket, key, contentType string, size int64, expire time.Duration) (string, error)
+	// Stat 对象不存在时返回 codes.ErrImgStorageObjectNotFound
+	Stat(bucket, key string) (*ObjectInfo, error)
+	List(bucket, prefix, token string, limit int) (*ObjectList, error)
+}
+
+// SelfServedStorage 由本服务对外提供访问的存储(如本地磁盘)
+// 需要自行生成公共访问前缀并校验预签名链接
+type SelfServedStorage interface {
+	PublicURLPrefix(bucket string) string
+	VerifyPresign(bucket, key string, expires int64, signature string) error
+	VerifyPresignPut(bucket, key, contentType string, size int64, expires int64, signature string) error
+}
+
+// ObjectStorageFactory 根据租户存储配置创建对应的存储实现
+// 传入配置中的密钥须为明文
+type ObjectStorageFactory interface {
+	New(config *StorageConfig) (ObjectStorage, error)
+}
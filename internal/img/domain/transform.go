@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+// TransformFit 同时指定宽高时的缩放方式
+type TransformFit string
+
+const (
+	// TransformFitContain 等比缩放至宽高范围内
+	TransformFitContain TransformFit = "contain"
+	// TransformFitCover 等比缩放铺满宽高 居中裁剪多余部分
+	TransformFitCover TransformFit = "cover"
+	// TransformFitFill 拉伸至指定宽高
+	TransformFitFill TransformFit = "fill"
+)
+
+var AllTransformFits = []TransformFit{
+	TransformFitContain,
+	TransformFitCover,
+	TransformFitFill,
+}
+
+func (f TransformFit) IsValid() bool {
+	return slices.Contains(AllTransformFits, f)
+}
+
+// IsTransformable 变换允许输出的格式 参数为空则保持原格式
+func (f ImageFormat) IsTransformable() bool {
+	return f == ImageFormatJPEG || f == ImageFormatPNG || f == ImageFormatWebP
+}
+
+// MaxTransformDimension 变换输出的最大边长
+const MaxTransformDimension = 4096
+
+// TransformCachePrefix 变换结果在租户公共桶中的缓存前缀
+const TransformCachePrefix = "_transform/"
+
+// TransformOptions 对应 URL 参数 ?w=&h=&fit=&format=&q=
+type TransformOptions struct {
+	Width   int
+	Height  int
+	Fit     TransformFit
+	Format  ImageFormat
+	Quality int
+}
+
+// Query 生成规范化的查询参数 用于签名与缓存key 零值参数不参与
+func (o *TransformOptions) Query() url.Values {
+	query := url.Values{}
+	if o.Width > 0 {
+		query.Set("w", strconv.Itoa(o.Width))
+	}
+	if o.Height > 0 {
+		query.Set("h", strconv.Itoa(o.Height))
+	}
+	if o.Fit != "" && o.Fit != TransformFitContain {
+		query.Set("fit", string(o.Fit))
+	}
+	if o.Format != "" {
+		query.Set("format", string(o.Format))
+	}
+	if o.Quality > 0 {
+		query.Set("q", strconv.Itoa(o.Quality))
+	}
+	return query
+}
+
+// CacheKey 同一图片下区分不同变换参数
+func (o *TransformOptions) CacheKey() string {
+	sum := sha256.Sum256([]byte(o.Query().Encode()))
+	return hex.EncodeToString(sum[:16])
+}
+
+// TransformCache 变换结果缓存 以图片路径分组 便于删除图片时一并清理
+// 未命中时 Get 返回 codes.ErrImgStorageObjectNotFound
+type TransformCache interface {
+	Get(tenantID TenantID, imgPath, key string) ([]byte, error)
+	Put(tenantID TenantID, imgPath, key string, data []byte) error
+	Purge(tenantID TenantID, imgPath string) error
+}
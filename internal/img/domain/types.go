@@ -29,3 +29,23 @@ func (c CategoryID) IsZero() bool {
 func (c CategoryID) String() string {
 	return string(c)
 }
+
+type TagID string
+
+func (t TagID) IsZero() bool {
+	return t == ""
+}
+
+func (t TagID) String() string {
+	return string(t)
+}
+
+type AlbumID string
+
+func (a AlbumID) IsZero() bool {
+	return a == ""
+}
+
+func (a AlbumID) String() string {
+	return string(a)
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+type UploadSlotID string
+
+func (u UploadSlotID) String() string {
+	return string(u)
+}
+
+const (
+	// MaxDirectUploadSize 直传允许的最大文件大小
+	MaxDirectUploadSize = 50 << 20
+	// UploadStagingPrefix 直传对象在租户回收站桶中的暂存前缀 确认后复制到公共桶
+	UploadStagingPrefix = "_upload/"
+)
+
+// UploadSlot 直传凭证 客户端凭预签名链接上传到暂存位置 再调用确认接口入库
+type UploadSlot struct {
+	ID         UploadSlotID
+	TenantID   TenantID
+	CategoryID CategoryID
+	// Path 不含分类前缀 扩展名与 Format 一致
+	Path             string
+	Description      string
+	OriginalFilename string
+	Format           ImageFormat
+	Size             int64
+	ExpiresAt        time.Time
+}
+
+func (s *UploadSlot) StagingKey() string {
+	return UploadStagingPrefix + s.ID.String()
+}
+
+// PresignedUpload 客户端需以 PUT 方法携带 Headers 上传
+type PresignedUpload struct {
+	Slot    *UploadSlot
+	URL     string
+	Headers map[string]string
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// StorageBucketKind 租户的存储桶类型
+type StorageBucketKind string
+
+const (
+	StorageBucketPublic StorageBucketKind = "public"
+	StorageBucketDelete StorageBucketKind = "delete"
+)
+
+// StorageUsage 租户某分类在某个桶中的存储用量 CategoryID 为空表示未分类
+// 增量更新时 Bytes 与 Objects 为变化量
+type StorageUsage struct {
+	TenantID     TenantID
+	CategoryID   CategoryID
+	Bucket       StorageBucketKind
+	Bytes        int64
+	Objects      int64
+	ReconciledAt time.Time
+	UpdatedAt    time.Time
+}
+
+// StorageUsageKey 用量记录的唯一键
+type StorageUsageKey struct {
+	CategoryID CategoryID
+	Bucket     StorageBucketKind
+}
+
+func (u *StorageUsage) Key() StorageUsageKey {
+	return StorageUsageKey{CategoryID: u.CategoryID, Bucket: u.Bucket}
+}
+
+// StorageUsageReport 租户的存储用量明细
+type StorageUsageReport struct {
+	Items []*StorageUsage
+}
+
+// Total 指定桶的总字节数与对象数
+func (r *StorageUsageReport) Total(bucket StorageBucketKind) (bytes int64, objects int64) {
+	for _, item := range r.Items {
+		if item.Bucket == bucket {
+			bytes += item.Bytes
+			objects += item.Objects
+		}
+	}
+	return bytes, objects
+}
+
+// ReconciledAt 最近一次校准时间 从未校准时为零值
+func (r *StorageUsageReport) ReconciledAt() time.Time {
+	var latest time.Time
+	for _, item := range r.Items {
+		if item.ReconciledAt.After(latest) {
+			latest = item.ReconciledAt
+		}
+	}
+	return latest
+}
+
+// IsInternalObjectKey 变换缓存、直传、导入与导出暂存、连接测试、水印等内部对象 不对应图片记录 也不计入用量
+func IsInternalObjectKey(key string) bool {
+	return strings.HasPrefix(key, TransformCachePrefix) ||
+		strings.HasPrefix(key, UploadStagingPrefix) ||
+		strings.HasPrefix(key, ImportStagingPrefix) ||
+		strings.HasPrefix(key, ExportStagingPrefix) ||
+		strings.HasPrefix(key, StorageProbePrefix) ||
+		strings.HasPrefix(key, WatermarkImagePrefix) ||
+		strings.HasPrefix(key, OriginalPrefix)
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"image/color"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type WatermarkKind string
+
+const (
+	WatermarkKindText  WatermarkKind = "text"
+	WatermarkKindImage WatermarkKind = "image"
+)
+
+type WatermarkPosition string
+
+const (
+	WatermarkTopLeft     WatermarkPosition = "top_left"
+	WatermarkTopRight    WatermarkPosition = "top_right"
+	WatermarkBottomLeft  WatermarkPosition = "bottom_left"
+	WatermarkBottomRight WatermarkPosition = "bottom_right"
+	WatermarkCenter      WatermarkPosition = "center"
+)
+
+const (
+	// WatermarkImagePrefix 水印图片在租户 delete_bucket 中的前缀
+	WatermarkImagePrefix = "_watermark/"
+	// OriginalPrefix 未加水印的图片在租户 delete_bucket 中的前缀 按图片id存放 不随路径变化
+	OriginalPrefix = "_original/"
+
+	// MaxWatermarkImageSize 水印图片的最大字节数
+	MaxWatermarkImageSize = 1 << 20
+	// MaxWatermarkCategories 可指定的分类上限
+	MaxWatermarkCategories = 50
+
+	DefaultWatermarkTextColor = "#FFFFFF"
+	DefaultWatermarkOpacity   = 50
+	DefaultWatermarkScale     = 20
+)
+
+// WatermarkSetting 租户级水印配置 仅对经服务端处理的上传生效 生效分类不允许直传 变换缓存基于已加水印的图片
+// 已上传的图片不受配置变更影响
+type WatermarkSetting struct {
+	TenantID TenantID
+	Enabled  bool
+	Kind     WatermarkKind
+	Text     string
+	// TextColor #RRGGBB
+	TextColor string
+	// ImageKey 水印图片在 delete_bucket 中的路径 通过上传水印图片设置
+	ImageKey string
+	Position WatermarkPosition
+	// Opacity 不透明度 1-100
+	Opacity int
+	// Scale 水印宽度占图片宽度的百分比 1-100
+	Scale int
+	// CategoryIDs 为空表示全部图片 否则仅对这些分类及其子分类生效
+	CategoryIDs []CategoryID
+	// KeepOriginal 在 delete_bucket 中保留未加水印的图片 供日后重新处理
+	KeepOriginal bool
+}
+
+func DefaultWatermarkSetting(tenantID TenantID) *WatermarkSetting {
+	return &WatermarkSetting{
+		TenantID:  tenantID,
+		Kind:      WatermarkKindText,
+		TextColor: DefaultWatermarkTextColor,
+		Position:  WatermarkBottomRight,
+		Opacity:   DefaultWatermarkOpacity,
+		Scale:     DefaultWatermarkScale,
+	}
+}
+
+// OriginalKey 未加水印的图片在 delete_bucket 中的路径
+func OriginalKey(imgID ImgID) string {
+	return OriginalPrefix + imgID.String()
+}
+
+// AppliesTo 图片所在分类是否需要加水印 category 为空表示未分类
+func (w *WatermarkSetting) AppliesTo(category *Category) bool {
+	if !w.Enabled {
+		return false
+	}
+	if len(w.CategoryIDs) == 0 {
+		return true
+	}
+	if category == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(w.CategoryIDs, func(id CategoryID) bool {
+		return id == category.ID || strings.Contains(category.TreePath, "/"+id.String()+"/")
+	})
+}
+
+// ParseWatermarkColor 解析 #RRGGBB 格式的颜色
+func ParseWatermarkColor(s string) (color.NRGBA, bool) {
+	if len(s) != 7 || s[0] != '#' {
+		return color.NRGBA{}, false
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return color.NRGBA{}, false
+	}
+	return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, true
+}
+
+// WatermarkOptions 叠加水印所需的参数 文字与图片二选一
+type WatermarkOptions struct {
+	Kind      WatermarkKind
+	Text      string
+	TextColor color.NRGBA
+	// Image 水印图片的原始内容
+	Image    []byte
+	Position WatermarkPosition
+	Opacity  int
+	Scale    int
+	// Quality 重新编码时的质量 仅对 JPEG 生效
+	Quality int
+}
@@ -1,7 +1,13 @@
 package handler
 
 import (
+	"net/http"
+	"saas/internal/common/reskit/response"
 	"saas/internal/img/domain"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 )
 
 func domainImgToResponse(img *domain.Img) *ImgResponse {
@@ -11,16 +17,35 @@ func domainImgToResponse(img *domain.Img) *ImgResponse {
 
 	// 默认访问public
 	resp := &ImgResponse{
-		ID:          img.ID,
-		URL:         img.GetPublicPreURL() + "/" + img.Path,
-		Description: img.Description,
-		CreatedAt:   img.CreatedAt.Unix(),
-		UpdatedAt:   img.UpdatedAt.Unix(),
+		ID:               img.ID,
+		URL:              img.GetPublicPreURL() + "/" + img.ObjectPath,
+		Description:      img.Description,
+		Width:            img.Width,
+		Height:           img.Height,
+		Size:             img.Size,
+		MimeType:         img.MimeType,
+		OriginalFilename: img.OriginalFilename,
+		Tags:             domainTagsToResponse(img.Tags),
+		Deduplicated:     img.Deduplicated,
+		CreatedAt:        img.CreatedAt.Unix(),
+		UpdatedAt:        img.UpdatedAt.Unix(),
 	}
 
 	// 如果是要访问软删除文件
 	if img.IsDeleted() {
-		resp.URL = img.Path
+		resp.URL = img.ObjectPath
+	}
+
+	for _, variant := range img.Variants {
+		variantResp := &ImgVariantResponse{
+			Width:  variant.Width,
+			Height: variant.Height,
+			URL:    img.GetPublicPreURL() + "/" + variant.Path,
+		}
+		if img.IsDeleted() {
+			variantResp.URL = variant.Path
+		}
+		resp.Variants = append(resp.Variants, variantResp)
 	}
 
 	return resp
@@ -55,6 +80,48 @@ func domainImgKeysetToResponse(pager *domain.ListByKeysetResult) *ListByKeysetRe
 	}
 }
 
+func domainDeleteDeadLettersToResponse(letters []*domain.DeleteDeadLetter) []*DeleteDeadLetterResponse {
+	list := make([]*DeleteDeadLetterResponse, 0, len(letters))
+
+	for _, letter := range letters {
+		if letter != nil {
+			list = append(list, &DeleteDeadLetterResponse{
+				ImgID:    letter.ImgID,
+				Attempts: letter.Attempts,
+				Error:    letter.Error,
+				FailedAt: letter.FailedAt.Unix(),
+			})
+		}
+	}
+
+	return list
+}
+
+// domainBulkResultsToResponse 单张失败按错误码返回 非业务错误记录到请求错误列表
+func domainBulkResultsToResponse(ctx *gin.Context, results []*domain.BulkResult) *BulkResponse {
+	resp := &BulkResponse{
+		Results: make([]*BulkItemResponse, 0, len(results)),
+	}
+
+	for _, result := range results {
+		item := &BulkItemResponse{ID: result.ImgID, OK: result.Err == nil}
+		if result.Err != nil {
+			httpErr := response.MapToHTTP(result.Err)
+			item.Code = httpErr.Response.Code
+			item.Message = httpErr.Response.Message
+			if httpErr.StatusCode >= http.StatusInternalServerError {
+				_ = ctx.Error(errors.WithMessagef(result.Err, "批量操作失败 img_id: %s", result.ImgID))
+			}
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+		resp.Results = append(resp.Results, item)
+	}
+
+	return resp
+}
+
 func domainCategoryToResponse(category *domain.Category) *CategoryResponse {
 	if category == nil {
 		return nil
@@ -62,6 +129,8 @@ func domainCategoryToResponse(category *domain.Category) *CategoryResponse {
 
 	resp := &CategoryResponse{
 		ID:        category.ID,
+		ParentID:  category.ParentID,
+		Depth:     category.Depth,
 		Title:     category.Title,
 		Prefix:    category.Prefix,
 		CreatedAt: category.CreatedAt.Unix(),
@@ -85,19 +154,392 @@ func domainCategoriesToResponse(categories []*domain.Category) []*CategoryRespon
 	return list
 }
 
-func domainR2ConfigToResponse(r2Config *domain.R2Config) *R2ConfigResponse {
-	if r2Config == nil {
+func domainCategoryJobToResponse(job *domain.CategoryJob) *CategoryJobResponse {
+	if job == nil {
+		return nil
+	}
+
+	resp := &CategoryJobResponse{
+		ID:               job.ID,
+		CategoryID:       job.CategoryID,
+		Kind:             job.Kind,
+		TargetCategoryID: job.TargetCategoryID,
+		Status:           job.Status,
+		Total:            job.Total,
+		Processed:        job.Processed,
+		Failed:           job.Failed,
+		LastError:        job.LastError,
+		CreatedAt:        job.CreatedAt.Unix(),
+		UpdatedAt:        job.UpdatedAt.Unix(),
+	}
+	if !job.FinishedAt.IsZero() {
+		resp.FinishedAt = job.FinishedAt.Unix()
+	}
+
+	return resp
+}
+
+func domainCategoryJobsToResponse(jobs []*domain.CategoryJob) []*CategoryJobResponse {
+	list := make([]*CategoryJobResponse, 0, len(jobs))
+
+	for _, job := range jobs {
+		if job != nil {
+			list = append(list, domainCategoryJobToResponse(job))
+		}
+	}
+
+	return list
+}
+
+func domainAlbumToResponse(album *domain.Album) *AlbumResponse {
+	if album == nil {
+		return nil
+	}
+
+	return &AlbumResponse{
+		ID:          album.ID,
+		Title:       album.Title,
+		Description: album.Description,
+		CreatedAt:   album.CreatedAt.Unix(),
+		UpdatedAt:   album.UpdatedAt.Unix(),
+	}
+}
+
+func domainAlbumsToResponse(albums []*domain.Album) []*AlbumResponse {
+	list := make([]*AlbumResponse, 0, len(albums))
+
+	for _, album := range albums {
+		if album != nil {
+			list = append(list, domainAlbumToResponse(album))
+		}
+	}
+
+	return list
+}
+
+func domainTagToResponse(tag *domain.Tag) *TagResponse {
+	if tag == nil {
+		return nil
+	}
+
+	return &TagResponse{
+		ID:   tag.ID,
+		Name: tag.Name,
+	}
+}
+
+func domainTagsToResponse(tags []*domain.Tag) []*TagResponse {
+	if len(tags) == 0 {
+		return nil
+	}
+	list := make([]*TagResponse, 0, len(tags))
+
+	for _, tag := range tags {
+		if tag != nil {
+			list = append(list, domainTagToResponse(tag))
+		}
+	}
+
+	return list
+}
+
+func domainStorageConfigToResponse(config *domain.StorageConfig) *StorageConfigResponse {
+	if config == nil {
 		return nil
 	}
 
 	// 默认访问public
-	resp := &R2ConfigResponse{
-		AccountID:       r2Config.AccountID,
-		AccessKeyID:     r2Config.AccessKeyID,
-		PublicBucket:    r2Config.PublicBucket,
-		PublicURLPrefix: r2Config.PublicURLPrefix,
-		DeleteBucket:    r2Config.DeleteBucket,
+	resp := &StorageConfigResponse{
+		Provider:        config.Provider,
+		AccountID:       config.AccountID,
+		Endpoint:        config.Endpoint,
+		Region:          config.Region,
+		UsePathStyle:    config.UsePathStyle,
+		AccessKeyID:     config.AccessKeyID,
+		PublicBucket:    config.PublicBucket,
+		PublicURLPrefix: config.PublicURLPrefix,
+		DeleteBucket:    config.DeleteBucket,
 	}
 
 	return resp
 }
+
+func domainStorageDiagnosticToResponse(report *domain.StorageDiagnosticReport) *StorageDiagnosticResponse {
+	if report == nil {
+		return nil
+	}
+
+	resp := &StorageDiagnosticResponse{
+		OK:        report.OK(),
+		Provider:  report.Provider,
+		Stored:    report.Stored,
+		Checks:    make([]*StorageCheckResponse, 0, len(report.Checks)),
+		CheckedAt: report.CheckedAt.Unix(),
+	}
+	for _, check := range report.Checks {
+		resp.Checks = append(resp.Checks, &StorageCheckResponse{
+			Name:       check.Name,
+			Bucket:     check.Bucket,
+			Status:     check.Status,
+			Error:      check.Error,
+			DurationMs: check.Duration.Milliseconds(),
+		})
+	}
+
+	return resp
+}
+
+func domainStorageMigrationToResponse(migration *domain.StorageMigration) *StorageMigrationResponse {
+	if migration == nil {
+		return nil
+	}
+
+	resp := &StorageMigrationResponse{
+		ID:        migration.ID,
+		Status:    migration.Status,
+		Target:    domainStorageConfigToResponse(migration.Target),
+		Total:     migration.Total,
+		Copied:    migration.Copied,
+		Verified:  migration.Verified,
+		LastError: migration.LastError,
+		CreatedAt: migration.CreatedAt.Unix(),
+		UpdatedAt: migration.UpdatedAt.Unix(),
+	}
+	if migration.IsSwitched() {
+		resp.SwitchedAt = migration.SwitchedAt.Unix()
+	}
+	if !migration.FinishedAt.IsZero() {
+		resp.FinishedAt = migration.FinishedAt.Unix()
+	}
+
+	return resp
+}
+
+func domainStorageMigrationsToResponse(migrations []*domain.StorageMigration) []*StorageMigrationResponse {
+	list := make([]*StorageMigrationResponse, 0, len(migrations))
+
+	for _, migration := range migrations {
+		if migration != nil {
+			list = append(list, domainStorageMigrationToResponse(migration))
+		}
+	}
+
+	return list
+}
+
+func domainStorageUsageToResponse(report *domain.StorageUsageReport) *StorageUsageResponse {
+	if report == nil {
+		return nil
+	}
+
+	resp := &StorageUsageResponse{
+		Categories: make([]*CategoryStorageUsageResponse, 0, len(report.Items)),
+	}
+	resp.Public.Bytes, resp.Public.Objects = report.Total(domain.StorageBucketPublic)
+	resp.Delete.Bytes, resp.Delete.Objects = report.Total(domain.StorageBucketDelete)
+	if reconciledAt := report.ReconciledAt(); !reconciledAt.IsZero() {
+		resp.ReconciledAt = reconciledAt.Unix()
+	}
+
+	for _, item := range report.Items {
+		resp.Categories = append(resp.Categories, &CategoryStorageUsageResponse{
+			CategoryID: item.CategoryID,
+			Bucket:     item.Bucket,
+			Bytes:      item.Bytes,
+			Objects:    item.Objects,
+		})
+	}
+
+	return resp
+}
+
+func domainReconcileReportToResponse(report *domain.ReconcileReport) *ReconcileReportResponse {
+	if report == nil {
+		return nil
+	}
+
+	resp := &ReconcileReportResponse{
+		Apply:            report.Apply,
+		OrphanCount:      report.OrphanCount,
+		DanglingCount:    report.DanglingCount,
+		Orphans:          make([]*OrphanObjectResponse, 0, len(report.Orphans)),
+		Dangling:         make([]*DanglingImgResponse, 0, len(report.Dangling)),
+		RepairedOrphans:  report.RepairedOrphans,
+		RepairedDangling: report.RepairedDangling,
+		StartedAt:        report.StartedAt.Unix(),
+		FinishedAt:       report.FinishedAt.Unix(),
+	}
+
+	for _, orphan := range report.Orphans {
+		resp.Orphans = append(resp.Orphans, &OrphanObjectResponse{
+			Bucket:       orphan.Bucket,
+			Key:          orphan.Key,
+			Size:         orphan.Size,
+			LastModified: orphan.LastModified.Unix(),
+		})
+	}
+	for _, dangling := range report.Dangling {
+		resp.Dangling = append(resp.Dangling, &DanglingImgResponse{
+			ImgID:           dangling.ImgID,
+			Path:            dangling.Path,
+			Bucket:          dangling.Bucket,
+			MissingOriginal: dangling.MissingOriginal,
+			MissingPaths:    dangling.MissingPaths,
+			FoundInOther:    dangling.FoundInOther,
+		})
+	}
+
+	return resp
+}
+
+func domainImgSettingToResponse(setting *domain.ImgSetting) *ImgSettingResponse {
+	if setting == nil {
+		return nil
+	}
+
+	return &ImgSettingResponse{
+		OutputFormat:  setting.OutputFormat,
+		Quality:       setting.Quality,
+		VariantWidths: setting.VariantWidths,
+		DedupMode:     setting.DedupMode,
+	}
+}
+
+func domainWatermarkSettingToResponse(setting *domain.WatermarkSetting) *WatermarkSettingResponse {
+	if setting == nil {
+		return nil
+	}
+
+	return &WatermarkSettingResponse{
+		Enabled:      setting.Enabled,
+		Kind:         setting.Kind,
+		Text:         setting.Text,
+		TextColor:    setting.TextColor,
+		HasImage:     setting.ImageKey != "",
+		Position:     setting.Position,
+		Opacity:      setting.Opacity,
+		Scale:        setting.Scale,
+		CategoryIDs:  setting.CategoryIDs,
+		KeepOriginal: setting.KeepOriginal,
+	}
+}
+
+func transformOptionsFromQuery(width, height int, fit domain.TransformFit, format domain.ImageFormat, quality int) *domain.TransformOptions {
+	return &domain.TransformOptions{
+		Width:   width,
+		Height:  height,
+		Fit:     fit,
+		Format:  format,
+		Quality: quality,
+	}
+}
+
+func domainPresignedUploadToResponse(upload *domain.PresignedUpload) *UploadSlotResponse {
+	if upload == nil {
+		return nil
+	}
+
+	return &UploadSlotResponse{
+		ID:        upload.Slot.ID,
+		URL:       upload.URL,
+		Method:    http.MethodPut,
+		Headers:   upload.Headers,
+		Path:      upload.Slot.Path,
+		ExpiresAt: upload.Slot.ExpiresAt.Unix(),
+	}
+}
+
+func domainImportJobToResponse(job *domain.ImportJob) *ImportJobResponse {
+	if job == nil {
+		return nil
+	}
+
+	resp := &ImportJobResponse{
+		ID:         job.ID,
+		CategoryID: job.CategoryID,
+		Source:     job.Source,
+		Status:     job.Status,
+		Total:      job.Total,
+		Succeeded:  job.Succeeded,
+		Failed:     job.Failed,
+		LastError:  job.LastError,
+		CreatedAt:  job.CreatedAt.Unix(),
+		UpdatedAt:  job.UpdatedAt.Unix(),
+	}
+	if !job.FinishedAt.IsZero() {
+		resp.FinishedAt = job.FinishedAt.Unix()
+	}
+	for _, item := range job.Items {
+		if item != nil {
+			resp.Items = append(resp.Items, &ImportItemResponse{
+				Seq:       item.Seq,
+				Source:    item.Source,
+				Status:    item.Status,
+				ImgID:     item.ImgID,
+				ErrorCode: item.ErrorCode,
+				Error:     item.Error,
+			})
+		}
+	}
+
+	return resp
+}
+
+func domainImportJobsToResponse(jobs []*domain.ImportJob) []*ImportJobResponse {
+	list := make([]*ImportJobResponse, 0, len(jobs))
+
+	for _, job := range jobs {
+		if job != nil {
+			list = append(list, domainImportJobToResponse(job))
+		}
+	}
+
+	return list
+}
+
+func domainExportJobToResponse(job *domain.ExportJob) *ExportJobResponse {
+	if job == nil {
+		return nil
+	}
+
+	resp := &ExportJobResponse{
+		ID:                   job.ID,
+		CategoryID:           job.CategoryID,
+		IncludeSubcategories: job.IncludeSubcategories,
+		TagIDs:               job.TagIDs,
+		IncludeDeleted:       job.IncludeDeleted,
+		Status:               job.Status,
+		Total:                job.Total,
+		Exported:             job.Exported,
+		Missing:              job.Missing,
+		Size:                 job.Size,
+		LastError:            job.LastError,
+		CreatedAt:            job.CreatedAt.Unix(),
+		UpdatedAt:            job.UpdatedAt.Unix(),
+		DownloadURL:          job.DownloadURL,
+	}
+	if resp.TagIDs == nil {
+		resp.TagIDs = []domain.TagID{}
+	}
+	if !job.FinishedAt.IsZero() {
+		resp.FinishedAt = job.FinishedAt.Unix()
+	}
+	if !job.ExpiresAt.IsZero() {
+		resp.ExpiresAt = job.ExpiresAt.Unix()
+		resp.Expired = !job.IsDownloadable(time.Now())
+	}
+
+	return resp
+}
+
+func domainExportJobsToResponse(jobs []*domain.ExportJob) []*ExportJobResponse {
+	list := make([]*ExportJobResponse, 0, len(jobs))
+
+	for _, job := range jobs {
+		if job != nil {
+			list = append(list, domainExportJobToResponse(job))
+		}
+	}
+
+	return list
+}
@@ -3,11 +3,25 @@ package handler
 import "saas/internal/img/domain"
 
 type ImgResponse struct {
-	ID          domain.ImgID `json:"id"`
-	URL         string       `json:"url"`
-	Description string       `json:"description,omitempty"`
-	CreatedAt   int64        `json:"created_at"`
-	UpdatedAt   int64        `json:"updated_at"`
+	ID               domain.ImgID          `json:"id"`
+	URL              string                `json:"url"`
+	Description      string                `json:"description,omitempty"`
+	Width            int                   `json:"width"`
+	Height           int                   `json:"height"`
+	Size             int64                 `json:"size"`
+	MimeType         string                `json:"mime_type,omitempty"`
+	OriginalFilename string                `json:"original_filename,omitempty"`
+	Tags             []*TagResponse        `json:"tags,omitempty"`
+	Variants         []*ImgVariantResponse `json:"variants,omitempty"`
+	Deduplicated     bool                  `json:"deduplicated,omitempty"`
+	CreatedAt        int64                 `json:"created_at"`
+	UpdatedAt        int64                 `json:"updated_at"`
+}
+
+type ImgVariantResponse struct {
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	URL    string `json:"url"`
 }
 
 type UploadRequest struct {
@@ -33,14 +47,54 @@ type RestoreFromRecycleBinRequest struct {
 	ID       domain.ImgID    `uri:"id" binding:"required,uuid"`
 }
 
-type ListByKeysetRequest struct {
+type ListDeleteDeadLettersRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type DeleteDeadLetterResponse struct {
+	ImgID    domain.ImgID `json:"img_id"`
+	Attempts int          `json:"attempts"`
+	Error    string       `json:"error"`
+	FailedAt int64        `json:"failed_at"`
+}
+
+type BulkRequest struct {
 	TenantID   domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
-	CategoryID domain.CategoryID `form:"category_id" binding:"omitempty,uuid"`
-	PrevCursor string            `form:"prev_cursor"`
-	NextCursor string            `form:"next_cursor"`
-	Keyword    string            `form:"keyword"`
-	PageSize   int               `form:"page_size,default=5" binding:"min=5,max=20"`
-	Deleted    bool              `form:"deleted,default=false"`
+	Action     domain.BulkAction `json:"action" binding:"required,oneof=delete hard_delete restore clear move"`
+	IDs        []domain.ImgID    `json:"ids" binding:"required,min=1,max=100,dive,uuid"`
+	CategoryID domain.CategoryID `json:"category_id" binding:"omitempty,uuid"`
+}
+
+type BulkItemResponse struct {
+	ID      domain.ImgID `json:"id"`
+	OK      bool         `json:"ok"`
+	Code    int          `json:"code,omitempty"`
+	Message string       `json:"message,omitempty"`
+}
+
+type BulkResponse struct {
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+	Results   []*BulkItemResponse `json:"results"`
+}
+
+type ListByKeysetRequest struct {
+	TenantID    domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	CategoryID  domain.CategoryID `form:"category_id" binding:"omitempty,uuid"`
+	PrevCursor  string            `form:"prev_cursor"`
+	NextCursor  string            `form:"next_cursor"`
+	Keyword     string            `form:"keyword"`
+	PageSize    int               `form:"page_size,default=5" binding:"min=5,max=20"`
+	Deleted     bool              `form:"deleted,default=false"`
+	TagIDs      []domain.TagID    `form:"tag_id" binding:"max=10,dive,uuid"`
+	MimeType    string            `form:"mime" binding:"omitempty,max=32"`
+	MinSize     int64             `form:"min_size" binding:"min=0"`
+	MaxSize     int64             `form:"max_size" binding:"min=0"`
+	CreatedFrom int64             `form:"created_from" binding:"min=0"`
+	CreatedTo   int64             `form:"created_to" binding:"min=0"`
+	Sort        domain.ImgSort    `form:"sort" binding:"omitempty,oneof=created_at_desc created_at_asc size_desc size_asc"`
+	// IncludeSubcategories 同时列出 category_id 子孙分类下的图片
+	IncludeSubcategories bool `form:"include_subcategories,default=false"`
 }
 
 type ListByKeysetResponse struct {
@@ -53,63 +107,512 @@ type ListByKeysetResponse struct {
 
 type CategoryResponse struct {
 	ID        domain.CategoryID `json:"id"`
+	ParentID  domain.CategoryID `json:"parent_id,omitempty"`
+	Depth     int               `json:"depth"`
 	Title     string            `json:"title"`
 	Prefix    string            `json:"prefix"`
 	CreatedAt int64             `json:"created_at"`
 }
 
 type CreateCategoryRequest struct {
-	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
-	Title    string          `json:"title" binding:"required,max=10"`
-	Prefix   string          `json:"prefix" binding:"required,max=20,slug"`
+	TenantID domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	ParentID domain.CategoryID `json:"parent_id" binding:"omitempty,uuid"`
+	Title    string            `json:"title" binding:"required,max=32"`
+	Prefix   string            `json:"prefix" binding:"required,max=20,slug"`
 }
 
 type UpdateCategoryRequest struct {
 	ID       domain.CategoryID `json:"-" uri:"id" binding:"required,uuid"`
 	TenantID domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
-	Title    string            `json:"title" binding:"required,max=10"`
+	Title    string            `json:"title" binding:"required,max=32"`
 	Prefix   string            `json:"prefix" binding:"required,max=20,slug"`
 }
 
-type DeleteCategoryRequest struct {
+type MoveCategoryRequest struct {
 	ID       domain.CategoryID `json:"-" uri:"id" binding:"required,uuid"`
 	TenantID domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	// ParentID 为空表示移动为顶级分类
+	ParentID domain.CategoryID `json:"parent_id" binding:"omitempty,uuid"`
+}
+
+type DeleteCategoryRequest struct {
+	ID         domain.CategoryID `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID   domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	MoveImages bool              `form:"move_images,default=false"`
+	MoveTo     domain.CategoryID `form:"move_to" binding:"omitempty,uuid"`
+}
+
+type ListCategoryJobsRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type CategoryJobRequest struct {
+	ID       domain.CategoryJobID `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID      `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type CategoryJobResponse struct {
+	ID               domain.CategoryJobID   `json:"id"`
+	CategoryID       domain.CategoryID      `json:"category_id"`
+	Kind             domain.CategoryJobKind `json:"kind"`
+	TargetCategoryID domain.CategoryID      `json:"target_category_id,omitempty"`
+	Status           domain.JobStatus       `json:"status"`
+	Total            int                    `json:"total"`
+	Processed        int                    `json:"processed"`
+	Failed           int                    `json:"failed"`
+	LastError        string                 `json:"last_error,omitempty"`
+	CreatedAt        int64                  `json:"created_at"`
+	UpdatedAt        int64                  `json:"updated_at"`
+	FinishedAt       int64                  `json:"finished_at,omitempty"`
 }
 
 type AllCategoryRequest struct {
 	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
 }
 
-type SetR2ConfigRequest struct {
-	TenantID        domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
-	AccountID       string          `json:"account_id" binding:"required,len=32"`
-	AccessKeyID     string          `json:"access_key_id" binding:"required,len=32"`
-	PublicBucket    string          `json:"public_bucket" binding:"required,max=32"`
-	PublicURLPrefix string          `json:"public_url_prefix" binding:"required,domain_url,max=128"`
-	DeleteBucket    string          `json:"delete_bucket" binding:"required,max=32"`
+type AlbumResponse struct {
+	ID          domain.AlbumID `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	CreatedAt   int64          `json:"created_at"`
+	UpdatedAt   int64          `json:"updated_at"`
+}
+
+type CreateAlbumRequest struct {
+	TenantID    domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Title       string          `json:"title" binding:"required,max=32"`
+	Description string          `json:"description" binding:"max=200"`
+}
+
+type UpdateAlbumRequest struct {
+	ID          domain.AlbumID  `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID    domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Title       string          `json:"title" binding:"required,max=32"`
+	Description string          `json:"description" binding:"max=200"`
+}
+
+type AlbumRequest struct {
+	ID       domain.AlbumID  `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type AllAlbumsRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type AlbumImgsRequest struct {
+	ID       domain.AlbumID  `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	ImgIDs   []domain.ImgID  `json:"ids" binding:"required,min=1,max=100,dive,uuid"`
+}
+
+type MoveAlbumImgRequest struct {
+	ID       domain.AlbumID  `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	ImgID    domain.ImgID    `json:"-" uri:"img_id" binding:"required,uuid"`
+	// BeforeID 移动到该图片之前 为空表示移动到末尾
+	BeforeID domain.ImgID `json:"before_id" binding:"omitempty,uuid"`
+}
+
+type ListAlbumImgsRequest struct {
+	ID         domain.AlbumID  `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID   domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	PrevCursor string          `form:"prev_cursor"`
+	NextCursor string          `form:"next_cursor"`
+	PageSize   int             `form:"page_size,default=20" binding:"min=5,max=50"`
+}
+
+type TagResponse struct {
+	ID   domain.TagID `json:"id"`
+	Name string       `json:"name"`
+}
+
+type CreateTagRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Name     string          `json:"name" binding:"required,max=32"`
+}
+
+type DeleteTagRequest struct {
+	ID       domain.TagID    `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type AllTagRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type SetImgTagsRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	ID       domain.ImgID    `json:"-" uri:"id" binding:"required,uuid"`
+	TagIDs   []domain.TagID  `json:"tag_ids" binding:"max=20,dive,uuid"`
+}
+
+// SetStorageConfigRequest 不填写 provider 时按 r2 处理 兼容旧的 /r2_config 接口
+type SetStorageConfigRequest struct {
+	TenantID        domain.TenantID        `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Provider        domain.StorageProvider `json:"provider" binding:"omitempty,oneof=r2 s3 local"`
+	AccountID       string                 `json:"account_id" binding:"omitempty,len=32"`
+	Endpoint        string                 `json:"endpoint" binding:"omitempty,url,max=255"`
+	Region          string                 `json:"region" binding:"omitempty,max=32"`
+	UsePathStyle    bool                   `json:"use_path_style"`
+	AccessKeyID     string                 `json:"access_key_id" binding:"omitempty,max=128"`
+	PublicBucket    string                 `json:"public_bucket" binding:"required,max=63"`
+	PublicURLPrefix string                 `json:"public_url_prefix" binding:"omitempty,domain_url,max=128"`
+	DeleteBucket    string                 `json:"delete_bucket" binding:"required,max=63"`
 }
 
-type R2ConfigResponse struct {
-	AccountID       string `json:"account_id"`
-	AccessKeyID     string `json:"access_key_id"`
-	PublicBucket    string `json:"public_bucket"`
-	PublicURLPrefix string `json:"public_url_prefix"`
-	DeleteBucket    string `json:"delete_bucket"`
+type StorageConfigResponse struct {
+	Provider        domain.StorageProvider `json:"provider"`
+	AccountID       string                 `json:"account_id"`
+	Endpoint        string                 `json:"endpoint"`
+	Region          string                 `json:"region"`
+	UsePathStyle    bool                   `json:"use_path_style"`
+	AccessKeyID     string                 `json:"access_key_id"`
+	PublicBucket    string                 `json:"public_bucket"`
+	PublicURLPrefix string                 `json:"public_url_prefix"`
+	DeleteBucket    string                 `json:"delete_bucket"`
 }
 
-type GetR2ConfigRequest struct {
+type GetStorageConfigRequest struct {
 	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
 }
 
-type SetR2SecretAccessKeyRequest struct {
-	TenantID        domain.TenantID          `json:"-" uri:"tenant_id" binding:"required,uuid"`
-	SecretAccessKey domain.R2SecretAccessKey `json:"secret_access_key" binding:"required,len=64"`
+type SetStorageSecretAccessKeyRequest struct {
+	TenantID        domain.TenantID               `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	SecretAccessKey domain.StorageSecretAccessKey `json:"secret_access_key" binding:"required,max=128"`
 }
 
-type IsSetR2SecretRequest struct {
+type IsSetStorageSecretRequest struct {
 	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
 }
 
-type IsSetR2SecretResponse struct {
+type IsSetStorageSecretResponse struct {
 	IsSet bool `json:"is_set"`
 }
+
+// TestStorageConfigRequest 不填写 provider 时测试已保存的配置 未填写密钥时沿用已保存的密钥
+type TestStorageConfigRequest struct {
+	TenantID        domain.TenantID               `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Provider        domain.StorageProvider        `json:"provider" binding:"omitempty,oneof=r2 s3 local"`
+	AccountID       string                        `json:"account_id" binding:"omitempty,len=32"`
+	Endpoint        string                        `json:"endpoint" binding:"omitempty,url,max=255"`
+	Region          string                        `json:"region" binding:"omitempty,max=32"`
+	UsePathStyle    bool                          `json:"use_path_style"`
+	AccessKeyID     string                        `json:"access_key_id" binding:"omitempty,max=128"`
+	SecretAccessKey domain.StorageSecretAccessKey `json:"secret_access_key" binding:"omitempty,max=128"`
+	PublicBucket    string                        `json:"public_bucket" binding:"required_with=Provider,max=63"`
+	PublicURLPrefix string                        `json:"public_url_prefix" binding:"omitempty,domain_url,max=128"`
+	DeleteBucket    string                        `json:"delete_bucket" binding:"required_with=Provider,max=63"`
+}
+
+type StorageCheckResponse struct {
+	Name   domain.StorageCheckName   `json:"name"`
+	Bucket string                    `json:"bucket,omitempty"`
+	Status domain.StorageCheckStatus `json:"status"`
+	Error  string                    `json:"error,omitempty"`
+	// DurationMs 耗时 毫秒
+	DurationMs int64 `json:"duration_ms"`
+}
+
+type StorageDiagnosticResponse struct {
+	// OK 全部检查通过或跳过
+	OK        bool                    `json:"ok"`
+	Provider  domain.StorageProvider  `json:"provider"`
+	Stored    bool                    `json:"stored"`
+	Checks    []*StorageCheckResponse `json:"checks"`
+	CheckedAt int64                   `json:"checked_at"`
+}
+
+type CreateStorageMigrationRequest struct {
+	TenantID        domain.TenantID               `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Provider        domain.StorageProvider        `json:"provider" binding:"required,oneof=r2 s3 local"`
+	AccountID       string                        `json:"account_id" binding:"omitempty,len=32"`
+	Endpoint        string                        `json:"endpoint" binding:"omitempty,url,max=255"`
+	Region          string                        `json:"region" binding:"omitempty,max=32"`
+	UsePathStyle    bool                          `json:"use_path_style"`
+	AccessKeyID     string                        `json:"access_key_id" binding:"omitempty,max=128"`
+	SecretAccessKey domain.StorageSecretAccessKey `json:"secret_access_key" binding:"omitempty,max=128"`
+	PublicBucket    string                        `json:"public_bucket" binding:"required,max=63"`
+	PublicURLPrefix string                        `json:"public_url_prefix" binding:"omitempty,domain_url,max=128"`
+	DeleteBucket    string                        `json:"delete_bucket" binding:"required,max=63"`
+}
+
+type ListStorageMigrationsRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type StorageMigrationRequest struct {
+	ID       domain.StorageMigrationID `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID           `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type StorageMigrationResponse struct {
+	ID     domain.StorageMigrationID `json:"id"`
+	Status domain.JobStatus          `json:"status"`
+	// Target 目标存储配置 不包含密钥
+	Target     *StorageConfigResponse `json:"target"`
+	Total      int                    `json:"total"`
+	Copied     int                    `json:"copied"`
+	Verified   int                    `json:"verified"`
+	LastError  string                 `json:"last_error,omitempty"`
+	CreatedAt  int64                  `json:"created_at"`
+	UpdatedAt  int64                  `json:"updated_at"`
+	SwitchedAt int64                  `json:"switched_at,omitempty"`
+	FinishedAt int64                  `json:"finished_at,omitempty"`
+}
+
+type ReadLocalObjectRequest struct {
+	TenantID  domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Bucket    string          `json:"-" uri:"bucket" binding:"required,max=63"`
+	Key       string          `json:"-" uri:"key" binding:"required"`
+	Expires   int64           `json:"-" form:"expires"`
+	Signature string          `json:"-" form:"signature"`
+}
+
+type GetStorageUsageRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type StorageUsageTotalResponse struct {
+	Bytes   int64 `json:"bytes"`
+	Objects int64 `json:"objects"`
+}
+
+type CategoryStorageUsageResponse struct {
+	CategoryID domain.CategoryID        `json:"category_id"`
+	Bucket     domain.StorageBucketKind `json:"bucket"`
+	Bytes      int64                    `json:"bytes"`
+	Objects    int64                    `json:"objects"`
+}
+
+type StorageUsageResponse struct {
+	Public       StorageUsageTotalResponse       `json:"public"`
+	Delete       StorageUsageTotalResponse       `json:"delete"`
+	Categories   []*CategoryStorageUsageResponse `json:"categories"`
+	ReconciledAt int64                           `json:"reconciled_at,omitempty"`
+}
+
+type ReconcileObjectsRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Apply    bool            `json:"apply"`
+}
+
+type OrphanObjectResponse struct {
+	Bucket       domain.StorageBucketKind `json:"bucket"`
+	Key          string                   `json:"key"`
+	Size         int64                    `json:"size"`
+	LastModified int64                    `json:"last_modified"`
+}
+
+type DanglingImgResponse struct {
+	ImgID           domain.ImgID             `json:"img_id"`
+	Path            string                   `json:"path"`
+	Bucket          domain.StorageBucketKind `json:"bucket"`
+	MissingOriginal bool                     `json:"missing_original"`
+	MissingPaths    []string                 `json:"missing_paths"`
+	FoundInOther    bool                     `json:"found_in_other"`
+}
+
+type ReconcileReportResponse struct {
+	Apply            bool                    `json:"apply"`
+	OrphanCount      int                     `json:"orphan_count"`
+	DanglingCount    int                     `json:"dangling_count"`
+	Orphans          []*OrphanObjectResponse `json:"orphans"`
+	Dangling         []*DanglingImgResponse  `json:"dangling"`
+	RepairedOrphans  int                     `json:"repaired_orphans"`
+	RepairedDangling int                     `json:"repaired_dangling"`
+	StartedAt        int64                   `json:"started_at"`
+	FinishedAt       int64                   `json:"finished_at"`
+}
+
+type GetImgSettingRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type SetImgSettingRequest struct {
+	TenantID      domain.TenantID     `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	OutputFormat  domain.OutputFormat `json:"output_format" binding:"required,oneof=original webp jpeg"`
+	Quality       int                 `json:"quality" binding:"required,min=1,max=100"`
+	VariantWidths []int               `json:"variant_widths" binding:"max=5,dive,min=16,max=4096"`
+	DedupMode     domain.DedupMode    `json:"dedup_mode" binding:"omitempty,oneof=off reuse link"`
+}
+
+type ImgSettingResponse struct {
+	OutputFormat  domain.OutputFormat `json:"output_format"`
+	Quality       int                 `json:"quality"`
+	VariantWidths []int               `json:"variant_widths"`
+	DedupMode     domain.DedupMode    `json:"dedup_mode"`
+}
+
+type GetWatermarkSettingRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type SetWatermarkSettingRequest struct {
+	TenantID     domain.TenantID          `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Enabled      bool                     `json:"enabled"`
+	Kind         domain.WatermarkKind     `json:"kind" binding:"required,oneof=text image"`
+	Text         string                   `json:"text" binding:"max=64"`
+	TextColor    string                   `json:"text_color" binding:"omitempty,len=7"`
+	Position     domain.WatermarkPosition `json:"position" binding:"required,oneof=top_left top_right bottom_left bottom_right center"`
+	Opacity      int                      `json:"opacity" binding:"required,min=1,max=100"`
+	Scale        int                      `json:"scale" binding:"required,min=1,max=100"`
+	CategoryIDs  []domain.CategoryID      `json:"category_ids" binding:"max=50,dive,uuid"`
+	KeepOriginal bool                     `json:"keep_original"`
+}
+
+type SetWatermarkImageRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type WatermarkSettingResponse struct {
+	Enabled      bool                     `json:"enabled"`
+	Kind         domain.WatermarkKind     `json:"kind"`
+	Text         string                   `json:"text"`
+	TextColor    string                   `json:"text_color"`
+	HasImage     bool                     `json:"has_image"`
+	Position     domain.WatermarkPosition `json:"position"`
+	Opacity      int                      `json:"opacity"`
+	Scale        int                      `json:"scale"`
+	CategoryIDs  []domain.CategoryID      `json:"category_ids"`
+	KeepOriginal bool                     `json:"keep_original"`
+}
+
+type CreateTransformURLRequest struct {
+	TenantID domain.TenantID     `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	ID       domain.ImgID        `json:"id" binding:"required,uuid"`
+	Width    int                 `json:"w" binding:"min=0,max=4096"`
+	Height   int                 `json:"h" binding:"min=0,max=4096"`
+	Fit      domain.TransformFit `json:"fit" binding:"omitempty,oneof=contain cover fill"`
+	Format   domain.ImageFormat  `json:"format" binding:"omitempty,oneof=jpeg png webp"`
+	Quality  int                 `json:"q" binding:"min=0,max=100"`
+}
+
+type TransformURLResponse struct {
+	URL string `json:"url"`
+}
+
+type TransformRequest struct {
+	TenantID  domain.TenantID     `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Path      string              `json:"-" uri:"path" binding:"required"`
+	Width     int                 `json:"-" form:"w" binding:"min=0,max=4096"`
+	Height    int                 `json:"-" form:"h" binding:"min=0,max=4096"`
+	Fit       domain.TransformFit `json:"-" form:"fit" binding:"omitempty,oneof=contain cover fill"`
+	Format    domain.ImageFormat  `json:"-" form:"format" binding:"omitempty,oneof=jpeg png webp"`
+	Quality   int                 `json:"-" form:"q" binding:"min=0,max=100"`
+	Signature string              `json:"-" form:"s" binding:"required"`
+}
+
+type CreateUploadSlotRequest struct {
+	TenantID    domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Path        string            `json:"path" binding:"omitempty,slug,max=200"`
+	Description string            `json:"description" binding:"max=120"`
+	CategoryID  domain.CategoryID `json:"category_id" binding:"omitempty,uuid"`
+	Size        int64             `json:"size" binding:"required,min=1,max=52428800"`
+	Filename    string            `json:"filename" binding:"max=1024"`
+	ContentType string            `json:"content_type" binding:"required,oneof=image/jpeg image/jpg image/png image/gif image/webp image/avif image/bmp"`
+}
+
+type UploadSlotResponse struct {
+	ID        domain.UploadSlotID `json:"id"`
+	URL       string              `json:"url"`
+	Method    string              `json:"method"`
+	Headers   map[string]string   `json:"headers"`
+	Path      string              `json:"path"`
+	ExpiresAt int64               `json:"expires_at"`
+}
+
+type ConfirmUploadRequest struct {
+	TenantID domain.TenantID     `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	ID       domain.UploadSlotID `uri:"id" binding:"required,hexadecimal,len=32"`
+}
+
+type WriteLocalObjectRequest struct {
+	TenantID  domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	Bucket    string          `json:"-" uri:"bucket" binding:"required,max=63"`
+	Key       string          `json:"-" uri:"key" binding:"required"`
+	Expires   int64           `json:"-" form:"expires" binding:"required"`
+	Signature string          `json:"-" form:"signature" binding:"required"`
+}
+
+type CreateURLImportRequest struct {
+	TenantID   domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	CategoryID domain.CategoryID `json:"category_id" binding:"omitempty,uuid"`
+	URLs       []string          `json:"urls" binding:"required,min=1,max=500,dive,url,max=2048"`
+}
+
+type CreateZipImportRequest struct {
+	TenantID   domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	CategoryID domain.CategoryID `form:"category_id" binding:"omitempty,uuid"`
+}
+
+type ListImportJobsRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type ImportJobRequest struct {
+	ID       domain.ImportJobID `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID    `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type ImportJobResponse struct {
+	ID         domain.ImportJobID    `json:"id"`
+	CategoryID domain.CategoryID     `json:"category_id,omitempty"`
+	Source     domain.ImportSource   `json:"source"`
+	Status     domain.JobStatus      `json:"status"`
+	Total      int                   `json:"total"`
+	Succeeded  int                   `json:"succeeded"`
+	Failed     int                   `json:"failed"`
+	LastError  string                `json:"last_error,omitempty"`
+	CreatedAt  int64                 `json:"created_at"`
+	UpdatedAt  int64                 `json:"updated_at"`
+	FinishedAt int64                 `json:"finished_at,omitempty"`
+	Items      []*ImportItemResponse `json:"items,omitempty"`
+}
+
+type ImportItemResponse struct {
+	Seq       int              `json:"seq"`
+	Source    string           `json:"source"`
+	Status    domain.JobStatus `json:"status"`
+	ImgID     domain.ImgID     `json:"img_id,omitempty"`
+	ErrorCode int              `json:"error_code,omitempty"`
+	Error     string           `json:"error,omitempty"`
+}
+
+type CreateExportRequest struct {
+	TenantID             domain.TenantID   `json:"-" uri:"tenant_id" binding:"required,uuid"`
+	CategoryID           domain.CategoryID `json:"category_id" binding:"omitempty,uuid"`
+	IncludeSubcategories bool              `json:"include_subcategories"`
+	TagIDs               []domain.TagID    `json:"tag_ids" binding:"max=10,dive,uuid"`
+	IncludeDeleted       bool              `json:"include_deleted"`
+}
+
+type ListExportJobsRequest struct {
+	TenantID domain.TenantID `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type ExportJobRequest struct {
+	ID       domain.ExportJobID `json:"-" uri:"id" binding:"required,uuid"`
+	TenantID domain.TenantID    `json:"-" uri:"tenant_id" binding:"required,uuid"`
+}
+
+type ExportJobResponse struct {
+	ID                   domain.ExportJobID `json:"id"`
+	CategoryID           domain.CategoryID  `json:"category_id,omitempty"`
+	IncludeSubcategories bool               `json:"include_subcategories"`
+	TagIDs               []domain.TagID     `json:"tag_ids"`
+	IncludeDeleted       bool               `json:"include_deleted"`
+	Status               domain.JobStatus   `json:"status"`
+	Total                int                `json:"total"`
+	Exported             int                `json:"exported"`
+	Missing              int                `json:"missing"`
+	Size                 int64              `json:"size"`
+	LastError            string             `json:"last_error,omitempty"`
+	CreatedAt            int64              `json:"created_at"`
+	UpdatedAt            int64              `json:"updated_at"`
+	FinishedAt           int64              `json:"finished_at,omitempty"`
+	ExpiresAt            int64              `json:"expires_at,omitempty"`
+	// Expired 压缩包已过期删除 需重新导出
+	Expired     bool   `json:"expired"`
+	DownloadURL string `json:"download_url,omitempty"`
+}
@@ -1,12 +1,15 @@
 package handler
 
 import (
+	"bytes"
+	"cmp"
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"mime/multipart"
 	"net/http"
-	"path/filepath"
+	"path"
 	"saas/internal/common/reqkit/bind"
 	"saas/internal/common/reskit/codes"
 	"saas/internal/common/reskit/response"
@@ -28,7 +31,7 @@ func NewHttpHandler(service domain.ImgService) *HttpHandler {
 	}
 }
 
-func isImage(file multipart.File) (bool, string, error) {
+func isImage(file multipart.File) (bool, domain.ImageFormat, error) {
 	buf := make([]byte, 512)
 	n, _ := file.Read(buf)
 
@@ -37,46 +40,33 @@ func isImage(file multipart.File) (bool, string, error) {
 		return false, "", fmt.Errorf("file.Seek 复位文件指针失败,reason:%v", err)
 	}
 
-	contentType := http.DetectContentType(buf[:n])
-	switch contentType {
-	case "image/jpeg", "image/png", "image/gif", "image/webp", "image/avif", "image/bmp", "image/svg+xml":
-		return true, contentType, nil
-	default:
-		return false, contentType, nil
-	}
+	format := domain.DetectImageFormat(buf[:n])
+	return format != domain.ImageFormatUnknown, format, nil
 }
 
-func generateImgPath(ext string) string {
+func generateImgPath() string {
 	now := time.Now().Format("2006_01_02_150405.000")
 	random := rand.Intn(1000000)
-	return fmt.Sprintf("%s_%d%s", now, random, ext)
-}
-
-func getExtByContentType(realType string) (ext string) {
-	switch realType {
-	case "image/jpeg":
-		ext = ".jpg"
-	case "image/png":
-		ext = ".png"
-	case "image/gif":
-		ext = ".gif"
-	case "image/webp":
-		ext = ".webp"
-	case "image/avif":
-		ext = ".avif"
-	case "image/bmp":
-		ext = ".bmp"
-	case "image/svg+xml":
-		ext = ".svg"
-	default:
-		ext = ""
-	}
-	return ext
+	return fmt.Sprintf("%s_%d", now, random)
+}
+
+const maxOriginalFilename = 255
+
+// originalFilename 仅保留文件名部分 超长时按字符截断
+func originalFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(strings.TrimSpace(name), "\\", "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	if runes := []rune(name); len(runes) > maxOriginalFilename {
+		name = string(runes[:maxOriginalFilename])
+	}
+	return name
 }
 
 // Upload godoc
 // @Summary      上传图片
-// @Description  上传单张图片（支持 jpeg/png/gif/webp/avif/bmp/svg）
+// @Description  上传单张图片（支持 jpeg/png/gif/webp/avif/bmp/svg），按租户图片处理配置转码，svg 会移除脚本、事件属性与外部链接，动图与透明通道会被保留，路径扩展名与实际格式一致；同时按配置宽度生成缩放版本，保存在原图旁的 {path}@{width}w 路径下；内容与已有图片重复时按 dedup_mode 返回已有图片或共享其存储对象，响应中 deduplicated 为 true
 // @Tags         img
 // @Accept       multipart/form-data
 // @Produce      json
@@ -84,7 +74,7 @@ func getExtByContentType(realType string) (ext string) {
 // @Param        path        formData  string false "自定义图片路径"
 // @Param        description formData  string false "图片描述"
 // @Param        category_id formData  string  false "分类id"
-// @Success      200 {object} response.successResponse "请求成功"
+// @Success      200 {object} response.successResponse{data=handler.ImgResponse} "请求成功"
 // @Failure      400 {object} response.invalidParamsResponse "参数错误"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Security     BearerAuth
@@ -100,13 +90,13 @@ func (h *HttpHandler) Upload(ctx *gin.Context) {
 	file, _ := fileHeader.Open()
 	defer file.Close()
 
-	ok, realType, err := isImage(file)
+	ok, _, err := isImage(file)
 	if err != nil {
 		response.Error(ctx, errors.Errorf("isImage执行失败: %s", err))
 		return
 	}
 	if !ok {
-		response.InvalidParams(ctx, errors.Errorf("仅支持基本图片类型上传，实际类型: %s", realType))
+		response.Error(ctx, codes.ErrImgUnsupportedFormat)
 		return
 	}
 
@@ -116,34 +106,28 @@ func (h *HttpHandler) Upload(ctx *gin.Context) {
 		return
 	}
 
-	var imgPath string
-
-	// 无path则生成path
-	if strings.TrimSpace(req.Path) != "" {
-		ext := filepath.Ext(req.Path)
-		if ext == "" {
-			imgPath = req.Path + getExtByContentType(realType)
-		} else {
-			imgPath = req.Path
-		}
-	} else {
-		imgPath = generateImgPath(getExtByContentType(realType))
+	// 无path则生成path 扩展名由服务端按处理后的实际格式补全
+	imgPath := strings.TrimSpace(req.Path)
+	if imgPath == "" {
+		imgPath = generateImgPath()
 	}
 
-	if err := h.service.Upload(
+	res, err := h.service.Upload(
 		file,
 		&domain.Img{
-			TenantID:    req.TenantID,
-			Path:        imgPath,
-			Description: req.Description,
+			TenantID:         req.TenantID,
+			Path:             imgPath,
+			Description:      req.Description,
+			OriginalFilename: originalFilename(fileHeader.Filename),
 		},
 		req.CategoryID,
-	); err != nil {
+	)
+	if err != nil {
 		response.Error(ctx, err)
 		return
 	}
 
-	response.Success(ctx)
+	response.Success(ctx, domainImgToResponse(res))
 }
 
 // Delete godoc
@@ -201,7 +185,7 @@ func (h *HttpHandler) ListByKeyset(ctx *gin.Context) {
 		return
 	}
 
-	list, err := h.service.ListByKeyset(&domain.ListByKeysetQuery{
+	query := &domain.ListByKeysetQuery{
 		TenantID:   req.TenantID,
 		CategoryID: req.CategoryID,
 		PrevCursor: req.PrevCursor,
@@ -209,7 +193,22 @@ func (h *HttpHandler) ListByKeyset(ctx *gin.Context) {
 		Keyword:    req.Keyword,
 		PageSize:   req.PageSize,
 		Deleted:    req.Deleted,
-	})
+		TagIDs:     req.TagIDs,
+		MimeType:   req.MimeType,
+		MinSize:    req.MinSize,
+		MaxSize:    req.MaxSize,
+		Sort:       req.Sort,
+
+		IncludeSubcategories: req.IncludeSubcategories,
+	}
+	if req.CreatedFrom > 0 {
+		query.CreatedFrom = time.Unix(req.CreatedFrom, 0)
+	}
+	if req.CreatedTo > 0 {
+		query.CreatedTo = time.Unix(req.CreatedTo, 0)
+	}
+
+	list, err := h.service.ListByKeyset(query)
 
 	if err != nil {
 		response.Error(ctx, err)
@@ -219,6 +218,39 @@ func (h *HttpHandler) ListByKeyset(ctx *gin.Context) {
 	response.Success(ctx, domainImgKeysetToResponse(list))
 }
 
+// Bulk godoc
+// @Summary      批量操作图片
+// @Description  对多张图片执行同一操作: delete 移入回收站, hard_delete 直接删除, restore 从回收站恢复, clear 移除回收站图片, move 移动到 category_id 指定的分类(为空则移出分类)；单张失败不影响其他图片，逐张返回结果
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Param        request body handler.BulkRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.BulkResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/bulk [post]
+func (h *HttpHandler) Bulk(ctx *gin.Context) {
+	req := new(BulkRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.Bulk(&domain.BulkOperation{
+		TenantID:   req.TenantID,
+		Action:     req.Action,
+		ImgIDs:     req.IDs,
+		CategoryID: req.CategoryID,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainBulkResultsToResponse(ctx, res))
+}
+
 // ClearRecycleBin godoc
 // @Summary      移除回收站图片
 // @Tags         img
@@ -273,10 +305,149 @@ func (h *HttpHandler) RestoreFromRecycleBin(ctx *gin.Context) {
 	response.Success(ctx)
 }
 
+// ListDeleteDeadLetters godoc
+// @Summary      回收站删除失败列表
+// @Description  到期后多次重试仍未能彻底删除的图片 可调用移除回收站图片接口手动重试
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=[]handler.DeleteDeadLetterResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/recycle/failed [get]
+func (h *HttpHandler) ListDeleteDeadLetters(ctx *gin.Context) {
+	req := new(ListDeleteDeadLettersRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ListDeleteDeadLetters(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainDeleteDeadLettersToResponse(res))
+}
+
 func (h *HttpHandler) ListenDeleteQueue() {
 	h.service.ListenDeleteQueue()
 }
 
+// --- 标签管理 ---
+
+// CreateTag godoc
+// @Summary      创建图片标签
+// @Tags         img-tag
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id    path   string  true  "租户id"
+// @Param        request body handler.CreateTagRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.TagResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/tag [post]
+func (h *HttpHandler) CreateTag(ctx *gin.Context) {
+	req := new(CreateTagRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	tag := &domain.Tag{
+		TenantID: req.TenantID,
+		Name:     strings.TrimSpace(req.Name),
+	}
+	if err := h.service.CreateTag(tag); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainTagToResponse(tag))
+}
+
+// DeleteTag godoc
+// @Summary      删除图片标签
+// @Description  同时解除该标签与图片的关联
+// @Tags         img-tag
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "标签id"
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse "删除成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/tag/{id} [delete]
+func (h *HttpHandler) DeleteTag(ctx *gin.Context) {
+	req := new(DeleteTagRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.DeleteTag(req.TenantID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// AllTags godoc
+// @Summary      获取全部图片标签
+// @Tags         img-tag
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=[]handler.TagResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/tags [get]
+func (h *HttpHandler) AllTags(ctx *gin.Context) {
+	req := new(AllTagRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.AllTags(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainTagsToResponse(res))
+}
+
+// SetImgTags godoc
+// @Summary      设置图片标签
+// @Description  覆盖图片的全部标签 传空数组清除标签
+// @Tags         img-tag
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Param        id        path string true "图片id"
+// @Param        request body handler.SetImgTagsRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      404 {object} response.errorResponse "图片或标签不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/{id}/tags [put]
+func (h *HttpHandler) SetImgTags(ctx *gin.Context) {
+	req := new(SetImgTagsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.SetImgTags(req.TenantID, req.ID, req.TagIDs); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
 // --- 分类管理 ---
 
 // CreateCategory godoc
@@ -299,6 +470,7 @@ func (h *HttpHandler) CreateCategory(ctx *gin.Context) {
 
 	if err := h.service.CreateCategory(&domain.Category{
 		TenantID: req.TenantID,
+		ParentID: req.ParentID,
 		Title:    req.Title,
 		Prefix:   req.Prefix,
 	}); err != nil {
@@ -317,7 +489,7 @@ func (h *HttpHandler) CreateCategory(ctx *gin.Context) {
 // @Param        id      		path   string  true  "分类id"
 // @Param        tenant_id  path   string  true  "租户id"
 // @Param        request body   handler.UpdateCategoryRequest true "请求参数"
-// @Success      200 {object} response.successResponse "请求成功"
+// @Success      200 {object} response.successResponse{data=handler.CategoryJobResponse} "请求成功 修改前缀且分类下存在图片时返回迁移任务"
 // @Failure      400 {object} response.invalidParamsResponse "参数错误"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Security     BearerAuth
@@ -328,17 +500,22 @@ func (h *HttpHandler) UpdateCategory(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.service.UpdateCategory(&domain.Category{
+	job, err := h.service.UpdateCategory(&domain.Category{
 		ID:       req.ID,
 		TenantID: req.TenantID,
 		Title:    req.Title,
 		Prefix:   req.Prefix,
-	}); err != nil {
+	})
+	if err != nil {
 		response.Error(ctx, err)
 		return
 	}
+	if job == nil {
+		response.Success(ctx)
+		return
+	}
 
-	response.Success(ctx)
+	response.Success(ctx, domainCategoryJobToResponse(job))
 }
 
 // DeleteCategory godoc
@@ -348,7 +525,9 @@ func (h *HttpHandler) UpdateCategory(ctx *gin.Context) {
 // @Produce      json
 // @Param        id path string true "分类id"
 // @Param        tenant_id path string true "租户id"
-// @Success      200 {object} response.successResponse "删除成功"
+// @Param        move_images query bool false "将图片移动到 move_to 分类后删除 默认要求分类下无图片"
+// @Param        move_to query string false "目标分类id 为空表示移出分类"
+// @Success      200 {object} response.successResponse{data=handler.CategoryJobResponse} "删除成功 移动图片时返回迁移任务"
 // @Failure      400 {object} response.invalidParamsResponse "参数错误"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Security     BearerAuth
@@ -359,7 +538,130 @@ func (h *HttpHandler) DeleteCategory(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.service.DeleteCategory(req.TenantID, req.ID); err != nil {
+	if !req.MoveImages {
+		if err := h.service.DeleteCategory(req.TenantID, req.ID); err != nil {
+			response.Error(ctx, err)
+			return
+		}
+
+		response.Success(ctx)
+		return
+	}
+
+	job, err := h.service.DeleteCategoryAndMove(req.TenantID, req.ID, req.MoveTo)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+	if job == nil {
+		response.Success(ctx)
+		return
+	}
+
+	response.Success(ctx, domainCategoryJobToResponse(job))
+}
+
+// ListCategoryJobs godoc
+// @Summary      获取最近的分类迁移任务
+// @Tags         img-category
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=[]handler.CategoryJobResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/category_jobs [get]
+func (h *HttpHandler) ListCategoryJobs(ctx *gin.Context) {
+	req := new(ListCategoryJobsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ListCategoryJobs(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainCategoryJobsToResponse(res))
+}
+
+// GetCategoryJob godoc
+// @Summary      获取分类迁移任务进度
+// @Tags         img-category
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "任务id"
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=handler.CategoryJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/category_job/{id} [get]
+func (h *HttpHandler) GetCategoryJob(ctx *gin.Context) {
+	req := new(CategoryJobRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.GetCategoryJob(req.TenantID, req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainCategoryJobToResponse(res))
+}
+
+// RetryCategoryJob godoc
+// @Summary      重试失败的分类迁移任务
+// @Description  从剩余未迁移的图片继续执行
+// @Tags         img-category
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "任务id"
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=handler.CategoryJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/category_job/{id}/retry [post]
+func (h *HttpHandler) RetryCategoryJob(ctx *gin.Context) {
+	req := new(CategoryJobRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.RetryCategoryJob(req.TenantID, req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainCategoryJobToResponse(res))
+}
+
+// MoveCategory godoc
+// @Summary      移动图片分类
+// @Description  连同子分类一起移动 分类的存储前缀不变 图片无需迁移
+// @Tags         img-category
+// @Accept       json
+// @Produce      json
+// @Param        id      		path   string  true  "分类id"
+// @Param        tenant_id  path   string  true  "租户id"
+// @Param        request body   handler.MoveCategoryRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/category/{id}/parent [put]
+func (h *HttpHandler) MoveCategory(ctx *gin.Context) {
+	req := new(MoveCategoryRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.MoveCategory(req.TenantID, req.ID, req.ParentID); err != nil {
 		response.Error(ctx, err)
 		return
 	}
@@ -392,33 +694,42 @@ func (h *HttpHandler) AllCategories(ctx *gin.Context) {
 	response.Success(ctx, domainCategoriesToResponse(res))
 }
 
-// SetR2Config godoc
-// @Summary      配置图库R2配置(不包含密钥)
+// SetStorageConfig godoc
+// @Summary      配置图库对象存储(不包含密钥)
+// @Description  provider 为 r2 时需 account_id；为 s3 时需 region，endpoint 为空则使用 AWS 默认端点；为 local 时无需凭证与公共访问前缀
 // @Tags         tenant
 // @Accept       json
 // @Produce      json
 // @Param        tenant_id      path   string  true  "租户id"
-// @Param        request body   handler.SetR2ConfigRequest true "请求参数"
+// @Param        request body   handler.SetStorageConfigRequest true "请求参数"
 // @Success      200 {object} response.successResponse "请求成功"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Security     BearerAuth
-// @Router       /v1/img/{tenant_id}/r2_config [put]
-func (h *HttpHandler) SetR2Config(ctx *gin.Context) {
-	req := new(SetR2ConfigRequest)
+// @Router       /v1/img/{tenant_id}/storage_config [put]
+func (h *HttpHandler) SetStorageConfig(ctx *gin.Context) {
+	req := new(SetStorageConfigRequest)
 	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
 		return
 	}
 
-	config := &domain.R2Config{
+	if req.Provider == "" {
+		req.Provider = domain.StorageProviderR2
+	}
+
+	config := &domain.StorageConfig{
 		TenantID:        req.TenantID,
+		Provider:        req.Provider,
 		AccountID:       req.AccountID,
+		Endpoint:        req.Endpoint,
+		Region:          req.Region,
+		UsePathStyle:    req.UsePathStyle,
 		AccessKeyID:     req.AccessKeyID,
 		PublicBucket:    req.PublicBucket,
 		PublicURLPrefix: req.PublicURLPrefix,
 		DeleteBucket:    req.DeleteBucket,
 	}
 
-	err := h.service.SetR2Config(config)
+	err := h.service.SetStorageConfig(config)
 	if err != nil {
 		response.Error(ctx, err)
 		return
@@ -427,50 +738,50 @@ func (h *HttpHandler) SetR2Config(ctx *gin.Context) {
 	response.Success(ctx)
 }
 
-// GetR2Config godoc
-// @Summary      获取配置图库R2配置
+// GetStorageConfig godoc
+// @Summary      获取图库对象存储配置
 // @Tags         tenant
 // @Accept       json
 // @Produce      json
 // @Param        tenant_id      path   string  true  "租户id"
-// @Success      200 {object} response.successResponse{data=handler.R2ConfigResponse} "请求成功"
+// @Success      200 {object} response.successResponse{data=handler.StorageConfigResponse} "请求成功"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Security     BearerAuth
-// @Router       /v1/img/{tenant_id}/r2_config [get]
-func (h *HttpHandler) GetR2Config(ctx *gin.Context) {
-	req := new(GetR2ConfigRequest)
+// @Router       /v1/img/{tenant_id}/storage_config [get]
+func (h *HttpHandler) GetStorageConfig(ctx *gin.Context) {
+	req := new(GetStorageConfigRequest)
 	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
 		return
 	}
 
-	res, err := h.service.GetR2Config(req.TenantID)
+	res, err := h.service.GetStorageConfig(req.TenantID)
 
-	if err != nil && !errors.Is(err, codes.ErrImgR2ConfigNotFound) {
+	if err != nil && !errors.Is(err, codes.ErrImgStorageConfigNotFound) {
 		response.Error(ctx, err)
 		return
 	}
 
-	response.Success(ctx, domainR2ConfigToResponse(res))
+	response.Success(ctx, domainStorageConfigToResponse(res))
 }
 
-// SetR2Secret godoc
-// @Summary      配置图库R2密钥
+// SetStorageSecret godoc
+// @Summary      配置图库对象存储密钥
 // @Tags         tenant
 // @Accept       json
 // @Produce      json
 // @Param        tenant_id      path   string  true  "租户id"
-// @Param        request body   handler.SetR2SecretAccessKeyRequest true "请求参数"
+// @Param        request body   handler.SetStorageSecretAccessKeyRequest true "请求参数"
 // @Success      200 {object} response.successResponse "请求成功"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Security     BearerAuth
-// @Router       /v1/img/{tenant_id}/r2_config/secret [put]
-func (h *HttpHandler) SetR2Secret(ctx *gin.Context) {
-	req := new(SetR2SecretAccessKeyRequest)
+// @Router       /v1/img/{tenant_id}/storage_config/secret [put]
+func (h *HttpHandler) SetStorageSecret(ctx *gin.Context) {
+	req := new(SetStorageSecretAccessKeyRequest)
 	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
 		return
 	}
 
-	err := h.service.SetR2SecretKey(req.TenantID, req.SecretAccessKey)
+	err := h.service.SetStorageSecretKey(req.TenantID, req.SecretAccessKey)
 	if err != nil {
 		response.Error(ctx, err)
 		return
@@ -479,30 +790,1129 @@ func (h *HttpHandler) SetR2Secret(ctx *gin.Context) {
 	response.Success(ctx)
 }
 
-// IsSetR2Secret godoc
-// @Summary      是否已配置图库R2密钥
+// IsSetStorageSecret godoc
+// @Summary      是否已配置图库对象存储密钥
 // @Tags         tenant
 // @Accept       json
 // @Produce      json
 // @Param        tenant_id      path   string  true  "租户id"
-// @Success      200 {object} response.successResponse{data=handler.IsSetR2SecretResponse} "请求成功"
+// @Success      200 {object} response.successResponse{data=handler.IsSetStorageSecretResponse} "请求成功"
 // @Failure      500 {object} response.errorResponse "服务器错误"
 // @Security     BearerAuth
-// @Router       /v1/img/{tenant_id}/r2_config/secret [get]
-func (h *HttpHandler) IsSetR2Secret(ctx *gin.Context) {
-	req := new(IsSetR2SecretRequest)
+// @Router       /v1/img/{tenant_id}/storage_config/secret [get]
+func (h *HttpHandler) IsSetStorageSecret(ctx *gin.Context) {
+	req := new(IsSetStorageSecretRequest)
 	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
 		return
 	}
 
-	isSet, err := h.service.IsSetR2SecretKey(req.TenantID)
+	isSet, err := h.service.IsSetStorageSecretKey(req.TenantID)
 
 	if err != nil {
 		response.Error(ctx, err)
 		return
 	}
 
-	response.Success(ctx, IsSetR2SecretResponse{
+	response.Success(ctx, IsSetStorageSecretResponse{
 		IsSet: isSet,
 	})
 }
+
+// TestStorageConfig godoc
+// @Summary      测试图库对象存储连接
+// @Description  写入探测对象检查 public_bucket 与 delete_bucket 的写入、读取、删除权限，并通过 public_url_prefix 访问公共桶中的探测对象；不填写 provider 时测试已保存的配置，未填写密钥时沿用已保存的密钥；连接与权限问题记录在报告中，不作为请求错误返回
+// @Tags         tenant
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        request body   handler.TestStorageConfigRequest false "待测试的配置"
+// @Success      200 {object} response.successResponse{data=handler.StorageDiagnosticResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/storage_config/test [post]
+func (h *HttpHandler) TestStorageConfig(ctx *gin.Context) {
+	req := new(TestStorageConfigRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	var config *domain.StorageConfig
+	if req.Provider != "" {
+		config = &domain.StorageConfig{
+			TenantID:        req.TenantID,
+			Provider:        req.Provider,
+			AccountID:       req.AccountID,
+			Endpoint:        req.Endpoint,
+			Region:          req.Region,
+			UsePathStyle:    req.UsePathStyle,
+			AccessKeyID:     req.AccessKeyID,
+			PublicBucket:    req.PublicBucket,
+			PublicURLPrefix: req.PublicURLPrefix,
+			DeleteBucket:    req.DeleteBucket,
+		}
+		config.SetSecretAccessKey(string(req.SecretAccessKey))
+	}
+
+	res, err := h.service.TestStorageConfig(req.TenantID, config)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainStorageDiagnosticToResponse(res))
+}
+
+// CreateStorageMigration godoc
+// @Summary      迁移图库对象存储
+// @Description  异步将当前存储中的全部对象复制到目标存储，逐个校验大小与内容哈希后切换存储配置；切换前失败会删除已复制的对象并保持当前配置，原存储中的对象始终保留；迁移期间无法修改存储配置，删除、恢复、移动图片与修复对象会被拒绝，到期的回收站图片延后删除
+// @Tags         tenant
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        request body   handler.CreateStorageMigrationRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.StorageMigrationResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/storage_migration [post]
+func (h *HttpHandler) CreateStorageMigration(ctx *gin.Context) {
+	req := new(CreateStorageMigrationRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	target := &domain.StorageConfig{
+		TenantID:        req.TenantID,
+		Provider:        req.Provider,
+		AccountID:       req.AccountID,
+		Endpoint:        req.Endpoint,
+		Region:          req.Region,
+		UsePathStyle:    req.UsePathStyle,
+		AccessKeyID:     req.AccessKeyID,
+		PublicBucket:    req.PublicBucket,
+		PublicURLPrefix: req.PublicURLPrefix,
+		DeleteBucket:    req.DeleteBucket,
+	}
+	target.SetSecretAccessKey(string(req.SecretAccessKey))
+
+	res, err := h.service.CreateStorageMigration(target)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainStorageMigrationToResponse(res))
+}
+
+// ListStorageMigrations godoc
+// @Summary      获取最近的存储迁移任务
+// @Tags         tenant
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Success      200 {object} response.successResponse{data=[]handler.StorageMigrationResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/storage_migrations [get]
+func (h *HttpHandler) ListStorageMigrations(ctx *gin.Context) {
+	req := new(ListStorageMigrationsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ListStorageMigrations(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainStorageMigrationsToResponse(res))
+}
+
+// GetStorageMigration godoc
+// @Summary      获取存储迁移任务进度
+// @Tags         tenant
+// @Accept       json
+// @Produce      json
+// @Param        id             path   string  true  "任务id"
+// @Param        tenant_id      path   string  true  "租户id"
+// @Success      200 {object} response.successResponse{data=handler.StorageMigrationResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/storage_migration/{id} [get]
+func (h *HttpHandler) GetStorageMigration(ctx *gin.Context) {
+	req := new(StorageMigrationRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.GetStorageMigration(req.TenantID, req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainStorageMigrationToResponse(res))
+}
+
+// ReadLocalObject godoc
+// @Summary      读取本地存储中的图片
+// @Description  仅 provider 为 local 的租户可用；公共桶可直接访问，其余桶需携带预签名参数
+// @Tags         img
+// @Produce      octet-stream
+// @Param        tenant_id  path   string  true  "租户id"
+// @Param        bucket     path   string  true  "桶"
+// @Param        key        path   string  true  "对象路径"
+// @Param        request    query  handler.ReadLocalObjectRequest false "预签名参数"
+// @Success      200 {file} file "图片内容"
+// @Failure      403 {object} response.errorResponse "签名无效或租户已被封禁"
+// @Failure      404 {object} response.errorResponse "对象不存在"
+// @Router       /v1/img_local/{tenant_id}/{bucket}/{key} [get]
+func (h *HttpHandler) ReadLocalObject(ctx *gin.Context) {
+	req := new(ReadLocalObjectRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	key := strings.TrimPrefix(req.Key, "/")
+	body, err := h.service.ReadLocalObject(req.TenantID, req.Bucket, key, req.Expires, req.Signature)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+	defer body.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	// 对象与 API 同源 禁止嗅探与执行脚本 svg 仅以附件形式下载
+	headers := map[string]string{
+		"X-Content-Type-Options":  "nosniff",
+		"Content-Security-Policy": "default-src 'none'",
+	}
+	if strings.HasPrefix(contentType, "image/svg+xml") {
+		headers["Content-Disposition"] = "attachment"
+	}
+
+	ctx.DataFromReader(http.StatusOK, -1, contentType, body, headers)
+}
+
+// GetStorageUsage godoc
+// @Summary      获取存储用量
+// @Description  按桶汇总的字节数与对象数 以及按分类的明细 分类id为空表示未分类 不含变换缓存与直传暂存对象
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Success      200 {object} response.successResponse{data=handler.StorageUsageResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/usage [get]
+func (h *HttpHandler) GetStorageUsage(ctx *gin.Context) {
+	req := new(GetStorageUsageRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.GetStorageUsage(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainStorageUsageToResponse(res))
+}
+
+// ReconcileStorageUsage godoc
+// @Summary      校准存储用量
+// @Description  列举公共桶与回收站桶中的实际对象并更新用量记录 校准期间的用量变化会保留 对象较多时耗时较长
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Success      200 {object} response.successResponse{data=handler.StorageUsageResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/usage/reconcile [post]
+func (h *HttpHandler) ReconcileStorageUsage(ctx *gin.Context) {
+	req := new(GetStorageUsageRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ReconcileStorageUsage(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainStorageUsageToResponse(res))
+}
+
+// ReconcileObjects godoc
+// @Summary      比对存储桶与图片记录
+// @Description  找出桶中无记录引用的孤立对象与对象缺失的图片记录 最近一小时内变更的对象与记录不参与比对；apply 为 false 时仅报告，为 true 时删除孤立对象，缺失对象在另一个桶中存在时复制回来，否则删除原图缺失的记录或缺失的缩放版本记录
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        request body handler.ReconcileObjectsRequest false "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.ReconcileReportResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/reconcile [post]
+func (h *HttpHandler) ReconcileObjects(ctx *gin.Context) {
+	req := new(ReconcileObjectsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ReconcileObjects(req.TenantID, req.Apply)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainReconcileReportToResponse(res))
+}
+
+// GetImgSetting godoc
+// @Summary      获取图片处理配置
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Success      200 {object} response.successResponse{data=handler.ImgSettingResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/setting [get]
+func (h *HttpHandler) GetImgSetting(ctx *gin.Context) {
+	req := new(GetImgSettingRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.GetImgSetting(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImgSettingToResponse(res))
+}
+
+// SetImgSetting godoc
+// @Summary      配置图片处理
+// @Description  output_format 为 original 时保持原格式；quality 仅对 JPEG 生效，WebP 使用无损编码，转换后体积大于原图时保留原图；variant_widths 为上传时生成的缩放宽度，不会放大小于该宽度的图片；dedup_mode 为上传内容重复时的处理方式：off 不去重，reuse 返回已有图片，link 以新路径共享已有图片的存储对象
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        request body   handler.SetImgSettingRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/setting [put]
+func (h *HttpHandler) SetImgSetting(ctx *gin.Context) {
+	req := new(SetImgSettingRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.SetImgSetting(&domain.ImgSetting{
+		TenantID:      req.TenantID,
+		OutputFormat:  req.OutputFormat,
+		Quality:       req.Quality,
+		VariantWidths: req.VariantWidths,
+		DedupMode:     cmp.Or(req.DedupMode, domain.DefaultDedupMode),
+	}); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// GetWatermarkSetting godoc
+// @Summary      获取水印配置
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Success      200 {object} response.successResponse{data=handler.WatermarkSettingResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/watermark [get]
+func (h *HttpHandler) GetWatermarkSetting(ctx *gin.Context) {
+	req := new(GetWatermarkSettingRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.GetWatermarkSetting(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainWatermarkSettingToResponse(res))
+}
+
+// SetWatermarkSetting godoc
+// @Summary      配置水印
+// @Description  启用后对服务端处理的上传（含导入）加水印，生效分类内的 SVG、AVIF 与动图上传会被拒绝，也不允许申请直传，已上传的图片不受影响；kind 为 text 时使用 text 与 text_color（#RRGGBB，默认 #FFFFFF），为 image 时须先上传水印图片；scale 为水印宽度占图片宽度的百分比；category_ids 为空时对全部图片生效，否则仅对这些分类及其子分类生效；keep_original 为 true 时在回收站桶中保留未加水印的图片，图片彻底删除时一并删除
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        request body   handler.SetWatermarkSettingRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/watermark [put]
+func (h *HttpHandler) SetWatermarkSetting(ctx *gin.Context) {
+	req := new(SetWatermarkSettingRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.SetWatermarkSetting(&domain.WatermarkSetting{
+		TenantID:     req.TenantID,
+		Enabled:      req.Enabled,
+		Kind:         req.Kind,
+		Text:         req.Text,
+		TextColor:    cmp.Or(req.TextColor, domain.DefaultWatermarkTextColor),
+		Position:     req.Position,
+		Opacity:      req.Opacity,
+		Scale:        req.Scale,
+		CategoryIDs:  req.CategoryIDs,
+		KeepOriginal: req.KeepOriginal,
+	}); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// SetWatermarkImage godoc
+// @Summary      上传水印图片
+// @Description  支持 jpeg/png/gif/webp/bmp，最大 1MB，建议使用带透明通道的 PNG；替换后旧图片被删除
+// @Tags         img
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        tenant_id path     string true "租户id"
+// @Param        image     formData file   true "水印图片"
+// @Success      200 {object} response.successResponse{data=handler.WatermarkSettingResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/watermark/image [put]
+func (h *HttpHandler) SetWatermarkImage(ctx *gin.Context) {
+	fileHeader, _ := ctx.FormFile("image")
+	if fileHeader == nil {
+		response.InvalidParams(ctx, errors.New("未携带水印图片"))
+		return
+	}
+
+	req := new(SetWatermarkImageRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if fileHeader.Size > domain.MaxWatermarkImageSize {
+		response.Error(ctx, codes.ErrImgWatermarkInvalid.WithDetail(map[string]any{
+			"size":     fileHeader.Size,
+			"max_size": domain.MaxWatermarkImageSize,
+		}))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(ctx, errors.WithStack(err))
+		return
+	}
+	defer file.Close()
+
+	res, err := h.service.SetWatermarkImage(req.TenantID, file)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainWatermarkSettingToResponse(res))
+}
+
+// CreateTransformURL godoc
+// @Summary      生成图片变换链接
+// @Description  返回带签名的变换链接，匿名用户只能访问已签名的参数组合；w/h 最大 4096，不会放大原图；fit 默认 contain；format 为空时保持原格式；WebP 使用无损编码，format 为 webp 时不可指定 q
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        request body   handler.CreateTransformURLRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.TransformURLResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/transform_url [post]
+func (h *HttpHandler) CreateTransformURL(ctx *gin.Context) {
+	req := new(CreateTransformURLRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	transformURL, err := h.service.TransformURL(
+		req.TenantID,
+		req.ID,
+		transformOptionsFromQuery(req.Width, req.Height, req.Fit, req.Format, req.Quality),
+	)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, TransformURLResponse{URL: transformURL})
+}
+
+// 变换结果在 CDN 与浏览器中的缓存时长 原图变更后最多在该时长内返回旧结果
+const transformCacheControl = "public, max-age=3600"
+
+// Transform godoc
+// @Summary      图片变换
+// @Description  按 URL 参数缩放、裁剪与转换格式，结果会被缓存；链接需由 transform_url 接口签名
+// @Tags         img
+// @Produce      octet-stream
+// @Param        tenant_id  path   string  true  "租户id"
+// @Param        path       path   string  true  "图片路径"
+// @Param        request    query  handler.TransformRequest true "变换参数"
+// @Success      200 {file} file "图片内容"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      403 {object} response.errorResponse "签名无效或租户已被封禁"
+// @Failure      404 {object} response.errorResponse "图片不存在"
+// @Router       /v1/img_transform/{tenant_id}/{path} [get]
+func (h *HttpHandler) Transform(ctx *gin.Context) {
+	req := new(TransformRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.Transform(
+		req.TenantID,
+		strings.TrimPrefix(req.Path, "/"),
+		transformOptionsFromQuery(req.Width, req.Height, req.Fit, req.Format, req.Quality),
+		req.Signature,
+	)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	// 签名链接不含图片版本 原图被替换、删除或恢复后结果会变化 仅允许短期缓存
+	ctx.Header("Cache-Control", transformCacheControl)
+	ctx.Data(http.StatusOK, res.Format.ContentType(), res.Data)
+}
+
+// CreateUploadSlot godoc
+// @Summary      申请直传凭证
+// @Description  校验路径、分类、大小与格式后返回预签名 PUT 链接，客户端直接上传到对象存储后调用确认接口入库；直传图片不经过服务端转码，也不生成缩放版本；svg 须经上传接口清理脚本，不支持直传；分类需要加水印时不允许直传，确认时水印配置已生效的凭证会被作废
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        request body   handler.CreateUploadSlotRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.UploadSlotResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/upload_slot [post]
+func (h *HttpHandler) CreateUploadSlot(ctx *gin.Context) {
+	req := new(CreateUploadSlotRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	// 无path则生成path 扩展名由服务端按申请的格式补全
+	imgPath := strings.TrimSpace(req.Path)
+	if imgPath == "" {
+		imgPath = generateImgPath()
+	}
+
+	res, err := h.service.CreateUploadSlot(&domain.UploadSlot{
+		TenantID:         req.TenantID,
+		CategoryID:       req.CategoryID,
+		Path:             imgPath,
+		Description:      req.Description,
+		OriginalFilename: originalFilename(req.Filename),
+		Format:           domain.ImageFormatFromContentType(req.ContentType),
+		Size:             req.Size,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainPresignedUploadToResponse(res))
+}
+
+// ConfirmUpload godoc
+// @Summary      确认直传
+// @Description  校验对象已上传、大小与文件头一致后入库；分类已需要加水印时删除暂存对象并拒绝入库
+// @Tags         img
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id      path   string  true  "租户id"
+// @Param        id             path   string  true  "直传凭证id"
+// @Success      200 {object} response.successResponse{data=handler.ImgResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      404 {object} response.errorResponse "凭证不存在或已过期"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/upload_slot/{id}/confirm [post]
+func (h *HttpHandler) ConfirmUpload(ctx *gin.Context) {
+	req := new(ConfirmUploadRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ConfirmUpload(req.TenantID, req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImgToResponse(res))
+}
+
+// WriteLocalObject godoc
+// @Summary      本地存储直传
+// @Description  仅 provider 为 local 的租户可用；需携带申请直传凭证时返回的签名，Content-Type 与 Content-Length 须与申请一致
+// @Tags         img
+// @Accept       octet-stream
+// @Produce      json
+// @Param        tenant_id  path   string  true  "租户id"
+// @Param        bucket     path   string  true  "桶"
+// @Param        key        path   string  true  "对象路径"
+// @Param        request    query  handler.WriteLocalObjectRequest true "预签名参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      403 {object} response.errorResponse "签名无效或租户已被封禁"
+// @Router       /v1/img_local/{tenant_id}/{bucket}/{key} [put]
+func (h *HttpHandler) WriteLocalObject(ctx *gin.Context) {
+	req := new(WriteLocalObjectRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	size := ctx.Request.ContentLength
+	if size <= 0 || size > domain.MaxDirectUploadSize {
+		response.InvalidParams(ctx, errors.New("Content-Length 无效"))
+		return
+	}
+
+	if err := h.service.WriteLocalObject(
+		req.TenantID,
+		req.Bucket,
+		strings.TrimPrefix(req.Key, "/"),
+		ctx.GetHeader("Content-Type"),
+		size,
+		req.Expires,
+		req.Signature,
+		ctx.Request.Body,
+	); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// --- 相册 ---
+
+// CreateAlbum godoc
+// @Summary      创建相册
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id    path   string  true  "租户id"
+// @Param        request body handler.CreateAlbumRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.AlbumResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/album [post]
+func (h *HttpHandler) CreateAlbum(ctx *gin.Context) {
+	req := new(CreateAlbumRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	album := &domain.Album{
+		TenantID:    req.TenantID,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+	if err := h.service.CreateAlbum(album); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainAlbumToResponse(album))
+}
+
+// UpdateAlbum godoc
+// @Summary      更新相册
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        id      		path   string  true  "相册id"
+// @Param        tenant_id  path   string  true  "租户id"
+// @Param        request body   handler.UpdateAlbumRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/album/{id} [put]
+func (h *HttpHandler) UpdateAlbum(ctx *gin.Context) {
+	req := new(UpdateAlbumRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.UpdateAlbum(&domain.Album{
+		ID:          req.ID,
+		TenantID:    req.TenantID,
+		Title:       req.Title,
+		Description: req.Description,
+	}); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// DeleteAlbum godoc
+// @Summary      删除相册
+// @Description  相册中的图片不受影响
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "相册id"
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse "删除成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/album/{id} [delete]
+func (h *HttpHandler) DeleteAlbum(ctx *gin.Context) {
+	req := new(AlbumRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.DeleteAlbum(req.TenantID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// AllAlbums godoc
+// @Summary      获取全部相册
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=[]handler.AlbumResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/albums [get]
+func (h *HttpHandler) AllAlbums(ctx *gin.Context) {
+	req := new(AllAlbumsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.AllAlbums(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainAlbumsToResponse(res))
+}
+
+// AddAlbumImgs godoc
+// @Summary      添加图片到相册
+// @Description  按请求顺序追加到相册末尾 已在相册中的图片保持原位置
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "相册id"
+// @Param        tenant_id path string true "租户id"
+// @Param        request body handler.AlbumImgsRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/album/{id}/imgs [post]
+func (h *HttpHandler) AddAlbumImgs(ctx *gin.Context) {
+	req := new(AlbumImgsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.AddAlbumImgs(req.TenantID, req.ID, req.ImgIDs); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// RemoveAlbumImgs godoc
+// @Summary      从相册移除图片
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "相册id"
+// @Param        tenant_id path string true "租户id"
+// @Param        request body handler.AlbumImgsRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/album/{id}/imgs [delete]
+func (h *HttpHandler) RemoveAlbumImgs(ctx *gin.Context) {
+	req := new(AlbumImgsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.RemoveAlbumImgs(req.TenantID, req.ID, req.ImgIDs); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// MoveAlbumImg godoc
+// @Summary      调整图片在相册中的顺序
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "相册id"
+// @Param        img_id path string true "图片id"
+// @Param        tenant_id path string true "租户id"
+// @Param        request body handler.MoveAlbumImgRequest true "请求参数"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/album/{id}/imgs/{img_id}/position [put]
+func (h *HttpHandler) MoveAlbumImg(ctx *gin.Context) {
+	req := new(MoveAlbumImgRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	if err := h.service.MoveAlbumImg(req.TenantID, req.ID, req.ImgID, req.BeforeID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListAlbumImgs godoc
+// @Summary      相册图片列表
+// @Description  按相册顺序分页 回收站中的图片不展示
+// @Tags         img-album
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "相册id"
+// @Param        tenant_id path string true "租户id"
+// @Param        request query handler.ListAlbumImgsRequest false "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.ListByKeysetResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/album/{id}/imgs [get]
+func (h *HttpHandler) ListAlbumImgs(ctx *gin.Context) {
+	req := new(ListAlbumImgsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	list, err := h.service.ListAlbumImgs(&domain.ListAlbumImgsQuery{
+		TenantID:   req.TenantID,
+		AlbumID:    req.ID,
+		PrevCursor: req.PrevCursor,
+		NextCursor: req.NextCursor,
+		PageSize:   req.PageSize,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImgKeysetToResponse(list))
+}
+
+// CreateURLImport godoc
+// @Summary      从远程地址导入图片
+// @Description  异步逐个下载并按普通上传流程导入到指定分类，仅允许 http/https 公网地址，单个文件最大 50MB，每个任务最多 500 个地址，重复地址只导入一次；图片路径取自文件名，与已有路径重复时追加随机后缀
+// @Tags         img-import
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Param        request body handler.CreateURLImportRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.ImportJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/import/urls [post]
+func (h *HttpHandler) CreateURLImport(ctx *gin.Context) {
+	req := new(CreateURLImportRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.CreateURLImport(req.TenantID, req.CategoryID, req.URLs)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImportJobToResponse(res))
+}
+
+// CreateZipImport godoc
+// @Summary      从压缩包导入图片
+// @Description  上传 ZIP 压缩包（最大 500MB）后异步解压导入，目录、隐藏文件与 __MACOSX 被忽略，每个压缩包最多 500 个文件，单个文件最大 50MB
+// @Tags         img-import
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        tenant_id   path     string true  "租户id"
+// @Param        archive     formData file   true  "ZIP 压缩包"
+// @Param        category_id formData string false "分类id"
+// @Success      200 {object} response.successResponse{data=handler.ImportJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/import/zip [post]
+func (h *HttpHandler) CreateZipImport(ctx *gin.Context) {
+	fileHeader, _ := ctx.FormFile("archive")
+	if fileHeader == nil {
+		response.InvalidParams(ctx, errors.New("未携带压缩包"))
+		return
+	}
+
+	req := new(CreateZipImportRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response.Error(ctx, errors.WithStack(err))
+		return
+	}
+	defer file.Close()
+
+	// 仅校验文件头 完整解析在任务执行时进行
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(file, header); err != nil || !bytes.Equal(header, []byte("PK\x03\x04")) {
+		response.Error(ctx, codes.ErrImgImportArchiveInvalid)
+		return
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		response.Error(ctx, errors.WithStack(err))
+		return
+	}
+
+	res, err := h.service.CreateZipImport(req.TenantID, req.CategoryID, file, fileHeader.Size)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImportJobToResponse(res))
+}
+
+// ListImportJobs godoc
+// @Summary      获取最近的导入任务
+// @Tags         img-import
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=[]handler.ImportJobResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/imports [get]
+func (h *HttpHandler) ListImportJobs(ctx *gin.Context) {
+	req := new(ListImportJobsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ListImportJobs(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImportJobsToResponse(res))
+}
+
+// GetImportJob godoc
+// @Summary      获取导入任务进度与逐个文件的结果
+// @Tags         img-import
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "任务id"
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=handler.ImportJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/import/{id} [get]
+func (h *HttpHandler) GetImportJob(ctx *gin.Context) {
+	req := new(ImportJobRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.GetImportJob(req.TenantID, req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImportJobToResponse(res))
+}
+
+// RetryImportJob godoc
+// @Summary      重试失败的导入任务
+// @Description  仅重新导入失败的文件；压缩包导入失败后压缩包保留 7 天，过期后无法重试
+// @Tags         img-import
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "任务id"
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=handler.ImportJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/import/{id}/retry [post]
+func (h *HttpHandler) RetryImportJob(ctx *gin.Context) {
+	req := new(ImportJobRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.RetryImportJob(req.TenantID, req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainImportJobToResponse(res))
+}
+
+// CreateExport godoc
+// @Summary      导出图片为 ZIP
+// @Description  异步将筛选的图片打包为 ZIP，图库图片位于 images/ 目录，回收站图片位于 recycle/ 目录，元数据清单为 manifest.json；每次最多导出 10000 张，压缩包保留 24 小时，完成后通过任务详情获取下载链接
+// @Tags         img-export
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Param        request body handler.CreateExportRequest true "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.ExportJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/export [post]
+func (h *HttpHandler) CreateExport(ctx *gin.Context) {
+	req := new(CreateExportRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.CreateExport(&domain.ExportJob{
+		TenantID:             req.TenantID,
+		CategoryID:           req.CategoryID,
+		IncludeSubcategories: req.IncludeSubcategories,
+		TagIDs:               req.TagIDs,
+		IncludeDeleted:       req.IncludeDeleted,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainExportJobToResponse(res))
+}
+
+// ListExportJobs godoc
+// @Summary      获取最近的导出任务
+// @Tags         img-export
+// @Accept       json
+// @Produce      json
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=[]handler.ExportJobResponse} "请求成功"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/exports [get]
+func (h *HttpHandler) ListExportJobs(ctx *gin.Context) {
+	req := new(ListExportJobsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.ListExportJobs(req.TenantID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainExportJobsToResponse(res))
+}
+
+// GetExportJob godoc
+// @Summary      获取导出任务进度
+// @Description  导出完成且未过期时返回预签名下载链接，链接有效期最长 1 小时，过期后重新获取即可
+// @Tags         img-export
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "任务id"
+// @Param        tenant_id path string true "租户id"
+// @Success      200 {object} response.successResponse{data=handler.ExportJobResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Security     BearerAuth
+// @Router       /v1/img/{tenant_id}/export/{id} [get]
+func (h *HttpHandler) GetExportJob(ctx *gin.Context) {
+	req := new(ExportJobRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	res, err := h.service.GetExportJob(req.TenantID, req.ID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainExportJobToResponse(res))
+}
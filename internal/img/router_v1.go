@@ -12,7 +12,21 @@ func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 	{
 	}
 
-	protect := g.Use(auth.JWTValidate(), auth.CasbinValited())
+	// 本地存储的对象访问 公共桶无需登录 其余桶依赖预签名 已封禁的租户不再提供访问
+	local := r.Group("/v1/img_local/:tenant_id", auth.TenantActiveValidate())
+	{
+		local.GET("/:bucket/*key", handler.ReadLocalObject)
+		// 直传 依赖上传签名
+		local.PUT("/:bucket/*key", handler.WriteLocalObject)
+	}
+
+	// 图片变换 依赖签名防止匿名用户生成任意尺寸 已封禁的租户不再提供访问
+	transform := r.Group("/v1/img_transform/:tenant_id", auth.TenantActiveValidate())
+	{
+		transform.GET("/*path", handler.Transform)
+	}
+
+	protect := g.Use(auth.JWTValidate(), auth.RequireScope("img"), auth.CasbinValited())
 	{
 		// 如果上传文件过大 可能导致连接重置 后端解决方案如下
 		//g.POST("/upload",middlewares.FullRequest() ,auth.Validate(), handler.Upload)
@@ -20,24 +34,88 @@ func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 		// 当前前端解决方案为上传时刷新token 这样可以有效避免服务端的资源浪费
 		protect.POST("/upload", handler.Upload)
 
+		// 直传: 申请预签名链接 -> 客户端上传到对象存储 -> 确认入库 文件不经过本服务
+		protect.POST("/upload_slot", handler.CreateUploadSlot)
+		protect.POST("/upload_slot/:id/confirm", handler.ConfirmUpload)
+
 		protect.DELETE("/:id", handler.Delete)
+		protect.POST("/bulk", handler.Bulk)
 		protect.GET("", handler.ListByKeyset)
 
 		// 回收站
 		protect.DELETE("/recycle/:id", handler.ClearRecycleBin)
 		protect.PUT("/recycle/:id", handler.RestoreFromRecycleBin)
+		protect.GET("/recycle/failed", handler.ListDeleteDeadLetters)
 
 		// 分类
 		protect.POST("/category", handler.CreateCategory)
 		protect.DELETE("/category/:id", handler.DeleteCategory)
 		protect.PUT("/category/:id", handler.UpdateCategory)
+		protect.PUT("/category/:id/parent", handler.MoveCategory)
 		protect.GET("/categories", handler.AllCategories)
+		protect.GET("/category_jobs", handler.ListCategoryJobs)
+		protect.GET("/category_job/:id", handler.GetCategoryJob)
+		protect.POST("/category_job/:id/retry", handler.RetryCategoryJob)
+
+		// 相册
+		protect.POST("/album", handler.CreateAlbum)
+		protect.PUT("/album/:id", handler.UpdateAlbum)
+		protect.DELETE("/album/:id", handler.DeleteAlbum)
+		protect.GET("/albums", handler.AllAlbums)
+		protect.GET("/album/:id/imgs", handler.ListAlbumImgs)
+		protect.POST("/album/:id/imgs", handler.AddAlbumImgs)
+		protect.DELETE("/album/:id/imgs", handler.RemoveAlbumImgs)
+		protect.PUT("/album/:id/imgs/:img_id/position", handler.MoveAlbumImg)
+
+		// 导入
+		protect.POST("/import/urls", handler.CreateURLImport)
+		protect.POST("/import/zip", handler.CreateZipImport)
+		protect.GET("/imports", handler.ListImportJobs)
+		protect.GET("/import/:id", handler.GetImportJob)
+		protect.POST("/import/:id/retry", handler.RetryImportJob)
+
+		// 导出
+		protect.POST("/export", handler.CreateExport)
+		protect.GET("/exports", handler.ListExportJobs)
+		protect.GET("/export/:id", handler.GetExportJob)
+
+		// 标签
+		protect.POST("/tag", handler.CreateTag)
+		protect.DELETE("/tag/:id", handler.DeleteTag)
+		protect.GET("/tags", handler.AllTags)
+		protect.PUT("/:id/tags", handler.SetImgTags)
 
 		// 图库配置
-		protect.PUT("/r2_config", handler.SetR2Config)
-		protect.GET("/r2_config", handler.GetR2Config)
-		protect.PUT("/r2_config/secret", handler.SetR2Secret)
-		protect.GET("/r2_config/secret", handler.IsSetR2Secret)
+		protect.PUT("/storage_config", handler.SetStorageConfig)
+		protect.GET("/storage_config", handler.GetStorageConfig)
+		protect.PUT("/storage_config/secret", handler.SetStorageSecret)
+		protect.GET("/storage_config/secret", handler.IsSetStorageSecret)
+		protect.POST("/storage_config/test", handler.TestStorageConfig)
+		// 旧路径 保留以兼容已有客户端
+		protect.PUT("/r2_config", handler.SetStorageConfig)
+		protect.GET("/r2_config", handler.GetStorageConfig)
+		protect.PUT("/r2_config/secret", handler.SetStorageSecret)
+		protect.GET("/r2_config/secret", handler.IsSetStorageSecret)
+		protect.POST("/storage_migration", handler.CreateStorageMigration)
+		protect.GET("/storage_migrations", handler.ListStorageMigrations)
+		protect.GET("/storage_migration/:id", handler.GetStorageMigration)
+
+		// 存储用量
+		protect.GET("/usage", handler.GetStorageUsage)
+		protect.POST("/usage/reconcile", handler.ReconcileStorageUsage)
+
+		// 存储桶与图片记录比对
+		protect.POST("/reconcile", handler.ReconcileObjects)
+
+		// 图片处理配置
+		protect.GET("/setting", handler.GetImgSetting)
+		protect.PUT("/setting", handler.SetImgSetting)
+		protect.GET("/watermark", handler.GetWatermarkSetting)
+		protect.PUT("/watermark", handler.SetWatermarkSetting)
+		protect.PUT("/watermark/image", handler.SetWatermarkImage)
+
+		// 图片变换
+		protect.POST("/transform_url", handler.CreateTransformURL)
 	}
 
 	go func() {
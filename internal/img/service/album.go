@@ -0,0 +1,137 @@
+package service
+
+import (
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"slices"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	maxAlbum = 100
+	// maxAlbumImgs 单个相册的图片上限
+	maxAlbumImgs = 10000
+)
+
+func (s *service) CreateAlbum(album *domain.Album) error {
+	if _, err := s.repo.FindAlbumByTitle(album.TenantID, album.Title); err == nil {
+		return codes.ErrImgAlbumTitleRepeat
+	} else if !errors.Is(err, codes.ErrImgAlbumNotFound) {
+		return err
+	}
+
+	count, err := s.repo.CountAlbums(album.TenantID)
+	if err != nil {
+		return err
+	}
+	if count >= maxAlbum {
+		return codes.ErrImgAlbumTooMany
+	}
+
+	return s.repo.CreateAlbum(album)
+}
+
+func (s *service) UpdateAlbum(album *domain.Album) error {
+	stored, err := s.repo.FindAlbumByTitle(album.TenantID, album.Title)
+	if err != nil && !errors.Is(err, codes.ErrImgAlbumNotFound) {
+		return err
+	}
+	if stored != nil && stored.ID != album.ID {
+		return codes.ErrImgAlbumTitleRepeat
+	}
+
+	return s.repo.UpdateAlbum(album)
+}
+
+// DeleteAlbum 仅删除相册 相册中的图片不受影响
+func (s *service) DeleteAlbum(tenantID domain.TenantID, albumID domain.AlbumID) error {
+	return s.repo.DeleteAlbum(tenantID, albumID)
+}
+
+func (s *service) AllAlbums(tenantID domain.TenantID) ([]*domain.Album, error) {
+	return s.repo.AllAlbums(tenantID)
+}
+
+// AddAlbumImgs 按请求顺序追加到相册末尾 图片须属于同一租户且未删除
+func (s *service) AddAlbumImgs(tenantID domain.TenantID, albumID domain.AlbumID, imgIDs []domain.ImgID) error {
+	if _, err := s.repo.FindAlbum(tenantID, albumID); err != nil {
+		return err
+	}
+
+	// 去重并保持顺序
+	ids := make([]domain.ImgID, 0, len(imgIDs))
+	for _, id := range imgIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	count, err := s.repo.CountImgsByIDs(tenantID, ids...)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(ids)) {
+		return codes.ErrImgNotFound
+	}
+
+	// 已在相册中的图片不会重复添加 此处按上限粗略校验
+	total, err := s.repo.CountAlbumImgs(albumID)
+	if err != nil {
+		return err
+	}
+	if total+int64(len(ids)) > maxAlbumImgs {
+		return codes.ErrImgAlbumImgTooMany
+	}
+
+	return s.repo.AddAlbumImgs(albumID, ids)
+}
+
+func (s *service) RemoveAlbumImgs(tenantID domain.TenantID, albumID domain.AlbumID, imgIDs []domain.ImgID) error {
+	if _, err := s.repo.FindAlbum(tenantID, albumID); err != nil {
+		return err
+	}
+
+	return s.repo.RemoveAlbumImgs(albumID, imgIDs)
+}
+
+// MoveAlbumImg 调整图片在相册中的顺序 beforeImgID 为空表示移动到末尾
+func (s *service) MoveAlbumImg(tenantID domain.TenantID, albumID domain.AlbumID, imgID, beforeImgID domain.ImgID) error {
+	if _, err := s.repo.FindAlbum(tenantID, albumID); err != nil {
+		return err
+	}
+	if imgID == beforeImgID {
+		return nil
+	}
+
+	return s.repo.MoveAlbumImg(albumID, imgID, beforeImgID)
+}
+
+// ListAlbumImgs 按相册顺序分页列出图片 回收站中的图片不展示
+func (s *service) ListAlbumImgs(query *domain.ListAlbumImgsQuery) (*domain.ListByKeysetResult, error) {
+	if _, err := s.repo.FindAlbum(query.TenantID, query.AlbumID); err != nil {
+		return nil, err
+	}
+
+	storage, err := s.getTenantStorage(query.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.ListAlbumImgs(query)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachVariants(res.Items...); err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(res.Items...); err != nil {
+		return nil, err
+	}
+
+	for i := range res.Items {
+		res.Items[i].SetPublicPreURL(storage.publicURLPrefix)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"path"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// bulkConcurrency 批量操作的并发数 避免同时占用过多对象存储连接
+const bulkConcurrency = 8
+
+// Bulk 以有限并发逐张执行批量操作 单张失败不影响其他图片 结果顺序与去重后的 ImgIDs 一致
+// 每张图片的操作与单张接口相同 持有同一把图片锁
+func (s *service) Bulk(op *domain.BulkOperation) ([]*domain.BulkResult, error) {
+	ids := make([]domain.ImgID, 0, len(op.ImgIDs))
+	for _, id := range op.ImgIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > domain.MaxBulkImgs {
+		return nil, codes.ErrImgIllegalOperation.WithDetail(map[string]any{"max": domain.MaxBulkImgs})
+	}
+
+	var handle func(imgID domain.ImgID) error
+	switch op.Action {
+	case domain.BulkActionDelete:
+		handle = func(imgID domain.ImgID) error { return s.Delete(op.TenantID, imgID) }
+	case domain.BulkActionHardDelete:
+		handle = func(imgID domain.ImgID) error { return s.Delete(op.TenantID, imgID, true) }
+	case domain.BulkActionRestore:
+		handle = func(imgID domain.ImgID) error { return s.RestoreFromRecycleBin(op.TenantID, imgID) }
+	case domain.BulkActionClear:
+		handle = func(imgID domain.ImgID) error { return s.ClearRecycleBin(op.TenantID, imgID) }
+	case domain.BulkActionMove:
+		// 目标分类只需校验一次
+		if op.CategoryID != "" {
+			if _, err := s.repo.FindCategoryByID(op.TenantID, op.CategoryID); err != nil {
+				return nil, err
+			}
+		}
+		handle = func(imgID domain.ImgID) error { return s.MoveToCategory(op.TenantID, imgID, op.CategoryID) }
+	default:
+		return nil, codes.ErrImgIllegalOperation.WithDetail(map[string]any{"action": op.Action})
+	}
+
+	results := make([]*domain.BulkResult, len(ids))
+	sem := make(chan struct{}, bulkConcurrency)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			results[i] = &domain.BulkResult{ImgID: id, Err: handle(id)}
+		})
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// MoveToCategory 将图片移动到其他分类 categoryID 为空表示移出分类
+// 存储对象与其他图片共享时仅更新记录 对象保留在原路径 否则将原图及缩放版本复制到新路径后删除旧对象
+func (s *service) MoveToCategory(tenantID domain.TenantID, imgID domain.ImgID, categoryID domain.CategoryID) error {
+	unlock, token, err := s.lockImg(imgID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// 迁移只同步新增对象 期间移走的旧对象会残留在目标存储中
+	if err := s.checkStorageMigrationIdle(tenantID); err != nil {
+		return err
+	}
+
+	img, err := s.repo.FindByID(tenantID, imgID)
+	if err != nil {
+		return err
+	}
+	if img.IsDeleted() {
+		return codes.ErrImgIllegalOperation
+	}
+	if img.CategoryID == categoryID {
+		return nil
+	}
+
+	// 1.去掉原分类前缀 拼接新分类前缀
+	prefix := ""
+	if categoryID != "" {
+		category, err := s.repo.FindCategoryByID(tenantID, categoryID)
+		if err != nil {
+			return err
+		}
+		prefix = category.Prefix
+	}
+	newPath := categoryPath(prefix, imgName(img))
+
+	exist, err := s.repo.ExistByPath(tenantID, newPath)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return codes.ErrImgPathRepeat.WithDetail(map[string]any{"path": newPath})
+	}
+
+	if err := s.attachVariants(img); err != nil {
+		return err
+	}
+
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return err
+	}
+
+	return s.relocate(storage, img, categoryID, newPath, token)
+}
+
+// imgName 去掉分类前缀后的图片路径 前缀为 slug 不含 /
+// 分类前缀修改后 迁移完成前图片仍位于旧前缀下 因此不依赖分类当前的前缀
+func imgName(img *domain.Img) string {
+	if img.CategoryID == "" {
+		return img.Path
+	}
+	if _, name, ok := strings.Cut(img.Path, "/"); ok {
+		return name
+	}
+	return img.Path
+}
+
+func categoryPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "/" + name
+}
+
+// relocate 将图片迁移到新分类与新路径 调用方须持有图片锁并加载缩放版本 token 为图片锁的令牌
+// 未删除的图片在公共桶内迁移 回收站中的图片在 deleteBucket 内迁移
+func (s *service) relocate(storage *tenantStorage, img *domain.Img, categoryID domain.CategoryID, newPath string, token domain.FencingToken) error {
+	bucket, kind := storage.publicBucket, domain.StorageBucketPublic
+	if img.IsDeleted() {
+		bucket, kind = storage.deleteBucket, domain.StorageBucketDelete
+	}
+
+	unlockObject, err := s.lockObject(img.TenantID, img.ObjectPath)
+	if err != nil {
+		return err
+	}
+	defer unlockObject()
+
+	shared, err := s.isObjectShared(img, img.IsDeleted())
+	if err != nil {
+		return err
+	}
+
+	moved := *img
+	moved.CategoryID = categoryID
+	moved.Path = newPath
+
+	// 1.共享存储对象 仅更新记录
+	if img.IsLinked() || shared {
+		if err := s.repo.UpdateImgLocation(&moved, token); err != nil {
+			return err
+		}
+		s.recordUsage(img, kind, -1)
+		s.recordUsage(&moved, kind, 1)
+		return nil
+	}
+
+	// 2.复制原图及缩放版本到新路径 缩放版本路径随原图路径变化
+	oldBase := strings.TrimSuffix(img.Path, path.Ext(img.Path))
+	newBase := strings.TrimSuffix(newPath, path.Ext(newPath))
+	moved.ObjectPath = newPath
+	moved.Variants = make([]*domain.ImgVariant, 0, len(img.Variants))
+	for _, variant := range img.Variants {
+		movedVariant := *variant
+		if strings.HasPrefix(variant.Path, oldBase) {
+			movedVariant.Path = newBase + strings.TrimPrefix(variant.Path, oldBase)
+		}
+		moved.Variants = append(moved.Variants, &movedVariant)
+	}
+
+	oldPaths := img.ObjectPaths()
+	newPaths := moved.ObjectPaths()
+	for i := range oldPaths {
+		if err := storage.storage.Copy(bucket, oldPaths[i], bucket, newPaths[i]); err != nil {
+			s.discardObjects(storage, bucket, newPaths[:i])
+			return errors.WithStack(err)
+		}
+	}
+
+	// 3.更新记录 失败则清理新对象
+	if err := s.repo.UpdateImgLocation(&moved, token); err != nil {
+		s.discardObjects(storage, bucket, newPaths)
+		return err
+	}
+
+	// 4.删除旧对象与变换缓存 失败仅记录日志 由对象比对清理
+	s.discardObjects(storage, bucket, oldPaths)
+	if !img.IsDeleted() {
+		s.purgeTransformCache(storage, img)
+	}
+
+	s.recordUsage(img, kind, -1)
+	s.recordUsage(&moved, kind, 1)
+
+	return nil
+}
+
+// discardObjects 删除桶中的对象 失败仅记录日志
+func (s *service) discardObjects(storage *tenantStorage, bucket string, paths []string) {
+	for _, p := range paths {
+		if err := storage.storage.Delete(bucket, p); err != nil {
+			zap.L().Error("删除存储对象失败",
+				zap.String("bucket", bucket),
+				zap.String("path", p),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// categoryJobPollInterval 兜底轮询间隔 创建任务时会立即唤醒
+	categoryJobPollInterval = time.Minute
+	// categoryJobLease 运行中任务的心跳超时 超时后视为实例中断 可被重新认领
+	categoryJobLease    = 5 * time.Minute
+	maxListCategoryJobs = 20
+)
+
+// DeleteCategoryAndMove 将分类下的图片移动到目标分类后删除分类 targetID 为空表示移出分类
+// 分类下无图片时直接删除 返回的任务为空
+func (s *service) DeleteCategoryAndMove(tenantID domain.TenantID, categoryID, targetID domain.CategoryID) (*domain.CategoryJob, error) {
+	if categoryID == targetID {
+		return nil, codes.ErrImgIllegalOperation
+	}
+	if _, err := s.repo.FindCategoryByID(tenantID, categoryID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategoryNoChildren(tenantID, categoryID); err != nil {
+		return nil, err
+	}
+	if targetID != "" {
+		if _, err := s.repo.FindCategoryByID(tenantID, targetID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range []domain.CategoryID{categoryID, targetID} {
+		if id == "" {
+			continue
+		}
+		if err := s.checkCategoryJobIdle(tenantID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.repo.IsCategoryExistImg(tenantID, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if !existing {
+		return nil, s.repo.DeleteCategory(tenantID, categoryID)
+	}
+
+	return s.createCategoryJob(&domain.CategoryJob{
+		TenantID:         tenantID,
+		CategoryID:       categoryID,
+		Kind:             domain.CategoryJobKindDeleteMove,
+		TargetCategoryID: targetID,
+	})
+}
+
+func (s *service) GetCategoryJob(tenantID domain.TenantID, jobID domain.CategoryJobID) (*domain.CategoryJob, error) {
+	return s.repo.FindCategoryJob(tenantID, jobID)
+}
+
+func (s *service) ListCategoryJobs(tenantID domain.TenantID) ([]*domain.CategoryJob, error) {
+	return s.repo.ListCategoryJobs(tenantID, maxListCategoryJobs)
+}
+
+// RetryCategoryJob 重新执行失败的任务 从剩余未迁移的图片继续
+func (s *service) RetryCategoryJob(tenantID domain.TenantID, jobID domain.CategoryJobID) (*domain.CategoryJob, error) {
+	job, err := s.repo.FindCategoryJob(tenantID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status.IsActive() {
+		return nil, codes.ErrImgCategoryJobRunning
+	}
+	if job.Status != domain.JobStatusFailed {
+		return nil, codes.ErrImgIllegalOperation
+	}
+
+	for _, id := range []domain.CategoryID{job.CategoryID, job.TargetCategoryID} {
+		if id == "" {
+			continue
+		}
+		if err := s.checkCategoryJobIdle(tenantID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	job.Status = domain.JobStatusPending
+	job.FinishedAt = time.Time{}
+	if err := s.repo.UpdateCategoryJob(job); err != nil {
+		return nil, err
+	}
+	s.notifyCategoryJobs()
+
+	return job, nil
+}
+
+// checkCategoryJobIdle 分类作为来源或目标存在未完成的迁移任务时返回错误
+func (s *service) checkCategoryJobIdle(tenantID domain.TenantID, categoryID domain.CategoryID) error {
+	active, err := s.repo.ExistActiveCategoryJob(tenantID, categoryID)
+	if err != nil {
+		return err
+	}
+	if active {
+		return codes.ErrImgCategoryJobRunning
+	}
+	return nil
+}
+
+func (s *service) createCategoryJob(job *domain.CategoryJob) (*domain.CategoryJob, error) {
+	job.Status = domain.JobStatusPending
+	if err := s.repo.CreateCategoryJob(job); err != nil {
+		return nil, err
+	}
+	s.notifyCategoryJobs()
+
+	return job, nil
+}
+
+// notifyCategoryJobs 唤醒本实例的任务循环 已有待处理的唤醒时忽略
+func (s *service) notifyCategoryJobs() {
+	select {
+	case s.categoryJobNotify <- struct{}{}:
+	default:
+	}
+}
+
+// runCategoryJobs 逐个认领并执行分类迁移任务 多实例部署时由数据库保证同一任务只被一个实例执行
+func (s *service) runCategoryJobs() {
+	ticker := time.NewTicker(categoryJobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			// 每次只认领一个任务 避免排队中的任务心跳超时被其他实例重复认领
+			jobs, err := s.repo.ClaimCategoryJobs(time.Now().Add(-categoryJobLease), 1)
+			if err != nil {
+				zap.L().Error("认领分类迁移任务失败", zap.Error(err))
+				break
+			}
+			if len(jobs) == 0 {
+				break
+			}
+			s.runCategoryJob(jobs[0])
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.categoryJobNotify:
+		}
+	}
+}
+
+// runCategoryJob 迁移剩余的图片 已迁移的图片不再处理 因此中断或失败后重新执行即可继续
+func (s *service) runCategoryJob(job *domain.CategoryJob) {
+	err := s.migrateCategory(job)
+	if err == nil && job.Failed > 0 {
+		err = errors.Errorf("%d 张图片迁移失败", job.Failed)
+	}
+
+	// delete_move 全部迁移完成后删除分类 迁移期间新上传的图片留待重试时处理
+	if err == nil && job.Kind == domain.CategoryJobKindDeleteMove {
+		err = s.isCategoryExistImg(job.TenantID, job.CategoryID)
+		if err == nil {
+			err = s.checkCategoryNoChildren(job.TenantID, job.CategoryID)
+		}
+		if err == nil {
+			err = s.repo.DeleteCategory(job.TenantID, job.CategoryID)
+			if errors.Is(err, codes.ErrImgCategoryNotFound) {
+				err = nil
+			}
+		}
+	}
+
+	job.Status = domain.JobStatusSucceeded
+	if err != nil {
+		job.Status = domain.JobStatusFailed
+		job.LastError = err.Error()
+		zap.L().Error("分类迁移任务失败",
+			zap.String("job_id", job.ID.String()),
+			zap.String("tenant_id", job.TenantID.String()),
+			zap.Error(err),
+		)
+	}
+	job.FinishedAt = time.Now()
+
+	if err := s.repo.UpdateCategoryJob(job); err != nil {
+		zap.L().Error("更新分类迁移任务状态失败",
+			zap.String("job_id", job.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *service) migrateCategory(job *domain.CategoryJob) error {
+	// 存储迁移期间移动的对象会残留在目标存储中 任务失败后待迁移完成重试
+	if err := s.checkStorageMigrationIdle(job.TenantID); err != nil {
+		return err
+	}
+
+	// 1.确定目标分类与前缀
+	targetID := job.CategoryID
+	if job.Kind == domain.CategoryJobKindDeleteMove {
+		targetID = job.TargetCategoryID
+	}
+	prefix := ""
+	if targetID != "" {
+		category, err := s.repo.FindCategoryByID(job.TenantID, targetID)
+		if err != nil {
+			return err
+		}
+		prefix = category.Prefix
+	}
+
+	storage, err := s.getTenantStorage(job.TenantID)
+	if err != nil {
+		return err
+	}
+
+	// 2.筛选剩余未迁移的图片 重新统计本轮进度
+	imgs, err := s.repo.ListImgsByCategory(job.TenantID, job.CategoryID)
+	if err != nil {
+		return err
+	}
+	remaining := make([]domain.ImgID, 0, len(imgs))
+	for _, img := range imgs {
+		if job.Kind == domain.CategoryJobKindRename && strings.HasPrefix(img.Path, prefix+"/") {
+			continue
+		}
+		remaining = append(remaining, img.ID)
+	}
+	job.Total = job.Processed + len(remaining)
+	job.Failed = 0
+
+	// 3.逐张迁移 每张完成后更新进度 同时作为心跳
+	for _, imgID := range remaining {
+		if err := s.migrateCategoryImg(storage, job, imgID, targetID, prefix); err != nil {
+			job.Failed++
+			job.LastError = err.Error()
+			zap.L().Error("迁移分类图片失败",
+				zap.String("job_id", job.ID.String()),
+				zap.String("img_id", imgID.String()),
+				zap.Error(err),
+			)
+		} else {
+			job.Processed++
+		}
+
+		if err := s.repo.UpdateCategoryJob(job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *service) migrateCategoryImg(storage *tenantStorage, job *domain.CategoryJob, imgID domain.ImgID, targetID domain.CategoryID, prefix string) error {
+	unlock, token, err := s.lockImg(imgID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := s.checkStorageMigrationIdle(job.TenantID); err != nil {
+		return err
+	}
+
+	// 加锁后重新读取 期间图片可能已被删除或移动到其他分类
+	img, err := s.repo.FindByID(job.TenantID, imgID, true)
+	if err != nil {
+		if errors.Is(err, codes.ErrImgNotFound) {
+			return nil
+		}
+		return err
+	}
+	if img.CategoryID != job.CategoryID {
+		return nil
+	}
+
+	newPath := categoryPath(prefix, imgName(img))
+	if newPath == img.Path && img.CategoryID == targetID {
+		return nil
+	}
+
+	exist, err := s.repo.ExistByPath(job.TenantID, newPath)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return codes.ErrImgPathRepeat.WithDetail(map[string]any{"path": newPath})
+	}
+
+	if err := s.attachVariants(img); err != nil {
+		return err
+	}
+
+	return s.relocate(storage, img, targetID, newPath, token)
+}
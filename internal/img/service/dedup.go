@@ -0,0 +1,97 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+
+	"github.com/pkg/errors"
+)
+
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// findDuplicate 查询内容相同的图片 未开启去重或无重复时返回 nil
+func (s *service) findDuplicate(tenantID domain.TenantID, hash string, mode domain.DedupMode) (*domain.Img, error) {
+	if mode == domain.DedupModeOff {
+		return nil, nil
+	}
+
+	duplicate, err := s.repo.FindByContentHash(tenantID, hash)
+	if err != nil {
+		if errors.Is(err, codes.ErrImgNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := s.attachVariants(duplicate); err != nil {
+		return nil, err
+	}
+
+	return duplicate, nil
+}
+
+// linkDuplicate 以新路径创建记录 共享已有图片的存储对象与缩放版本
+// 加锁后已有图片已被删除时返回 nil 调用方按普通上传处理
+func (s *service) linkDuplicate(img *domain.Img, categoryID domain.CategoryID, source *domain.Img) (*domain.Img, error) {
+	unlock, err := s.lockObject(source.TenantID, source.ObjectPath)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// 查询与加锁之间已有图片可能被删除 其对象随之删除
+	if _, err := s.repo.FindByID(source.TenantID, source.ID); err != nil {
+		if errors.Is(err, codes.ErrImgNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	img.ObjectPath = source.ObjectPath
+
+	res, err := s.repo.Create(img, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(source.Variants) > 0 {
+		variants := make([]*domain.ImgVariant, 0, len(source.Variants))
+		for _, variant := range source.Variants {
+			variants = append(variants, &domain.ImgVariant{
+				ImgID:  res.ID,
+				Width:  variant.Width,
+				Height: variant.Height,
+				Path:   variant.Path,
+				Size:   variant.Size,
+			})
+		}
+		if err := s.repo.CreateVariants(variants); err != nil {
+			return nil, err
+		}
+		res.Variants = variants
+	}
+
+	return res, nil
+}
+
+// lockObject 同一存储对象的引用计数与增删互斥
+// 去重关联的图片共享对象 仅持有图片锁时并发删除会互相视为仍有引用而遗留对象
+// 调用方须先持有图片锁再加对象锁 保持加锁顺序一致
+func (s *service) lockObject(tenantID domain.TenantID, objectPath string) (func(), error) {
+	return s.lock("img_object:" + tenantID.String() + ":" + objectPath)
+}
+
+// isObjectShared 除当前图片外是否还有图片引用同一存储对象
+// deleted 为 true 时检查回收站桶中的对象 否则检查公共桶中的对象 调用方须持有对象锁
+func (s *service) isObjectShared(img *domain.Img, deleted bool) (bool, error) {
+	count, err := s.repo.CountObjectRefs(img.TenantID, img.ObjectPath, deleted, img.ID)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TestStorageConfig 用探测对象检查两个桶的读写删除权限与公共访问前缀
+// config 为空时测试已保存的配置 未填写密钥时沿用已保存的密钥
+// 配置本身不合法时返回错误 连接与权限问题记录在报告中
+func (s *service) TestStorageConfig(tenantID domain.TenantID, config *domain.StorageConfig) (*domain.StorageDiagnosticReport, error) {
+	cfg, err := s.resolveTestStorageConfig(tenantID, config)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.StorageDiagnosticReport{
+		Provider:  cfg.Provider,
+		Stored:    config == nil,
+		CheckedAt: time.Now(),
+	}
+
+	var storage domain.ObjectStorage
+	report.Checks = append(report.Checks, runStorageCheck(domain.StorageCheckClient, "", func() error {
+		storage, err = s.storageFactory.New(cfg)
+		return err
+	}))
+	if storage == nil {
+		return report, nil
+	}
+
+	publicURLPrefix := cfg.PublicURLPrefix
+	if selfServed, ok := storage.(domain.SelfServedStorage); ok {
+		publicURLPrefix = selfServed.PublicURLPrefix(cfg.PublicBucket)
+	}
+
+	report.Checks = append(report.Checks, s.probeStorageBucket(storage, cfg.PublicBucket, publicURLPrefix)...)
+	report.Checks = append(report.Checks, s.probeStorageBucket(storage, cfg.DeleteBucket, "")...)
+
+	return report, nil
+}
+
+// resolveTestStorageConfig 返回密钥为明文的待测试配置
+func (s *service) resolveTestStorageConfig(tenantID domain.TenantID, config *domain.StorageConfig) (*domain.StorageConfig, error) {
+	if config != nil {
+		if err := checkStorageConfig(config); err != nil {
+			return nil, err
+		}
+		if !config.NeedCredentials() || config.GetSecretAccessKey() != "" {
+			return config, nil
+		}
+	}
+
+	stored, err := s.repo.GetTenantStorageConfig(tenantID)
+	if err != nil && (config == nil || !errors.Is(err, codes.ErrImgStorageConfigNotFound)) {
+		return nil, err
+	}
+	if config == nil {
+		config = stored
+	}
+	if !config.NeedCredentials() {
+		return config, nil
+	}
+
+	if stored == nil || stored.GetSecretAccessKey() == "" {
+		return nil, codes.ErrImgStorageConfigInvalid.WithDetail(map[string]any{
+			"provider": config.Provider,
+			"field":    "secret_access_key",
+		})
+	}
+	secret, err := s.secretEncryptor.Decrypt(stored.GetSecretAccessKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt secret key")
+	}
+	config.SetSecretAccessKey(secret)
+
+	return config, nil
+}
+
+// probeStorageBucket 依次写入、读取、通过公共链接访问并删除探测对象 写入失败时跳过后续检查
+// publicURLPrefix 为空表示不检查公共访问 本地存储的公共链接为相对路径 同样跳过
+func (s *service) probeStorageBucket(storage domain.ObjectStorage, bucket, publicURLPrefix string) []*domain.StorageCheck {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return []*domain.StorageCheck{{
+			Name:   domain.StorageCheckWrite,
+			Bucket: bucket,
+			Status: domain.StorageCheckFailed,
+			Error:  err.Error(),
+		}}
+	}
+	key := domain.StorageProbePrefix + hex.EncodeToString(id) + ".txt"
+	content := []byte("storage probe " + time.Now().Format(time.RFC3339Nano))
+
+	checks := make([]*domain.StorageCheck, 0, 4)
+	write := runStorageCheck(domain.StorageCheckWrite, bucket, func() error {
+		return storage.Put(bucket, key, bytes.NewReader(content), "text/plain")
+	})
+	checks = append(checks, write)
+
+	names := []domain.StorageCheckName{domain.StorageCheckRead}
+	if publicURLPrefix != "" {
+		names = append(names, domain.StorageCheckPublicURL)
+	}
+	names = append(names, domain.StorageCheckDelete)
+
+	if write.Status != domain.StorageCheckPassed {
+		for _, name := range names {
+			checks = append(checks, skippedStorageCheck(name, bucket))
+		}
+		return checks
+	}
+
+	checks = append(checks, runStorageCheck(domain.StorageCheckRead, bucket, func() error {
+		body, err := storage.Get(bucket, key)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !bytes.Equal(data, content) {
+			return errors.New("读取的内容与写入的不一致")
+		}
+		return nil
+	}))
+
+	if publicURLPrefix != "" {
+		checks = append(checks, s.probePublicURL(bucket, publicURLPrefix+"/"+key, content))
+	}
+
+	checks = append(checks, runStorageCheck(domain.StorageCheckDelete, bucket, func() error {
+		if err := storage.Delete(bucket, key); err != nil {
+			return err
+		}
+		// 部分服务删除不存在或无权删除的对象时同样返回成功 需确认对象已不存在
+		_, err := storage.Stat(bucket, key)
+		if err == nil {
+			return errors.New("删除后对象仍然存在")
+		}
+		if !errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+			return err
+		}
+		return nil
+	}))
+
+	return checks
+}
+
+func (s *service) probePublicURL(bucket, url string, content []byte) *domain.StorageCheck {
+	if url[0] == '/' {
+		return skippedStorageCheck(domain.StorageCheckPublicURL, bucket)
+	}
+
+	return runStorageCheck(domain.StorageCheckPublicURL, bucket, func() error {
+		file, err := s.fetcher.Fetch(url, int64(len(content)))
+		if err != nil {
+			return errors.Wrapf(err, "访问 %s 失败", url)
+		}
+		if !bytes.Equal(file.Data, content) {
+			return errors.Errorf("%s 返回的内容与写入的不一致", url)
+		}
+		return nil
+	})
+}
+
+func runStorageCheck(name domain.StorageCheckName, bucket string, fn func() error) *domain.StorageCheck {
+	start := time.Now()
+	err := fn()
+
+	check := &domain.StorageCheck{
+		Name:     name,
+		Bucket:   bucket,
+		Status:   domain.StorageCheckPassed,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		check.Status = domain.StorageCheckFailed
+		check.Error = err.Error()
+	}
+	return check
+}
+
+func skippedStorageCheck(name domain.StorageCheckName, bucket string) *domain.StorageCheck {
+	return &domain.StorageCheck{
+		Name:   name,
+		Bucket: bucket,
+		Status: domain.StorageCheckSkipped,
+	}
+}
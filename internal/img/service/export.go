@@ -0,0 +1,343 @@
+package service
+
+import (
+	"archive/zip"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// exportJobPollInterval 兜底轮询间隔 创建任务时会立即唤醒 同时清理过期的压缩包
+	exportJobPollInterval = time.Minute
+	// exportJobLease 运行中任务的心跳超时 超时后视为实例中断 可被重新认领
+	exportJobLease    = 5 * time.Minute
+	maxListExportJobs = 20
+	// exportHeartbeatEvery 每打包若干张图片更新一次进度
+	exportHeartbeatEvery = 50
+	expiredExportBatch   = 100
+)
+
+// CreateExport 校验筛选条件后创建导出任务 图片数量在执行时检查
+func (s *service) CreateExport(job *domain.ExportJob) (*domain.ExportJob, error) {
+	if job.CategoryID != "" {
+		if _, err := s.repo.FindCategoryByID(job.TenantID, job.CategoryID); err != nil {
+			return nil, err
+		}
+	} else {
+		job.IncludeSubcategories = false
+	}
+
+	slices.Sort(job.TagIDs)
+	job.TagIDs = slices.Compact(job.TagIDs)
+	if len(job.TagIDs) > 0 {
+		count, err := s.repo.CountTags(job.TenantID, job.TagIDs...)
+		if err != nil {
+			return nil, err
+		}
+		if count != int64(len(job.TagIDs)) {
+			return nil, codes.ErrImgTagNotFound
+		}
+	}
+
+	job.Status = domain.JobStatusPending
+	if err := s.repo.CreateExportJob(job); err != nil {
+		return nil, err
+	}
+	s.notifyExportJobs()
+
+	return job, nil
+}
+
+// GetExportJob 导出完成且压缩包未过期时附带预签名下载链接
+func (s *service) GetExportJob(tenantID domain.TenantID, jobID domain.ExportJobID) (*domain.ExportJob, error) {
+	job, err := s.repo.FindExportJob(tenantID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !job.IsDownloadable(now) {
+		return job, nil
+	}
+
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	expire := min(domain.ExportDownloadURLExpire, job.ExpiresAt.Sub(now))
+	job.DownloadURL, err = storage.storage.Presign(storage.deleteBucket, job.ArchiveKey, expire)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (s *service) ListExportJobs(tenantID domain.TenantID) ([]*domain.ExportJob, error) {
+	return s.repo.ListExportJobs(tenantID, maxListExportJobs)
+}
+
+// notifyExportJobs 唤醒本实例的任务循环 已有待处理的唤醒时忽略
+func (s *service) notifyExportJobs() {
+	select {
+	case s.exportJobNotify <- struct{}{}:
+	default:
+	}
+}
+
+// runExportJobs 逐个认领并执行导出任务 多实例部署时由数据库保证同一任务只被一个实例执行
+func (s *service) runExportJobs() {
+	ticker := time.NewTicker(exportJobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			jobs, err := s.repo.ClaimExportJobs(time.Now().Add(-exportJobLease), 1)
+			if err != nil {
+				zap.L().Error("认领导出任务失败", zap.Error(err))
+				break
+			}
+			if len(jobs) == 0 {
+				break
+			}
+			s.runExportJob(jobs[0])
+		}
+
+		s.deleteExpiredExports()
+
+		select {
+		case <-ticker.C:
+		case <-s.exportJobNotify:
+		}
+	}
+}
+
+// runExportJob 打包全部图片 中断后被重新认领时从头打包
+func (s *service) runExportJob(job *domain.ExportJob) {
+	err := s.exportImgs(job)
+
+	job.Status = domain.JobStatusSucceeded
+	if err != nil {
+		job.Status = domain.JobStatusFailed
+		job.LastError = err.Error()
+		zap.L().Error("导出任务失败",
+			zap.String("job_id", job.ID.String()),
+			zap.String("tenant_id", job.TenantID.String()),
+			zap.Error(err),
+		)
+	}
+	job.FinishedAt = time.Now()
+
+	if err := s.repo.UpdateExportJob(job); err != nil {
+		zap.L().Error("更新导出任务状态失败",
+			zap.String("job_id", job.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *service) exportImgs(job *domain.ExportJob) error {
+	// 1.筛选图片 超出上限时失败 提示缩小范围
+	imgs, err := s.repo.ListExportImgs(job, domain.MaxExportImgs+1)
+	if err != nil {
+		return err
+	}
+	if len(imgs) > domain.MaxExportImgs {
+		return codes.ErrImgExportTooManyImgs.WithDetail(map[string]any{"max": domain.MaxExportImgs})
+	}
+
+	imgIDs := make([]domain.ImgID, 0, len(imgs))
+	for _, img := range imgs {
+		imgIDs = append(imgIDs, img.ID)
+	}
+	tags := make(map[domain.ImgID][]*domain.Tag)
+	if len(imgIDs) > 0 {
+		tags, err = s.repo.ListImgTags(imgIDs...)
+		if err != nil {
+			return err
+		}
+	}
+
+	storage, err := s.getTenantStorage(job.TenantID)
+	if err != nil {
+		return err
+	}
+
+	// 2.逐张从桶中读取写入临时文件 内存占用与图片数量无关
+	tmp, err := os.CreateTemp("", "img-export-*.zip")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	job.Total = len(imgs)
+	job.Exported, job.Missing = 0, 0
+	manifest := &domain.ExportManifest{
+		JobID:                job.ID,
+		TenantID:             job.TenantID,
+		CategoryID:           job.CategoryID,
+		IncludeSubcategories: job.IncludeSubcategories,
+		TagIDs:               job.TagIDs,
+		IncludeDeleted:       job.IncludeDeleted,
+		ExportedAt:           time.Now(),
+		Images:               make([]*domain.ExportManifestItem, 0, len(imgs)),
+	}
+
+	zw := zip.NewWriter(tmp)
+	for i, img := range imgs {
+		item := exportManifestItem(img, tags[img.ID])
+
+		bucket, dir := storage.publicBucket, domain.ExportImgDir
+		if img.IsDeleted() {
+			bucket, dir = storage.deleteBucket, domain.ExportRecycleDir
+		}
+
+		if err := writeExportEntry(zw, storage, bucket, img.ObjectPath, dir+img.Path, img.CreatedAt); err != nil {
+			if !errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+				return err
+			}
+			// 对象缺失不影响其余图片 记录在清单中 可通过对账修复
+			item.Missing = true
+			job.Missing++
+		} else {
+			item.File = dir + img.Path
+			job.Exported++
+		}
+		manifest.Images = append(manifest.Images, item)
+
+		if (i+1)%exportHeartbeatEvery == 0 {
+			if err := s.repo.UpdateExportJob(job); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 3.写入清单后上传
+	w, err := zw.Create(domain.ExportManifestName)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := zw.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return errors.WithStack(err)
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return errors.WithStack(err)
+	}
+	archiveKey := domain.ExportStagingPrefix + hex.EncodeToString(id) + ".zip"
+	if err := storage.storage.Put(storage.deleteBucket, archiveKey, tmp, "application/zip"); err != nil {
+		return err
+	}
+
+	job.ArchiveKey = archiveKey
+	job.Size = size
+	job.ExpiresAt = time.Now().Add(domain.ExportArchiveTTL)
+
+	return nil
+}
+
+func writeExportEntry(zw *zip.Writer, storage *tenantStorage, bucket, key, name string, modified time.Time) error {
+	body, err := storage.storage.Get(bucket, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// 图片本身已压缩 直接存储
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: modified,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := io.Copy(w, body); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func exportManifestItem(img *domain.Img, tags []*domain.Tag) *domain.ExportManifestItem {
+	item := &domain.ExportManifestItem{
+		ID:               img.ID,
+		Path:             img.Path,
+		CategoryID:       img.CategoryID,
+		Description:      img.Description,
+		OriginalFilename: img.OriginalFilename,
+		Width:            img.Width,
+		Height:           img.Height,
+		Size:             img.Size,
+		MimeType:         img.MimeType,
+		ContentHash:      img.ContentHash,
+		CreatedAt:        img.CreatedAt,
+	}
+	for _, tag := range tags {
+		item.Tags = append(item.Tags, tag.Name)
+	}
+	if img.IsDeleted() {
+		deletedAt := img.DeletedAt
+		item.DeletedAt = &deletedAt
+	}
+	return item
+}
+
+// deleteExpiredExports 删除过期的压缩包 任务记录保留
+func (s *service) deleteExpiredExports() {
+	jobs, err := s.repo.ListExpiredExportJobs(time.Now(), expiredExportBatch)
+	if err != nil {
+		zap.L().Error("查询过期导出任务失败", zap.Error(err))
+		return
+	}
+
+	for _, job := range jobs {
+		storage, err := s.getTenantStorage(job.TenantID)
+		if err == nil {
+			err = storage.storage.Delete(storage.deleteBucket, job.ArchiveKey)
+		}
+		if err != nil {
+			zap.L().Error("删除过期导出压缩包失败",
+				zap.String("job_id", job.ID.String()),
+				zap.String("key", job.ArchiveKey),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		job.ArchiveKey = ""
+		if err := s.repo.UpdateExportJob(job); err != nil {
+			zap.L().Error("更新导出任务状态失败",
+				zap.String("job_id", job.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
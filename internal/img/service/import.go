@@ -0,0 +1,543 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// importJobPollInterval 兜底轮询间隔 创建任务时会立即唤醒
+	importJobPollInterval = time.Minute
+	// importJobLease 运行中任务的心跳超时 超时后视为实例中断 可被重新认领
+	importJobLease        = 5 * time.Minute
+	maxListImportJobs     = 20
+	maxImportImgPath      = 200
+	maxImportPathAttempts = 3
+	maxOriginalFilename   = 255
+	expiredImportBatch    = 100
+)
+
+// CreateURLImport 创建从远程地址导入的任务 重复的地址只导入一次
+func (s *service) CreateURLImport(tenantID domain.TenantID, categoryID domain.CategoryID, urls []string) (*domain.ImportJob, error) {
+	if err := s.checkImportCategory(tenantID, categoryID); err != nil {
+		return nil, err
+	}
+
+	job := &domain.ImportJob{
+		TenantID:   tenantID,
+		CategoryID: categoryID,
+		Source:     domain.ImportSourceURLs,
+		Status:     domain.JobStatusPending,
+	}
+	seen := make(map[string]struct{}, len(urls))
+	for _, rawURL := range urls {
+		rawURL = strings.TrimSpace(rawURL)
+		if _, ok := seen[rawURL]; ok || rawURL == "" {
+			continue
+		}
+		seen[rawURL] = struct{}{}
+		job.Items = append(job.Items, &domain.ImportItem{
+			Seq:    len(job.Items) + 1,
+			Source: rawURL,
+			Status: domain.JobStatusPending,
+		})
+	}
+	if len(job.Items) == 0 {
+		return nil, codes.ErrImgIllegalOperation
+	}
+	if len(job.Items) > domain.MaxImportItems {
+		return nil, codes.ErrImgImportTooManyFiles.WithDetail(map[string]any{"max": domain.MaxImportItems})
+	}
+	job.Total = len(job.Items)
+
+	if err := s.repo.CreateImportJob(job); err != nil {
+		return nil, err
+	}
+	s.notifyImportJobs()
+
+	return job, nil
+}
+
+// CreateZipImport 暂存压缩包后创建导入任务 解压在任务执行时进行
+func (s *service) CreateZipImport(tenantID domain.TenantID, categoryID domain.CategoryID, src io.Reader, size int64) (*domain.ImportJob, error) {
+	if size > domain.MaxImportArchiveSize {
+		return nil, codes.ErrImgImportFileTooLarge.WithDetail(map[string]any{"max": domain.MaxImportArchiveSize})
+	}
+	if err := s.checkImportCategory(tenantID, categoryID); err != nil {
+		return nil, err
+	}
+
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	archiveKey := domain.ImportStagingPrefix + hex.EncodeToString(id) + ".zip"
+
+	if err := storage.storage.Put(storage.deleteBucket, archiveKey, src, "application/zip"); err != nil {
+		return nil, err
+	}
+
+	job := &domain.ImportJob{
+		TenantID:   tenantID,
+		CategoryID: categoryID,
+		Source:     domain.ImportSourceZip,
+		ArchiveKey: archiveKey,
+		Status:     domain.JobStatusPending,
+	}
+	if err := s.repo.CreateImportJob(job); err != nil {
+		if err := storage.storage.Delete(storage.deleteBucket, archiveKey); err != nil {
+			zap.L().Error("删除导入压缩包失败", zap.String("key", archiveKey), zap.Error(err))
+		}
+		return nil, err
+	}
+	s.notifyImportJobs()
+
+	return job, nil
+}
+
+// GetImportJob 返回任务及其逐个文件的结果
+func (s *service) GetImportJob(tenantID domain.TenantID, jobID domain.ImportJobID) (*domain.ImportJob, error) {
+	job, err := s.repo.FindImportJob(tenantID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Items, err = s.repo.ListImportItems(job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (s *service) ListImportJobs(tenantID domain.TenantID) ([]*domain.ImportJob, error) {
+	return s.repo.ListImportJobs(tenantID, maxListImportJobs)
+}
+
+// RetryImportJob 重新导入失败的文件 已成功的文件不再处理
+func (s *service) RetryImportJob(tenantID domain.TenantID, jobID domain.ImportJobID) (*domain.ImportJob, error) {
+	job, err := s.repo.FindImportJob(tenantID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != domain.JobStatusFailed {
+		return nil, codes.ErrImgIllegalOperation
+	}
+	// 压缩包超过保留时间已被删除
+	if job.Source == domain.ImportSourceZip && job.ArchiveKey == "" {
+		return nil, codes.ErrImgIllegalOperation.WithDetail(map[string]any{"reason": "archive expired"})
+	}
+
+	if _, err := s.repo.ResetFailedImportItems(job.ID); err != nil {
+		return nil, err
+	}
+
+	job.Status = domain.JobStatusPending
+	job.LastError = ""
+	job.FinishedAt = time.Time{}
+	if err := s.repo.UpdateImportJob(job); err != nil {
+		return nil, err
+	}
+	s.notifyImportJobs()
+
+	return job, nil
+}
+
+func (s *service) checkImportCategory(tenantID domain.TenantID, categoryID domain.CategoryID) error {
+	if categoryID == "" {
+		return nil
+	}
+	_, err := s.repo.FindCategoryByID(tenantID, categoryID)
+	return err
+}
+
+// notifyImportJobs 唤醒本实例的任务循环 已有待处理的唤醒时忽略
+func (s *service) notifyImportJobs() {
+	select {
+	case s.importJobNotify <- struct{}{}:
+	default:
+	}
+}
+
+// runImportJobs 逐个认领并执行导入任务 多实例部署时由数据库保证同一任务只被一个实例执行
+func (s *service) runImportJobs() {
+	ticker := time.NewTicker(importJobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			jobs, err := s.repo.ClaimImportJobs(time.Now().Add(-importJobLease), 1)
+			if err != nil {
+				zap.L().Error("认领导入任务失败", zap.Error(err))
+				break
+			}
+			if len(jobs) == 0 {
+				break
+			}
+			s.runImportJob(jobs[0])
+		}
+
+		s.deleteExpiredImportArchives()
+
+		select {
+		case <-ticker.C:
+		case <-s.importJobNotify:
+		}
+	}
+}
+
+// runImportJob 导入剩余待处理的文件 中断或失败后重新执行即可继续
+func (s *service) runImportJob(job *domain.ImportJob) {
+	err := s.importFiles(job)
+	if err == nil && job.Failed > 0 {
+		err = errors.Errorf("%d 个文件导入失败", job.Failed)
+	}
+
+	job.Status = domain.JobStatusSucceeded
+	if err != nil {
+		job.Status = domain.JobStatusFailed
+		job.LastError = err.Error()
+		zap.L().Error("导入任务失败",
+			zap.String("job_id", job.ID.String()),
+			zap.String("tenant_id", job.TenantID.String()),
+			zap.Error(err),
+		)
+	}
+
+	// 全部导入成功后删除暂存的压缩包 失败时保留 ImportArchiveRetention 以便重试
+	if err == nil && job.ArchiveKey != "" {
+		if err := s.deleteImportArchive(job); err != nil {
+			zap.L().Error("删除导入压缩包失败",
+				zap.String("job_id", job.ID.String()),
+				zap.String("key", job.ArchiveKey),
+				zap.Error(err),
+			)
+		} else {
+			job.ArchiveKey = ""
+		}
+	}
+	job.FinishedAt = time.Now()
+
+	if err := s.repo.UpdateImportJob(job); err != nil {
+		zap.L().Error("更新导入任务状态失败",
+			zap.String("job_id", job.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// deleteExpiredImportArchives 删除超过保留时间仍未重试的失败任务的压缩包
+// 删除失败时保留记录 下一轮重试 清空记录时任务已被重试则不修改 避免覆盖重试后的状态
+func (s *service) deleteExpiredImportArchives() {
+	before := time.Now().Add(-domain.ImportArchiveRetention)
+	jobs, err := s.repo.ListExpiredImportJobs(before, expiredImportBatch)
+	if err != nil {
+		zap.L().Error("查询过期导入任务失败", zap.Error(err))
+		return
+	}
+
+	for _, job := range jobs {
+		if err := s.deleteImportArchive(job); err != nil {
+			zap.L().Error("删除过期导入压缩包失败",
+				zap.String("job_id", job.ID.String()),
+				zap.String("key", job.ArchiveKey),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if err := s.repo.ClearExpiredImportArchive(job.ID, before); err != nil {
+			zap.L().Error("更新导入任务状态失败",
+				zap.String("job_id", job.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (s *service) importFiles(job *domain.ImportJob) error {
+	// 1.分类在任务执行前可能已被删除
+	if err := s.checkImportCategory(job.TenantID, job.CategoryID); err != nil {
+		return err
+	}
+
+	items, err := s.repo.ListImportItems(job.ID)
+	if err != nil {
+		return err
+	}
+	job.Items = items
+
+	// 2.压缩包来源 首次执行时按压缩包内的文件创建待处理项
+	var archive map[string]*zip.File
+	if job.Source == domain.ImportSourceZip {
+		reader, cleanup, err := s.openImportArchive(job)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		archive = make(map[string]*zip.File, len(reader.File))
+		for _, f := range reader.File {
+			if !domain.IsIgnoredArchiveEntry(f.Name) {
+				archive[f.Name] = f
+			}
+		}
+
+		if len(job.Items) == 0 {
+			if err := s.createArchiveItems(job, reader.File); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 3.重新统计本轮进度 逐个导入 每个文件完成后更新进度 同时作为心跳
+	job.Succeeded, job.Failed = 0, 0
+	for _, item := range job.Items {
+		switch item.Status {
+		case domain.JobStatusSucceeded:
+			job.Succeeded++
+		case domain.JobStatusFailed:
+			job.Failed++
+		}
+	}
+
+	for _, item := range job.Items {
+		if item.Status != domain.JobStatusPending {
+			continue
+		}
+
+		if err := s.importFile(job, item, archive); err != nil {
+			job.Failed++
+			item.Status = domain.JobStatusFailed
+			item.ErrorCode, item.Error = importErrorOf(err)
+			zap.L().Warn("导入文件失败",
+				zap.String("job_id", job.ID.String()),
+				zap.String("source", item.Source),
+				zap.Error(err),
+			)
+		} else {
+			job.Succeeded++
+			item.Status = domain.JobStatusSucceeded
+		}
+
+		if err := s.repo.UpdateImportItem(item); err != nil {
+			return err
+		}
+		if err := s.repo.UpdateImportJob(job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// openImportArchive 将压缩包下载到临时文件 zip 需要随机读取
+func (s *service) openImportArchive(job *domain.ImportJob) (*zip.Reader, func(), error) {
+	storage, err := s.getTenantStorage(job.TenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := storage.storage.Get(storage.deleteBucket, job.ArchiveKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer body.Close()
+
+	tmp, err := os.CreateTemp("", "img-import-*.zip")
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	cleanup := func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+
+	size, err := io.Copy(tmp, io.LimitReader(body, domain.MaxImportArchiveSize+1))
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.WithStack(err)
+	}
+	if size > domain.MaxImportArchiveSize {
+		cleanup()
+		return nil, nil, codes.ErrImgImportFileTooLarge
+	}
+
+	reader, err := zip.NewReader(tmp, size)
+	if err != nil {
+		cleanup()
+		return nil, nil, codes.ErrImgImportArchiveInvalid.WithCause(err)
+	}
+
+	return reader, cleanup, nil
+}
+
+func (s *service) createArchiveItems(job *domain.ImportJob, files []*zip.File) error {
+	items := make([]*domain.ImportItem, 0, len(files))
+	for _, f := range files {
+		if domain.IsIgnoredArchiveEntry(f.Name) {
+			continue
+		}
+		items = append(items, &domain.ImportItem{
+			Seq:    len(items) + 1,
+			Source: f.Name,
+			Status: domain.JobStatusPending,
+		})
+	}
+	if len(items) > domain.MaxImportItems {
+		return codes.ErrImgImportTooManyFiles.WithDetail(map[string]any{"max": domain.MaxImportItems})
+	}
+
+	return s.repo.CreateImportItems(job, items)
+}
+
+func (s *service) deleteImportArchive(job *domain.ImportJob) error {
+	storage, err := s.getTenantStorage(job.TenantID)
+	if err != nil {
+		return err
+	}
+	return storage.storage.Delete(storage.deleteBucket, job.ArchiveKey)
+}
+
+// importFile 读取单个文件后走普通上传流程 路径取自文件名 重复时追加随机后缀
+func (s *service) importFile(job *domain.ImportJob, item *domain.ImportItem, archive map[string]*zip.File) error {
+	var file *domain.FetchedFile
+	if job.Source == domain.ImportSourceZip {
+		var err error
+		file, err = readArchiveFile(archive[item.Source])
+		if err != nil {
+			return err
+		}
+	} else {
+		var err error
+		file, err = s.fetcher.Fetch(item.Source, domain.MaxImportFileSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	if domain.DetectImageFormat(file.Data) == domain.ImageFormatUnknown {
+		return codes.ErrImgUnsupportedFormat
+	}
+
+	filename := path.Base(strings.ReplaceAll(file.Filename, "\\", "/"))
+	if filename == "." || filename == "/" {
+		filename = ""
+	}
+	if runes := []rune(filename); len(runes) > maxOriginalFilename {
+		filename = string(runes[:maxOriginalFilename])
+	}
+
+	name := importImgPath(filename)
+	for attempt := 1; ; attempt++ {
+		img, err := s.Upload(bytes.NewReader(file.Data), &domain.Img{
+			TenantID:         job.TenantID,
+			Path:             name,
+			OriginalFilename: filename,
+		}, job.CategoryID)
+		if err == nil {
+			item.ImgID = img.ID
+			item.ErrorCode, item.Error = 0, ""
+			return nil
+		}
+		if !errors.Is(err, codes.ErrImgPathRepeat) || attempt >= maxImportPathAttempts {
+			return err
+		}
+
+		suffix := make([]byte, 3)
+		if _, err := rand.Read(suffix); err != nil {
+			return errors.WithStack(err)
+		}
+		name = fmt.Sprintf("%s_%s", importImgPath(filename), hex.EncodeToString(suffix))
+	}
+}
+
+func readArchiveFile(f *zip.File) (*domain.FetchedFile, error) {
+	if f == nil {
+		return nil, codes.ErrImgImportArchiveInvalid
+	}
+	if f.UncompressedSize64 > domain.MaxImportFileSize {
+		return nil, codes.ErrImgImportFileTooLarge
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, codes.ErrImgImportArchiveInvalid.WithCause(err)
+	}
+	defer rc.Close()
+
+	// 不信任压缩包声明的大小 多读一个字节以判断是否超出上限
+	data, err := io.ReadAll(io.LimitReader(rc, domain.MaxImportFileSize+1))
+	if err != nil {
+		return nil, codes.ErrImgImportArchiveInvalid.WithCause(err)
+	}
+	if len(data) > domain.MaxImportFileSize {
+		return nil, codes.ErrImgImportFileTooLarge
+	}
+
+	return &domain.FetchedFile{Data: data, Filename: f.Name}, nil
+}
+
+// importImgPath 由文件名生成图片路径 去掉扩展名后仅保留字母、数字、下划线与连字符
+func importImgPath(filename string) string {
+	name := strings.TrimSuffix(filename, path.Ext(filename))
+
+	var b strings.Builder
+	underscore := false
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+			b.WriteRune(r)
+			underscore = false
+		default:
+			if !underscore {
+				b.WriteByte('_')
+				underscore = true
+			}
+		}
+	}
+
+	slug := strings.Trim(b.String(), "_")
+	if len(slug) > maxImportImgPath-16 {
+		slug = slug[:maxImportImgPath-16]
+	}
+	if slug == "" {
+		slug = "import_" + time.Now().Format("2006_01_02_150405")
+	}
+	return slug
+}
+
+// importErrorOf 错误码与可展示的错误信息 非业务错误只返回通用信息 详细原因记录在日志中
+func importErrorOf(err error) (int, string) {
+	var errCode codes.ErrCode
+	if errors.As(err, &errCode) {
+		return errCode.Code, errCode.Msg
+	}
+	var withDetail codes.ErrCodeWithDetail
+	if errors.As(err, &withDetail) {
+		return withDetail.Code, withDetail.Msg
+	}
+	var withCause codes.ErrCodeWithCause
+	if errors.As(err, &withCause) {
+		return withCause.Code, withCause.Msg
+	}
+	return 0, "导入失败"
+}
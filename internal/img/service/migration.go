@@ -0,0 +1,445 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// storageMigrationPollInterval 兜底轮询间隔 创建任务时会立即唤醒
+	storageMigrationPollInterval = time.Minute
+	// storageMigrationLease 运行中任务的心跳超时 超时后视为实例中断 可被重新认领
+	storageMigrationLease     = 5 * time.Minute
+	maxListStorageMigrations  = 20
+	storageMigrationListLimit = 1000
+	// storageMigrationHeartbeatEvery 每处理若干个对象更新一次进度
+	storageMigrationHeartbeatEvery = 50
+)
+
+// migrationBucket 迁移中一对源桶与目标桶
+type migrationBucket struct {
+	kind     domain.StorageBucketKind
+	src, dst string
+}
+
+// migrationContext 一次迁移执行所需的源存储、目标存储与内容哈希
+type migrationContext struct {
+	migration *domain.StorageMigration
+	src, dst  *tenantStorage
+	buckets   []migrationBucket
+	// hashes key: 桶类型 + 对象路径 value: 内容的 sha256 直传图片没有哈希 只校验大小
+	hashes map[string]string
+	// copied 本次执行复制到目标存储的对象 切换前失败时删除
+	copied map[migrationBucket][]string
+}
+
+func migrationHashKey(kind domain.StorageBucketKind, key string) string {
+	return string(kind) + "/" + key
+}
+
+// CreateStorageMigration 校验目标配置后创建迁移任务 迁移期间禁止修改存储配置
+func (s *service) CreateStorageMigration(target *domain.StorageConfig) (*domain.StorageMigration, error) {
+	if err := checkStorageConfig(target); err != nil {
+		return nil, err
+	}
+	if target.NeedCredentials() && target.GetSecretAccessKey() == "" {
+		return nil, codes.ErrImgStorageConfigInvalid.WithDetail(map[string]any{
+			"provider": target.Provider,
+			"field":    "secret_access_key",
+		})
+	}
+
+	current, err := s.repo.GetTenantStorageConfig(target.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if sameStorageBucket(current, target) {
+		return nil, codes.ErrImgMigrationSameTarget
+	}
+
+	if err := s.checkStorageMigrationIdle(target.TenantID); err != nil {
+		return nil, err
+	}
+
+	// 提前构建客户端 尽早暴露配置错误
+	if _, err := s.storageFactory.New(target); err != nil {
+		return nil, err
+	}
+
+	if target.NeedCredentials() {
+		encrypted, err := s.secretEncryptor.Encrypt(target.GetSecretAccessKey())
+		if err != nil {
+			return nil, err
+		}
+		target.SetSecretAccessKey(encrypted)
+	}
+
+	migration := &domain.StorageMigration{
+		TenantID: target.TenantID,
+		Status:   domain.JobStatusPending,
+		Target:   target,
+	}
+	if err := s.repo.CreateStorageMigration(migration); err != nil {
+		return nil, err
+	}
+	s.notifyStorageMigrations()
+
+	return migration, nil
+}
+
+// sameStorageBucket 目标配置的任一桶与当前配置的桶相同 复制与回滚会互相覆盖
+func sameStorageBucket(current, target *domain.StorageConfig) bool {
+	location := func(c *domain.StorageConfig, bucket string) string {
+		return strings.Join([]string{c.Provider.String(), c.AccountID, c.Endpoint, bucket}, "|")
+	}
+	for _, src := range []string{current.PublicBucket, current.DeleteBucket} {
+		for _, dst := range []string{target.PublicBucket, target.DeleteBucket} {
+			if location(current, src) == location(target, dst) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *service) GetStorageMigration(tenantID domain.TenantID, migrationID domain.StorageMigrationID) (*domain.StorageMigration, error) {
+	return s.repo.FindStorageMigration(tenantID, migrationID)
+}
+
+func (s *service) ListStorageMigrations(tenantID domain.TenantID) ([]*domain.StorageMigration, error) {
+	return s.repo.ListStorageMigrations(tenantID, maxListStorageMigrations)
+}
+
+// checkStorageMigrationIdle 存在未完成的存储迁移时返回错误
+func (s *service) checkStorageMigrationIdle(tenantID domain.TenantID) error {
+	active, err := s.repo.ExistActiveStorageMigration(tenantID)
+	if err != nil {
+		return err
+	}
+	if active {
+		return codes.ErrImgStorageMigrating
+	}
+	return nil
+}
+
+// notifyStorageMigrations 唤醒本实例的任务循环 已有待处理的唤醒时忽略
+func (s *service) notifyStorageMigrations() {
+	select {
+	case s.storageMigrationNotify <- struct{}{}:
+	default:
+	}
+}
+
+// runStorageMigrations 逐个认领并执行存储迁移 多实例部署时由数据库保证同一任务只被一个实例执行
+func (s *service) runStorageMigrations() {
+	ticker := time.NewTicker(storageMigrationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			migrations, err := s.repo.ClaimStorageMigrations(time.Now().Add(-storageMigrationLease), 1)
+			if err != nil {
+				zap.L().Error("认领存储迁移任务失败", zap.Error(err))
+				break
+			}
+			if len(migrations) == 0 {
+				break
+			}
+			s.runStorageMigration(migrations[0])
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.storageMigrationNotify:
+		}
+	}
+}
+
+// runStorageMigration 复制并校验全部对象后切换配置
+// 切换前中断的任务被重新认领时跳过目标存储中大小一致的对象 切换后中断的任务标记为失败 需人工核对
+func (s *service) runStorageMigration(migration *domain.StorageMigration) {
+	err := s.migrateStorage(migration)
+
+	migration.Status = domain.JobStatusSucceeded
+	if err != nil {
+		migration.Status = domain.JobStatusFailed
+		migration.LastError = err.Error()
+		zap.L().Error("存储迁移失败",
+			zap.String("migration_id", migration.ID.String()),
+			zap.String("tenant_id", migration.TenantID.String()),
+			zap.Bool("switched", migration.IsSwitched()),
+			zap.Error(err),
+		)
+	}
+	migration.FinishedAt = time.Now()
+
+	if err := s.repo.UpdateStorageMigration(migration); err != nil {
+		zap.L().Error("更新存储迁移状态失败",
+			zap.String("migration_id", migration.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *service) migrateStorage(migration *domain.StorageMigration) error {
+	mc, err := s.newMigrationContext(migration)
+	if err != nil {
+		return err
+	}
+
+	// 1.全量复制并校验 再补齐复制期间新写入的对象 任一对象失败则回滚
+	migration.Total, migration.Copied, migration.Verified = 0, 0, 0
+	for _, full := range []bool{true, false} {
+		if err := s.syncMigrationObjects(mc, full); err != nil {
+			s.rollbackStorageMigration(mc)
+			return err
+		}
+	}
+
+	// 2.切换配置 切换失败时当前配置未改动
+	if err := s.repo.SwitchStorageConfig(migration); err != nil {
+		s.rollbackStorageMigration(mc)
+		return err
+	}
+	s.invalidateTenantStorage(migration.TenantID)
+
+	// 3.切换后补齐其他实例在收到通知前写入原存储的对象 此时不再回滚
+	if err := s.syncMigrationObjects(mc, false); err != nil {
+		return errors.Wrap(err, "已切换到新存储 补齐对象失败")
+	}
+
+	return nil
+}
+
+func (s *service) newMigrationContext(migration *domain.StorageMigration) (*migrationContext, error) {
+	// 已切换时当前配置即为目标配置 原存储无法再从配置中加载
+	if migration.IsSwitched() {
+		return nil, errors.New("任务在切换后中断 请核对原存储中是否有遗漏的对象")
+	}
+
+	target := *migration.Target
+	if target.NeedCredentials() {
+		secret, err := s.secretEncryptor.Decrypt(target.GetSecretAccessKey())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decrypt secret key")
+		}
+		target.SetSecretAccessKey(secret)
+	}
+	dstStorage, err := s.storageFactory.New(&target)
+	if err != nil {
+		return nil, err
+	}
+	dst := &tenantStorage{
+		storage:      dstStorage,
+		publicBucket: target.PublicBucket,
+		deleteBucket: target.DeleteBucket,
+	}
+
+	src, err := s.loadTenantStorage(migration.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	imgs, err := s.repo.AllImgs(migration.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[string]string, len(imgs))
+	for _, img := range imgs {
+		if img.ContentHash == "" {
+			continue
+		}
+		kind := domain.StorageBucketPublic
+		if img.IsDeleted() {
+			kind = domain.StorageBucketDelete
+		}
+		hashes[migrationHashKey(kind, img.ObjectPath)] = img.ContentHash
+	}
+
+	return &migrationContext{
+		migration: migration,
+		src:       src,
+		dst:       dst,
+		buckets: []migrationBucket{
+			{kind: domain.StorageBucketPublic, src: src.publicBucket, dst: dst.publicBucket},
+			{kind: domain.StorageBucketDelete, src: src.deleteBucket, dst: dst.deleteBucket},
+		},
+		hashes: hashes,
+		copied: make(map[migrationBucket][]string),
+	}, nil
+}
+
+// syncMigrationObjects 将源存储中目标存储尚未具有的对象复制过去并校验 变换缓存可重新生成 不迁移
+// full 为 true 时统计并校验全部对象 包括中断前已复制的对象 否则只处理新复制的对象
+func (s *service) syncMigrationObjects(mc *migrationContext, full bool) error {
+	migration := mc.migration
+	processed := 0
+
+	for _, bucket := range mc.buckets {
+		token := ""
+		for {
+			list, err := mc.src.storage.List(bucket.src, "", token, storageMigrationListLimit)
+			if err != nil {
+				return err
+			}
+
+			for _, object := range list.Objects {
+				if strings.HasPrefix(object.Key, domain.TransformCachePrefix) {
+					continue
+				}
+
+				copied, err := s.copyMigrationObject(mc, bucket, object)
+				if err == nil && (copied || full) {
+					migration.Total++
+					migration.Copied++
+					err = s.verifyMigrationObject(mc, bucket, object)
+				}
+				if err != nil {
+					return errors.Wrapf(err, "迁移对象 %s/%s 失败", bucket.src, object.Key)
+				}
+				if copied || full {
+					migration.Verified++
+				}
+
+				processed++
+				if processed%storageMigrationHeartbeatEvery == 0 {
+					if err := s.repo.UpdateStorageMigration(migration); err != nil {
+						return err
+					}
+				}
+			}
+
+			if list.NextToken == "" {
+				break
+			}
+			token = list.NextToken
+		}
+	}
+
+	return s.repo.UpdateStorageMigration(migration)
+}
+
+// copyMigrationObject 目标存储中已有大小一致的对象时跳过 返回是否复制了对象
+func (s *service) copyMigrationObject(mc *migrationContext, bucket migrationBucket, object *domain.ObjectInfo) (bool, error) {
+	existing, err := mc.dst.storage.Stat(bucket.dst, object.Key)
+	if err == nil && existing.Size == object.Size {
+		return false, nil
+	}
+	if err != nil && !errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+		return false, err
+	}
+
+	// 不同存储之间无法服务端复制 经临时文件中转 上传需要可重读的内容
+	body, err := mc.src.storage.Get(bucket.src, object.Key)
+	if err != nil {
+		if errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+			// 列出后被删除
+			return false, nil
+		}
+		return false, err
+	}
+	defer body.Close()
+
+	tmp, err := os.CreateTemp("", "img-migrate-*")
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		return false, errors.WithStack(err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(object.Key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	if err := mc.dst.storage.Put(bucket.dst, object.Key, tmp, contentType); err != nil {
+		return false, err
+	}
+	mc.copied[bucket] = append(mc.copied[bucket], object.Key)
+
+	return true, nil
+}
+
+// verifyMigrationObject 比对大小 有内容哈希的图片再比对 sha256
+func (s *service) verifyMigrationObject(mc *migrationContext, bucket migrationBucket, object *domain.ObjectInfo) error {
+	copied, err := mc.dst.storage.Stat(bucket.dst, object.Key)
+	if err != nil {
+		return err
+	}
+	if copied.Size != object.Size {
+		return codes.ErrImgStorageVerifyFailed.WithDetail(map[string]any{
+			"key":      object.Key,
+			"expected": object.Size,
+			"actual":   copied.Size,
+		})
+	}
+
+	expected, ok := mc.hashes[migrationHashKey(bucket.kind, object.Key)]
+	if !ok {
+		return nil
+	}
+
+	body, err := mc.dst.storage.Get(bucket.dst, object.Key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, body); err != nil {
+		return errors.WithStack(err)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != expected {
+		return codes.ErrImgStorageVerifyFailed.WithDetail(map[string]any{
+			"key":      object.Key,
+			"expected": expected,
+			"actual":   actual,
+		})
+	}
+
+	return nil
+}
+
+// rollbackStorageMigration 删除本次执行复制到目标存储的对象 当前配置未切换 原存储不受影响
+// 中断前的执行复制的对象无法追踪 保留在目标存储中 重新迁移时会被跳过
+func (s *service) rollbackStorageMigration(mc *migrationContext) {
+	for bucket, keys := range mc.copied {
+		for _, key := range keys {
+			if err := mc.dst.storage.Delete(bucket.dst, key); err != nil && !errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+				zap.L().Error("回滚存储迁移 删除目标对象失败",
+					zap.String("migration_id", mc.migration.ID.String()),
+					zap.String("bucket", bucket.dst),
+					zap.String("key", key),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+	mc.copied = make(map[migrationBucket][]string)
+
+	zap.L().Info("存储迁移已回滚",
+		zap.String("migration_id", mc.migration.ID.String()),
+		zap.String("tenant_id", mc.migration.TenantID.String()),
+		zap.String("progress", fmt.Sprintf("%d/%d", mc.migration.Verified, mc.migration.Total)),
+	)
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"saas/internal/img/domain"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// reconcileGracePeriod 最近变更的对象与记录可能处于上传或删除流程中 跳过比对
+	reconcileGracePeriod = time.Hour
+	// reconcileInterval 定期以 dry-run 方式比对 仅记录日志
+	reconcileInterval     = 24 * time.Hour
+	reconcileVariantBatch = 1000
+)
+
+type reconcileBucket struct {
+	kind    domain.StorageBucketKind
+	name    string
+	objects map[string]*domain.ObjectInfo
+	// expected 图片记录引用的对象
+	expected map[string]bool
+}
+
+// ReconcileObjects 比对公共桶、回收站桶与图片记录 找出无记录引用的对象与对象缺失的记录
+// apply 为 false 时仅报告 为 true 时删除孤立对象 并修复或删除缺失对象的记录
+func (s *service) ReconcileObjects(tenantID domain.TenantID, apply bool) (*domain.ReconcileReport, error) {
+	report := &domain.ReconcileReport{
+		TenantID:  tenantID,
+		Apply:     apply,
+		StartedAt: time.Now(),
+	}
+
+	// 存储迁移期间修复的记录与删除的对象无法同步到目标存储
+	if apply {
+		if err := s.checkStorageMigrationIdle(tenantID); err != nil {
+			return nil, err
+		}
+	}
+
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1.加载全部图片记录及缩放版本
+	imgs, err := s.repo.AllImgs(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	for chunk := range slices.Chunk(imgs, reconcileVariantBatch) {
+		if err := s.attachVariants(chunk...); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2.列举两个桶中的对象
+	public := &reconcileBucket{kind: domain.StorageBucketPublic, name: storage.publicBucket}
+	recycle := &reconcileBucket{kind: domain.StorageBucketDelete, name: storage.deleteBucket}
+	for _, bucket := range []*reconcileBucket{public, recycle} {
+		bucket.objects = make(map[string]*domain.ObjectInfo)
+		bucket.expected = make(map[string]bool)
+		err := listAllObjects(storage, bucket.name, func(object *domain.ObjectInfo) {
+			if !domain.IsInternalObjectKey(object.Key) {
+				bucket.objects[object.Key] = object
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 未删除的图片应在公共桶 已软删除的应在回收站桶
+	locate := func(img *domain.Img) (expected, other *reconcileBucket) {
+		if img.IsDeleted() {
+			return recycle, public
+		}
+		return public, recycle
+	}
+	for _, img := range imgs {
+		expected, _ := locate(img)
+		for _, p := range img.ObjectPaths() {
+			expected.expected[p] = true
+		}
+	}
+
+	// 3.对象缺失的记录
+	for _, img := range imgs {
+		if recentlyChanged(img) {
+			continue
+		}
+
+		expected, other := locate(img)
+		var missing []string
+		for _, p := range img.ObjectPaths() {
+			if _, ok := expected.objects[p]; !ok {
+				missing = append(missing, p)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		dangling := &domain.DanglingImg{
+			ImgID:           img.ID,
+			Path:            img.Path,
+			Bucket:          expected.kind,
+			MissingOriginal: slices.Contains(missing, img.ObjectPath),
+			MissingPaths:    missing,
+		}
+		if dangling.MissingOriginal {
+			_, dangling.FoundInOther = other.objects[img.ObjectPath]
+		}
+		report.AddDangling(dangling)
+
+		if !apply {
+			continue
+		}
+		if err := s.repairDangling(storage, img, dangling, expected, other); err != nil {
+			zap.L().Error("对象比对：修复缺失对象的图片记录失败",
+				zap.String("tenant_id", tenantID.String()),
+				zap.String("img_id", img.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		report.RepairedDangling++
+	}
+
+	// 4.无记录引用的对象 修复缺失记录时复制走的对象也会在此清理
+	for _, bucket := range []*reconcileBucket{public, recycle} {
+		keys := make([]string, 0, len(bucket.objects))
+		for key := range bucket.objects {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			object := bucket.objects[key]
+			if bucket.expected[key] || time.Since(object.LastModified) < reconcileGracePeriod {
+				continue
+			}
+
+			report.AddOrphan(&domain.OrphanObject{
+				Bucket:       bucket.kind,
+				Key:          key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			})
+
+			if !apply {
+				continue
+			}
+			if err := storage.storage.Delete(bucket.name, key); err != nil {
+				zap.L().Error("对象比对：删除孤立对象失败",
+					zap.String("tenant_id", tenantID.String()),
+					zap.String("bucket", bucket.name),
+					zap.String("key", key),
+					zap.Error(err),
+				)
+				continue
+			}
+			report.RepairedOrphans++
+		}
+	}
+
+	// 5.修复后重新校准存储用量
+	if report.RepairedOrphans > 0 || report.RepairedDangling > 0 {
+		if _, err := s.ReconcileStorageUsage(tenantID); err != nil {
+			zap.L().Error("对象比对：校准存储用量失败",
+				zap.String("tenant_id", tenantID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	report.FinishedAt = time.Now()
+
+	return report, nil
+}
+
+// repairDangling 缺失的对象若在另一个桶中存在则复制回来 否则原图缺失时删除记录 仅缩放版本缺失时删除对应的版本记录
+func (s *service) repairDangling(storage *tenantStorage, img *domain.Img, dangling *domain.DanglingImg, expected, other *reconcileBucket) error {
+	unlock, token, err := s.lockImg(img.ID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var lost []string
+	for _, p := range dangling.MissingPaths {
+		if _, ok := other.objects[p]; !ok {
+			lost = append(lost, p)
+			continue
+		}
+		if err := storage.storage.Copy(other.name, p, expected.name, p); err != nil {
+			return errors.WithStack(err)
+		}
+		expected.objects[p] = other.objects[p]
+	}
+
+	if slices.Contains(lost, img.ObjectPath) {
+		if err := s.repo.Delete(img.TenantID, img.ID, true, token); err != nil {
+			return err
+		}
+		if img.IsDeleted() {
+			if err := s.msgQueue.RemoveFromDeleteQueue(img.TenantID, img.ID); err != nil {
+				zap.L().Error("对象比对：移除定时删除任务失败",
+					zap.String("img_id", img.ID.String()),
+					zap.Error(err),
+				)
+			}
+		} else {
+			s.purgeTransformCache(storage, img)
+		}
+		return nil
+	}
+
+	return s.repo.DeleteVariants(img.ID, lost)
+}
+
+// recentlyChanged 记录在宽限期内创建、删除或更新过
+func recentlyChanged(img *domain.Img) bool {
+	changedAt := img.CreatedAt
+	for _, t := range []time.Time{img.UpdatedAt, img.DeletedAt} {
+		if t.After(changedAt) {
+			changedAt = t
+		}
+	}
+	return time.Since(changedAt) < reconcileGracePeriod
+}
+
+// reconcileObjectsPeriodically 定期以 dry-run 方式比对全部租户 发现问题时记录日志
+func (s *service) reconcileObjectsPeriodically() {
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		tenantIDs, err := s.repo.AllStorageConfiguredTenants()
+		if err != nil {
+			zap.L().Error("对象比对：查询租户失败", zap.Error(err))
+			continue
+		}
+
+		for _, tenantID := range tenantIDs {
+			report, err := s.ReconcileObjects(tenantID, false)
+			if err != nil {
+				zap.L().Error("对象比对失败",
+					zap.String("tenant_id", tenantID.String()),
+					zap.Error(err),
+				)
+				continue
+			}
+			if report.OrphanCount > 0 || report.DanglingCount > 0 {
+				zap.L().Warn("对象比对：桶与图片记录不一致",
+					zap.String("tenant_id", tenantID.String()),
+					zap.Int("orphans", report.OrphanCount),
+					zap.Int("dangling", report.DanglingCount),
+				)
+			}
+		}
+	}
+}
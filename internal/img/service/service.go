@@ -2,242 +2,361 @@ package service
 
 import (
 	"bytes"
-	"context"
-	"fmt"
-	"image"
-	"image/jpeg"
-	"image/png"
 	"io"
-	"log"
+	"os"
+	"path"
 	"saas/internal/common/reskit/codes"
-	"saas/internal/common/utils"
+	"saas/internal/common/secrets"
 	"saas/internal/img/domain"
+	"strings"
 	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
-type tenantR2Config struct {
-	accountID       string
-	accessKeyID     string
-	secretAccessKey string
-	publicBucket    bucket
+type tenantStorage struct {
+	storage         domain.ObjectStorage
+	publicBucket    string
 	publicURLPrefix string
-	deleteBucket    bucket
-	s3Client        *s3.Client
-	presignClient   *s3.PresignClient
+	deleteBucket    string
 	expireAt        time.Time
 }
 
-type tenantR2ConfigWithOnce struct {
-	config *tenantR2Config
-	once   sync.Once
-	err    error
+type tenantStorageWithOnce struct {
+	storage *tenantStorage
+	once    sync.Once
+	err     error
 }
 
 type service struct {
 	repo            domain.ImgRepository
 	msgQueue        domain.ImgMsgQueue
-	tenantR2        sync.Map // key: TenantID (tenant_id), value: *TenantR2Config
-	imgMutex        sync.Map // key: ImgID (imgID), value: *sync.Mutex
-	ace256Encryptor *utils.AES256Encryptor
+	storageFactory  domain.ObjectStorageFactory
+	processor       domain.ImageProcessor
+	transformCache  domain.TransformCache
+	fetcher         domain.ImageFetcher
+	tenantStorage   sync.Map // key: TenantID (tenant_id), value: *tenantStorageWithOnce
+	locker          domain.Locker
+	secretEncryptor *secrets.Keyring
+	watermarkImages sync.Map // key: tenant_id:image_key, value: []byte
+	// categoryJobNotify 唤醒分类迁移任务循环
+	categoryJobNotify chan struct{}
+	// importJobNotify 唤醒导入任务循环
+	importJobNotify chan struct{}
+	// exportJobNotify 唤醒导出任务循环
+	exportJobNotify chan struct{}
+	// storageMigrationNotify 唤醒存储迁移任务循环
+	storageMigrationNotify chan struct{}
+
+	transformSignKey []byte
+	transformBaseURL string
 }
 
-const tenantR2ConfigTTL = 1 * time.Hour
+const tenantStorageTTL = 1 * time.Hour
 
-func NewImgService(repo domain.ImgRepository, msgQueue domain.ImgMsgQueue) domain.ImgService {
-	encryptKey := utils.GetEnv("R2_AES256_ENCRYPTION_KEY")
+const (
+	// imgLockTTL 持有期间自动续期 仅在实例崩溃后生效
+	imgLockTTL = 30 * time.Second
+	// imgLockWait 等待其他操作释放锁的上限
+	imgLockWait = 10 * time.Second
+)
 
-	ace256Encryptor, err := utils.NewAES256Encryptor(encryptKey)
-	if err != nil {
-		panic(err)
+func NewImgService(
+	repo domain.ImgRepository,
+	msgQueue domain.ImgMsgQueue,
+	storageFactory domain.ObjectStorageFactory,
+	processor domain.ImageProcessor,
+	transformCache domain.TransformCache,
+	fetcher domain.ImageFetcher,
+	locker domain.Locker,
+) domain.ImgService {
+	// 未设置时返回相对路径 由调用方拼接当前域名
+	transformBaseURL := strings.TrimRight(os.Getenv("IMG_TRANSFORM_BASE_URL"), "/")
+	if transformBaseURL == "" {
+		transformBaseURL = "/v1/img_transform"
 	}
 
 	svc := &service{
-		repo:            repo,
-		msgQueue:        msgQueue,
-		ace256Encryptor: ace256Encryptor,
+		repo:              repo,
+		msgQueue:          msgQueue,
+		storageFactory:    storageFactory,
+		processor:         processor,
+		transformCache:    transformCache,
+		fetcher:           fetcher,
+		locker:            locker,
+		secretEncryptor:   secrets.Default(),
+		transformSignKey:  secrets.SigningKey("img-transform"),
+		transformBaseURL:  transformBaseURL,
+		categoryJobNotify: make(chan struct{}, 1),
+		importJobNotify:   make(chan struct{}, 1),
+		exportJobNotify:   make(chan struct{}, 1),
+
+		storageMigrationNotify: make(chan struct{}, 1),
 	}
 
-	go svc.cleanupExpiredConfigs()
+	go svc.cleanupExpiredStorages()
+	go svc.listenStorageInvalidation()
+	go svc.reconcileStorageUsages()
+	go svc.reconcileObjectsPeriodically()
+	go svc.runCategoryJobs()
+	go svc.runImportJobs()
+	go svc.runExportJobs()
+	go svc.runStorageMigrations()
+	go svc.sweepUploadStagingPeriodically()
 
 	return svc
 }
 
-func (s *service) getTenantR2Config(tenantID domain.TenantID) (*tenantR2Config, error) {
+func (s *service) getTenantStorage(tenantID domain.TenantID) (*tenantStorage, error) {
 	// 用 LoadOrStore 获取或创建 wrapper
-	value, _ := s.tenantR2.LoadOrStore(tenantID, &tenantR2ConfigWithOnce{})
-	wrapper := value.(*tenantR2ConfigWithOnce)
+	value, _ := s.tenantStorage.LoadOrStore(tenantID, &tenantStorageWithOnce{})
+	wrapper := value.(*tenantStorageWithOnce)
 
 	// 用 once.Do 确保只加载一次
 	wrapper.once.Do(func() {
-		cfg, err := s.loadTenantR2Config(tenantID)
+		storage, err := s.loadTenantStorage(tenantID)
 		if err != nil {
 			wrapper.err = err
 			return
 		}
-		wrapper.config = cfg
+		wrapper.storage = storage
 	})
 
-	// 如果加载失败，返回错误
+	// 如果加载失败，删除 wrapper 以便下次重试，并返回错误
 	if wrapper.err != nil {
+		s.tenantStorage.CompareAndDelete(tenantID, wrapper)
 		return nil, wrapper.err
 	}
 
 	// 检查是否过期
-	if time.Now().After(wrapper.config.expireAt) {
+	if time.Now().After(wrapper.storage.expireAt) {
 		// 过期：删除旧的，递归重新加载（会创建新 once）
-		s.tenantR2.Delete(tenantID)
-		return s.getTenantR2Config(tenantID)
+		s.tenantStorage.CompareAndDelete(tenantID, wrapper)
+		return s.getTenantStorage(tenantID)
 	}
 
 	// 未过期：延长 TTL 并返回
-	wrapper.config.expireAt = time.Now().Add(tenantR2ConfigTTL)
-	return wrapper.config, nil
+	wrapper.storage.expireAt = time.Now().Add(tenantStorageTTL)
+	return wrapper.storage, nil
 }
 
-func (s *service) loadTenantR2Config(tenantID domain.TenantID) (*tenantR2Config, error) {
-	cfg, err := s.repo.GetTenantR2Config(tenantID)
+func (s *service) loadTenantStorage(tenantID domain.TenantID) (*tenantStorage, error) {
+	cfg, err := s.repo.GetTenantStorageConfig(tenantID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get tenant R2 config")
+		return nil, errors.Wrap(err, "failed to get tenant storage config")
 	}
 
 	// 解密 secret key
-	decryptedSecret, err := s.ace256Encryptor.Decrypt(cfg.GetSecretAccessKey())
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to decrypt secret key")
+	if cfg.NeedCredentials() {
+		if cfg.GetSecretAccessKey() == "" {
+			return nil, codes.ErrImgStorageConfigInvalid.WithDetail(map[string]any{
+				"field": "secret_access_key",
+			})
+		}
+		decryptedSecret, err := s.secretEncryptor.Decrypt(cfg.GetSecretAccessKey())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decrypt secret key")
+		}
+		cfg.SetSecretAccessKey(decryptedSecret)
 	}
 
-	// 配置 S3 客户端
-	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
-		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, decryptedSecret, "")),
-		config.WithRegion("auto"), // R2 不使用区域，但 SDK 需要
-	)
+	storage, err := s.storageFactory.New(cfg)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to load AWS config")
+		return nil, err
 	}
 
-	// 使用服务特定端点创建 S3 客户端（适用于 Cloudflare R2）
-	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
-		o.BaseEndpoint = aws.String(fmt.Sprintf("https://%s.r2.cloudflarestorage.com", cfg.AccountID))
-	})
-	presignClient := s3.NewPresignClient(client)
-
-	return &tenantR2Config{
-		accountID:       cfg.AccountID,
-		accessKeyID:     cfg.AccessKeyID,
-		secretAccessKey: decryptedSecret,
-		publicBucket:    bucket(cfg.PublicBucket),
-		publicURLPrefix: cfg.PublicURLPrefix,
-		deleteBucket:    bucket(cfg.DeleteBucket),
-		s3Client:        client,
-		presignClient:   presignClient,
-		expireAt:        time.Now().Add(tenantR2ConfigTTL),
+	publicURLPrefix := cfg.PublicURLPrefix
+	if selfServed, ok := storage.(domain.SelfServedStorage); ok {
+		publicURLPrefix = selfServed.PublicURLPrefix(cfg.PublicBucket)
+	}
+
+	return &tenantStorage{
+		storage:         storage,
+		publicBucket:    cfg.PublicBucket,
+		publicURLPrefix: publicURLPrefix,
+		deleteBucket:    cfg.DeleteBucket,
+		expireAt:        time.Now().Add(tenantStorageTTL),
 	}, nil
 }
 
-// cleanupExpiredConfigs 定期清理过期配置
-func (s *service) cleanupExpiredConfigs() {
+// cleanupExpiredStorages 定期清理过期的存储客户端
+func (s *service) cleanupExpiredStorages() {
 	ticker := time.NewTicker(10 * time.Minute)
 	defer ticker.Stop()
 
 	for range ticker.C {
-		s.tenantR2.Range(func(key, value interface{}) bool {
-			wrapper := value.(*tenantR2ConfigWithOnce)
-			if wrapper.config != nil && time.Now().After(wrapper.config.expireAt) {
-				s.tenantR2.Delete(key)
+		s.tenantStorage.Range(func(key, value interface{}) bool {
+			wrapper := value.(*tenantStorageWithOnce)
+			if wrapper.storage != nil && time.Now().After(wrapper.storage.expireAt) {
+				s.tenantStorage.Delete(key)
 			}
 			return true
 		})
 	}
 }
 
-const compressQuality = 60
-
-// Compress 压缩图片质量，返回压缩后的图片数据
-func (s *service) Compress(src io.Reader) (io.Reader, error) {
-	// 注册 PNG 解码器
-	image.RegisterFormat("png", "png", png.Decode, png.DecodeConfig)
+// lock 加跨实例的互斥锁 返回的函数用于释放
+func (s *service) lock(key string) (func(), error) {
+	unlock, _, err := s.lockFenced(key)
+	return unlock, err
+}
 
-	// 解码图片
-	img, _, err := image.Decode(src)
+// lockFenced 同 lock 并返回本次加锁的 fencing token
+func (s *service) lockFenced(key string) (func(), domain.FencingToken, error) {
+	lock, err := s.locker.Lock(key, imgLockTTL, imgLockWait)
 	if err != nil {
-		return nil, fmt.Errorf("解码输入图片失败: %v", err)
+		return nil, 0, err
 	}
 
-	// 创建一个缓冲区来存储压缩后的图片数据
-	output := &bytes.Buffer{}
+	return func() {
+		if err := lock.Unlock(); err != nil {
+			zap.L().Error("释放分布式锁失败",
+				zap.String("key", key),
+				zap.Int64("token", int64(lock.Token())),
+				zap.Error(err),
+			)
+		}
+	}, lock.Token(), nil
+}
+
+// lockImg 同一图片的删除、恢复、彻底删除、移动与修复互斥
+// 返回的令牌须随记录的删除、恢复与移动一并提交 锁过期后旧持有者的写入被拒绝
+func (s *service) lockImg(imgID domain.ImgID) (func(), domain.FencingToken, error) {
+	return s.lockFenced("img:" + imgID.String())
+}
 
-	// 将图片编码为 JPEG 格式并以指定质量写入缓冲区
-	err = jpeg.Encode(output, img, &jpeg.Options{Quality: compressQuality})
+// invalidateTenantStorage 配置变更后丢弃本实例缓存的存储客户端 并通知其他实例
+// 通知失败时其他实例的缓存最迟在 tenantStorageTTL 后过期
+func (s *service) invalidateTenantStorage(tenantID domain.TenantID) {
+	s.tenantStorage.Delete(tenantID)
+
+	if err := s.msgQueue.PublishStorageInvalidation(tenantID); err != nil {
+		zap.L().Error("发布存储配置变更通知失败",
+			zap.String("tenant_id", tenantID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// listenStorageInvalidation 收到其他实例的配置变更通知后丢弃缓存的存储客户端
+func (s *service) listenStorageInvalidation() {
+	s.msgQueue.SubscribeStorageInvalidation(func(tenantID domain.TenantID) {
+		if tenantID == "" {
+			s.tenantStorage.Clear()
+			return
+		}
+		s.tenantStorage.Delete(tenantID)
+	})
+}
+
+// process 按租户配置处理图片 并为路径补全与实际格式一致的扩展名
+func (s *service) process(src io.Reader, img *domain.Img, setting *domain.ImgSetting) (*domain.ProcessedImage, error) {
+	processed, err := s.processor.Process(src, &domain.ProcessOptions{
+		Target:  setting.OutputFormat,
+		Quality: setting.Quality,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("编码图片失败: %v", err)
+		return nil, err
 	}
 
-	return bytes.NewReader(output.Bytes()), nil
+	img.Path = strings.TrimSuffix(img.Path, path.Ext(img.Path)) + processed.Format.Ext()
+
+	return processed, nil
 }
 
-func (s *service) Upload(src io.Reader, img *domain.Img, categoryID domain.CategoryID) error {
-	// 压缩图片
-	compressed, err := s.Compress(src)
+func (s *service) Upload(src io.Reader, img *domain.Img, categoryID domain.CategoryID) (*domain.Img, error) {
+	setting, err := s.GetImgSetting(img.TenantID)
 	if err != nil {
-		return codes.ErrImgCompress.WithCause(err)
+		return nil, err
 	}
 
-	// 1. 若有 categoryID 则需要先检查分类是否存在
+	// 1. 若有 categoryID 则需要先检查分类是否存在 水印按分类生效
 	var category *domain.Category
 	if categoryID != "" {
-		var err error
 		category, err = s.repo.FindCategoryByID(img.TenantID, categoryID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
+	// 处理图片
+	original, err := s.process(src, img, setting)
+	if err != nil {
+		return nil, err
+	}
+
+	// 加水印 去重按加水印后的内容判断
+	processed, watermark, err := s.applyWatermark(img, original, setting, category)
+	if err != nil {
+		return nil, err
+	}
+
+	img.Width = processed.Width
+	img.Height = processed.Height
+	img.Size = int64(len(processed.Data))
+	img.MimeType = processed.Format.ContentType()
+
+	// 内容与已有图片重复时按租户配置处理
+	img.ContentHash = contentHash(processed.Data)
+	duplicate, err := s.findDuplicate(img.TenantID, img.ContentHash, setting.DedupMode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 加载配置
+	storage, err := s.getTenantStorage(img.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if duplicate != nil && setting.DedupMode == domain.DedupModeReuse {
+		duplicate.Deduplicated = true
+		duplicate.SetPublicPreURL(storage.publicURLPrefix)
+		return duplicate, nil
+	}
+
 	// 2.查询是否有相同路径
-	nowPath := ""
+	nowPath := img.Path
 	if category != nil {
 		nowPath = category.Prefix + "/" + img.Path
 	}
 
 	exist, err := s.repo.ExistByPath(img.TenantID, nowPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if exist {
-		return codes.ErrImgPathRepeat
+		return nil, codes.ErrImgPathRepeat
 	}
 
-	// 3.入库
-	res, err := s.repo.Create(img, categoryID)
-	if err != nil {
-		return err
+	// 关联已有对象 无需上传 已有图片期间被删除时按普通上传处理
+	if duplicate != nil {
+		res, err := s.linkDuplicate(img, categoryID, duplicate)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			res.Deduplicated = true
+			res.SetPublicPreURL(storage.publicURLPrefix)
+			return res, nil
+		}
+		img.ObjectPath = ""
 	}
 
-	// 加载配置
-	r2Config, err := s.getTenantR2Config(img.TenantID)
+	// 3.入库
+	res, err := s.repo.Create(img, categoryID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// 后续不要再使用 img 使用res！
-	// 4.上传s3
-	uploadOk := true
-	if err = s.UploadFile(r2Config.s3Client, r2Config.publicBucket, compressed, res.Path); err != nil {
-		uploadOk = false
-		err = codes.ErrImgUploadToR3Failed.WithCause(err)
-	}
-
-	// 5.如果第4步发生错误 则删除已入库的记录
-	if !uploadOk {
-		if err := s.repo.Delete(img.TenantID, res.ID, true); err != nil {
+	// 4.上传对象存储
+	if err = storage.storage.Put(storage.publicBucket, res.Path, bytes.NewReader(processed.Data), processed.Format.ContentType()); err != nil {
+		// 5.如果第4步发生错误 则删除已入库的记录 新记录未经加锁写入 令牌为 0
+		if err := s.repo.Delete(img.TenantID, res.ID, true, 0); err != nil {
 			zap.L().Error("数据库入库成功但图片上传失败，尝试回滚删除数据库记录时出错",
 				zap.String("tenant_id:", img.TenantID.String()),
 				zap.String("id:", res.ID.String()),
@@ -246,22 +365,39 @@ func (s *service) Upload(src io.Reader, img *domain.Img, categoryID domain.Categ
 				zap.Error(err),
 			)
 		}
+		return nil, codes.ErrImgUploadToStorageFailed.WithCause(err)
 	}
 
-	res.SetPublicPreURL(r2Config.publicURLPrefix)
+	// 5.生成缩放版本 与原图放在同一目录
+	res.Variants = s.uploadVariants(storage, res, processed, setting)
 
-	return nil
+	if watermark != nil && watermark.KeepOriginal {
+		s.keepOriginal(storage, res, original)
+	}
+
+	s.recordUsage(res, domain.StorageBucketPublic, 1)
+
+	res.SetPublicPreURL(storage.publicURLPrefix)
+
+	return res, nil
 }
 
 // Delete 删除逻辑
 // 硬删除 -> 直接删除 publicBucket 中的对象
 // 软删除 -> 复制原有对象到不可公共访问的 deleteBucket 删除 publicBucket 中的对象 -> 类似于回收站功能
+// 去重关联的图片共享存储对象 仍有其他图片引用时保留 publicBucket 中的对象
 func (s *service) Delete(tenantID domain.TenantID, imgID domain.ImgID, hard ...bool) error {
 	// 为每个图片创建或获取锁
-	value, _ := s.imgMutex.LoadOrStore(imgID, &sync.Mutex{})
-	mu := value.(*sync.Mutex)
-	mu.Lock()
-	defer mu.Unlock()
+	unlock, token, err := s.lockImg(imgID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// 迁移只同步新增对象 期间删除、恢复的对象会残留在目标存储中
+	if err := s.checkStorageMigrationIdle(tenantID); err != nil {
+		return err
+	}
 
 	img, err := s.repo.FindByID(tenantID, imgID)
 	if err != nil {
@@ -272,39 +408,80 @@ func (s *service) Delete(tenantID domain.TenantID, imgID domain.ImgID, hard ...b
 		return codes.ErrImgIllegalOperation
 	}
 
+	// 引用计数与对象增删须在对象锁内完成
+	unlockObject, err := s.lockObject(tenantID, img.ObjectPath)
+	if err != nil {
+		return err
+	}
+	defer unlockObject()
+
+	if err := s.attachVariants(img); err != nil {
+		return err
+	}
+
 	// 加载配置
-	r2Config, err := s.getTenantR2Config(tenantID)
+	storage, err := s.getTenantStorage(tenantID)
 	if err != nil {
 		return err
 	}
 
 	isHardDelete := len(hard) > 0 && hard[0]
 
+	shared, err := s.isObjectShared(img, false)
+	if err != nil {
+		return err
+	}
+
 	if isHardDelete {
-		// 1.删除r3
-		if err := s.DeleteFile(r2Config.s3Client, r2Config.publicBucket, img.Path); err != nil {
-			return errors.WithStack(err)
+		// 1.删除原图及缩放版本
+		if !shared {
+			if err := deleteObjects(storage, storage.publicBucket, img.ObjectPaths()); err != nil {
+				return err
+			}
+			s.recordUsage(img, domain.StorageBucketPublic, -1)
 		}
 		// 2.删除记录
-		if err := s.repo.Delete(tenantID, img.ID, true); err != nil {
+		if err := s.repo.Delete(tenantID, img.ID, true, token); err != nil {
 			return errors.WithStack(err)
 		}
+		s.deleteOriginal(storage, img)
+
+		// 3.清理变换缓存
+		if !shared {
+			s.purgeTransformCache(storage, img)
+		}
 	} else {
-		// 1.从 publicBucket 移到 deleteBucket
-		if err := s.CopyFileToAnotherBucket(r2Config.s3Client, r2Config.publicBucket, r2Config.deleteBucket, img.Path); err != nil {
-			return errors.WithStack(err)
+		// 回收站中已有其他图片引用同一对象时 复制不会新增对象
+		sharedInRecycle, err := s.isObjectShared(img, true)
+		if err != nil {
+			return err
 		}
-		//2.删除 publicBucket 中的对象
-		if err := s.DeleteFile(r2Config.s3Client, r2Config.publicBucket, img.Path); err != nil {
-			return errors.WithStack(err)
+
+		// 1.原图及缩放版本复制到 deleteBucket 无其他引用时删除 publicBucket 中的对象
+		if err := copyObjects(storage, storage.publicBucket, storage.deleteBucket, img.ObjectPaths()); err != nil {
+			return err
+		}
+		if !sharedInRecycle {
+			s.recordUsage(img, domain.StorageBucketDelete, 1)
+		}
+		if !shared {
+			if err := deleteObjects(storage, storage.publicBucket, img.ObjectPaths()); err != nil {
+				return err
+			}
+			s.recordUsage(img, domain.StorageBucketPublic, -1)
 		}
 
-		// 3.软删除记录
-		if err := s.repo.Delete(tenantID, img.ID, false); err != nil {
+		// 2.软删除记录
+		if err := s.repo.Delete(tenantID, img.ID, false, token); err != nil {
 			return errors.WithStack(err)
 		}
 
-		// 4.将id记录到消息队列
+		// 回收站中的图片不再提供变换 恢复后按需重新生成
+		if !shared {
+			s.purgeTransformCache(storage, img)
+		}
+
+		// 3.将id记录到消息队列
 		if err := s.msgQueue.AddToDeleteQueue(tenantID, img.ID); err != nil {
 			zap.L().Error("图片软删除：添加到定时删除队列失败",
 				zap.String("img_id", img.ID.String()),
@@ -321,7 +498,7 @@ const deletedPresignExpired = 1 * time.Minute
 
 func (s *service) ListByKeyset(query *domain.ListByKeysetQuery) (*domain.ListByKeysetResult, error) {
 	// 加载配置
-	r2Config, err := s.getTenantR2Config(query.TenantID)
+	storage, err := s.getTenantStorage(query.TenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -330,83 +507,126 @@ func (s *service) ListByKeyset(query *domain.ListByKeysetQuery) (*domain.ListByK
 	if err != nil {
 		return nil, err
 	}
+	if err := s.attachVariants(res.Items...); err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(res.Items...); err != nil {
+		return nil, err
+	}
 	if query.Deleted {
 		for i := range res.Items {
-			presignUrl, err := s.getPresignURL(r2Config.presignClient, r2Config.deleteBucket, res.Items[i].Path, deletedPresignExpired)
+			presignUrl, err := storage.storage.Presign(storage.deleteBucket, res.Items[i].ObjectPath, deletedPresignExpired)
 			if err != nil {
 				return nil, errors.WithStack(err)
 			}
-			res.Items[i].Path = presignUrl
+			res.Items[i].ObjectPath = presignUrl
+
+			for _, variant := range res.Items[i].Variants {
+				presignUrl, err := storage.storage.Presign(storage.deleteBucket, variant.Path, deletedPresignExpired)
+				if err != nil {
+					return nil, errors.WithStack(err)
+				}
+				variant.Path = presignUrl
+			}
 		}
 	}
 
 	for i := range res.Items {
-		res.Items[i].SetPublicPreURL(r2Config.publicURLPrefix)
+		res.Items[i].SetPublicPreURL(storage.publicURLPrefix)
 	}
 
 	return res, nil
 }
 
+// ListenDeleteQueue 彻底删除到期的回收站图片
+// 任务至少处理一次 处理过程须幂等: 先删除存储对象再删除记录 记录不存在或已恢复时视为完成
 func (s *service) ListenDeleteQueue() {
-	s.msgQueue.ListenDeleteQueue(func(tenantID domain.TenantID, imgID domain.ImgID) {
-		// 加载配置
-		r2Config, err := s.getTenantR2Config(tenantID)
+	s.msgQueue.ListenDeleteQueue(func(tenantID domain.TenantID, imgID domain.ImgID) error {
+		unlock, token, err := s.lockImg(imgID)
 		if err != nil {
-			zap.L().Error("加载租户R2配置失败",
-				zap.String("tenant_id:", tenantID.String()),
-				zap.Error(err),
-			)
-			return
+			return err
+		}
+		defer unlock()
+
+		// 迁移期间延后删除 队列不计入重试次数
+		if err := s.checkStorageMigrationIdle(tenantID); err != nil {
+			return err
 		}
 
 		//1.先查询img
 		img, err := s.repo.FindByID(tenantID, imgID, true)
 		if err != nil {
-			zap.L().Error("定时删除队列：查询图片失败",
-				zap.String("tenant_id:", tenantID.String()),
-				zap.String("img_id", imgID.String()),
-				zap.Error(err),
-			)
-			return
+			if errors.Is(err, codes.ErrImgNotFound) {
+				return nil
+			}
+			return err
+		}
+		if !img.IsDeleted() {
+			return nil
 		}
 
-		log.Println(img)
+		unlockObject, err := s.lockObject(tenantID, img.ObjectPath)
+		if err != nil {
+			return err
+		}
+		defer unlockObject()
 
-		//2.删除记录
-		if err := s.repo.Delete(tenantID, imgID, true); err != nil {
-			zap.L().Error("定时删除队列：删除数据库记录失败",
-				zap.String("tenant_id:", tenantID.String()),
-				zap.String("img_id", imgID.String()),
-				zap.String("path", img.Path),
-				zap.Error(err),
-			)
-			return
+		// 加载配置
+		storage, err := s.getTenantStorage(tenantID)
+		if err != nil {
+			return err
 		}
 
-		// 3.删除R3
-		if err := s.DeleteFile(r2Config.s3Client, r2Config.deleteBucket, img.Path); err != nil {
-			zap.L().Error("定时删除队列：删除存储文件失败",
-				zap.String("img_id", imgID.String()),
-				zap.String("path", img.Path),
-				zap.String("bucket", r2Config.deleteBucket.string()),
-				zap.Error(err),
-			)
+		// 记录删除后缩放版本随之级联删除 需提前加载
+		if err := s.attachVariants(img); err != nil {
+			return err
 		}
 
+		shared, err := s.isObjectShared(img, true)
+		if err != nil {
+			return err
+		}
+
+		// 2.删除存储对象 回收站中仍有其他图片引用时保留
+		if !shared {
+			if err := deleteObjects(storage, storage.deleteBucket, img.ObjectPaths()); err != nil {
+				return err
+			}
+			s.recordUsage(img, domain.StorageBucketDelete, -1)
+		}
+
+		//3.删除记录
+		if err := s.repo.Delete(tenantID, imgID, true, token); err != nil && !errors.Is(err, codes.ErrImgNotFound) {
+			return err
+		}
+		s.deleteOriginal(storage, img)
+
 		zap.L().Info("定时删除队列：图片删除成功",
 			zap.String("img_id", imgID.String()),
 			zap.String("path", img.Path),
 		)
+
+		return nil
 	})
 }
 
+func (s *service) ListDeleteDeadLetters(tenantID domain.TenantID) ([]*domain.DeleteDeadLetter, error) {
+	return s.msgQueue.ListDeleteDeadLetters(tenantID)
+}
+
 // ClearRecycleBin 删除被软删除的数据
 // 此时删除 deleteBucket对象 数据库记录 消息队列key
 func (s *service) ClearRecycleBin(tenantID domain.TenantID, imgID domain.ImgID) error {
-	value, _ := s.imgMutex.LoadOrStore(imgID, &sync.Mutex{})
-	mu := value.(*sync.Mutex)
-	mu.Lock()
-	defer mu.Unlock()
+	unlock, token, err := s.lockImg(imgID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// 迁移只同步新增对象 期间删除、恢复的对象会残留在目标存储中
+	if err := s.checkStorageMigrationIdle(tenantID); err != nil {
+		return err
+	}
 
 	// 1.先查询图片信息
 	img, err := s.repo.FindByID(tenantID, imgID, true)
@@ -418,21 +638,41 @@ func (s *service) ClearRecycleBin(tenantID domain.TenantID, imgID domain.ImgID)
 		return codes.ErrImgIllegalOperation
 	}
 
+	// 引用计数与对象增删须在对象锁内完成
+	unlockObject, err := s.lockObject(tenantID, img.ObjectPath)
+	if err != nil {
+		return err
+	}
+	defer unlockObject()
+
 	// 加载配置
-	r2Config, err := s.getTenantR2Config(tenantID)
+	storage, err := s.getTenantStorage(tenantID)
 	if err != nil {
 		return err
 	}
 
-	// 2.删除 deleteBucket 中的文件
-	if err := s.DeleteFile(r2Config.s3Client, r2Config.deleteBucket, img.Path); err != nil {
+	if err := s.attachVariants(img); err != nil {
+		return err
+	}
+
+	shared, err := s.isObjectShared(img, true)
+	if err != nil {
 		return err
 	}
 
+	// 2.删除 deleteBucket 中的原图及缩放版本 回收站中仍有其他图片引用时保留
+	if !shared {
+		if err := deleteObjects(storage, storage.deleteBucket, img.ObjectPaths()); err != nil {
+			return err
+		}
+		s.recordUsage(img, domain.StorageBucketDelete, -1)
+	}
+
 	// 3.硬删除数据库记录
-	if err := s.repo.Delete(tenantID, imgID, true); err != nil {
+	if err := s.repo.Delete(tenantID, imgID, true, token); err != nil {
 		return err
 	}
+	s.deleteOriginal(storage, img)
 
 	// 4.从删除队列中移除（防止定时器重复删除）
 	if err := s.msgQueue.RemoveFromDeleteQueue(tenantID, imgID); err != nil {
@@ -446,10 +686,16 @@ func (s *service) ClearRecycleBin(tenantID domain.TenantID, imgID domain.ImgID)
 }
 
 func (s *service) RestoreFromRecycleBin(tenantID domain.TenantID, imgID domain.ImgID) error {
-	value, _ := s.imgMutex.LoadOrStore(imgID, &sync.Mutex{})
-	mu := value.(*sync.Mutex)
-	mu.Lock()
-	defer mu.Unlock()
+	unlock, token, err := s.lockImg(imgID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// 迁移只同步新增对象 期间删除、恢复的对象会残留在目标存储中
+	if err := s.checkStorageMigrationIdle(tenantID); err != nil {
+		return err
+	}
 
 	// 1.查询已软删除的图片信息
 	img, err := s.repo.FindByID(tenantID, imgID, true)
@@ -461,29 +707,55 @@ func (s *service) RestoreFromRecycleBin(tenantID domain.TenantID, imgID domain.I
 		return codes.ErrImgIllegalOperation
 	}
 
+	// 引用计数与对象增删须在对象锁内完成
+	unlockObject, err := s.lockObject(tenantID, img.ObjectPath)
+	if err != nil {
+		return err
+	}
+	defer unlockObject()
+
 	// 加载配置
-	r2Config, err := s.getTenantR2Config(tenantID)
+	storage, err := s.getTenantStorage(tenantID)
 	if err != nil {
 		return err
 	}
 
-	// 2.从 deleteBucket 复制回 publicBucket
-	if err := s.CopyFileToAnotherBucket(r2Config.s3Client, r2Config.deleteBucket, r2Config.publicBucket, img.Path); err != nil {
+	if err := s.attachVariants(img); err != nil {
 		return err
 	}
 
-	// 3.删除 deleteBucket 中的文件
-	if err := s.DeleteFile(r2Config.s3Client, r2Config.deleteBucket, img.Path); err != nil {
+	shared, err := s.isObjectShared(img, true)
+	if err != nil {
 		return err
 	}
 
-	// 4.恢复数据库记录（取消软删除）
-	res, err := s.repo.Restore(tenantID, imgID)
+	// 公共桶中仍有其他图片引用同一对象时 复制不会新增对象
+	sharedInPublic, err := s.isObjectShared(img, false)
+	if err != nil {
+		return err
+	}
+
+	// 2.原图及缩放版本复制回 publicBucket 回收站中无其他引用时删除 deleteBucket 中的对象
+	if err := copyObjects(storage, storage.deleteBucket, storage.publicBucket, img.ObjectPaths()); err != nil {
+		return err
+	}
+	if !sharedInPublic {
+		s.recordUsage(img, domain.StorageBucketPublic, 1)
+	}
+	if !shared {
+		if err := deleteObjects(storage, storage.deleteBucket, img.ObjectPaths()); err != nil {
+			return err
+		}
+		s.recordUsage(img, domain.StorageBucketDelete, -1)
+	}
+
+	// 3.恢复数据库记录（取消软删除）
+	res, err := s.repo.Restore(tenantID, imgID, token)
 	if err != nil {
 		return err
 	}
 
-	// 5.从删除队列中移除
+	// 4.从删除队列中移除
 	if err := s.msgQueue.RemoveFromDeleteQueue(tenantID, imgID); err != nil {
 		zap.L().Error("从回收站恢复：移除定时删除任务失败",
 			zap.String("img_id", imgID.String()),
@@ -491,15 +763,19 @@ func (s *service) RestoreFromRecycleBin(tenantID domain.TenantID, imgID domain.I
 		)
 	}
 
-	res.SetPublicPreURL(r2Config.publicURLPrefix)
+	res.SetPublicPreURL(storage.publicURLPrefix)
 
 	return nil
 }
 
-const maxCategory = 10
+const (
+	maxCategory = 50
+	// maxCategoryDepth 顶级分类层级为 0 最多 5 层
+	maxCategoryDepth = 4
+)
 
 func (s *service) CreateCategory(category *domain.Category) error {
-	exist, err := s.repo.CategoryExistByTitle(category.TenantID, category.Title)
+	exist, err := s.repo.CategoryExistByTitle(category.TenantID, category.ParentID, category.Title)
 	if err != nil {
 		return err
 	}
@@ -507,6 +783,21 @@ func (s *service) CreateCategory(category *domain.Category) error {
 		return codes.ErrImgCategoryTitleRepeat
 	}
 
+	// 根据父分类计算物化路径与层级
+	category.TreePath = "/"
+	category.Depth = 0
+	if category.ParentID != "" {
+		parent, err := s.repo.FindCategoryByID(category.TenantID, category.ParentID)
+		if err != nil {
+			return err
+		}
+		if parent.Depth >= maxCategoryDepth {
+			return codes.ErrImgCategoryTooDeep
+		}
+		category.TreePath = parent.SubtreePath()
+		category.Depth = parent.Depth + 1
+	}
+
 	count, err := s.repo.CountCategory(category.TenantID)
 	if err != nil {
 		return err
@@ -531,37 +822,62 @@ func (s *service) isCategoryExistImg(tenantID domain.TenantID, categoryID domain
 	return nil
 }
 
-func (s *service) UpdateCategory(category *domain.Category) error {
-	// 1.除开自己以外 是否有与修改之后title相同的数据
-	stored, err := s.repo.FindCategoryByTitle(category.TenantID, category.Title)
+// UpdateCategory 修改前缀且分类下存在图片时 创建迁移任务将图片移动到新前缀下
+func (s *service) UpdateCategory(category *domain.Category) (*domain.CategoryJob, error) {
+	old, err := s.repo.FindCategoryByID(category.TenantID, category.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1.除开自己以外 同级分类中是否有与修改之后title相同的数据
+	stored, err := s.repo.FindCategoryByTitle(category.TenantID, old.ParentID, category.Title)
 	if err != nil && !errors.Is(err, codes.ErrImgCategoryNotFound) {
-		return err
+		return nil, err
 	}
 	if stored != nil && stored.ID != category.ID {
-		return codes.ErrImgCategoryTitleRepeat
+		return nil, codes.ErrImgCategoryTitleRepeat
 	}
 
-	// 2.再去查询原先数据 比对path是否一致
+	// 2.比对原先数据 path是否一致
 	// 若一致则允许更新
-	// 若不一致 则需该分类下无图片关联方可进行修改
-	old, err := s.repo.FindCategoryByID(category.TenantID, category.ID)
-	if err != nil {
-		return err
-	}
-	// 若不一致
+	// 若不一致 分类下无图片时直接更新 否则创建迁移任务
 	if old.Prefix == category.Prefix {
-		return s.repo.UpdateCategory(category)
+		return nil, s.repo.UpdateCategory(category)
 	}
 
-	// 若一致
-	if err := s.isCategoryExistImg(category.TenantID, category.ID); err != nil {
-		return err
+	// 迁移中的分类不允许再次修改前缀
+	if err := s.checkCategoryJobIdle(category.TenantID, category.ID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.IsCategoryExistImg(category.TenantID, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateCategory(category); err != nil {
+		return nil, err
+	}
+	if !existing {
+		return nil, nil
 	}
 
-	return s.repo.UpdateCategory(category)
+	return s.createCategoryJob(&domain.CategoryJob{
+		TenantID:   category.TenantID,
+		CategoryID: category.ID,
+		Kind:       domain.CategoryJobKindRename,
+	})
 }
 
 func (s *service) DeleteCategory(tenantID domain.TenantID, categoryID domain.CategoryID) error {
+	// 作为迁移目标的分类不能删除
+	if err := s.checkCategoryJobIdle(tenantID, categoryID); err != nil {
+		return err
+	}
+
+	if err := s.checkCategoryNoChildren(tenantID, categoryID); err != nil {
+		return err
+	}
+
 	// 检验当前分类下是否存在图片
 	if err := s.isCategoryExistImg(tenantID, categoryID); err != nil {
 		return err
@@ -570,44 +886,207 @@ func (s *service) DeleteCategory(tenantID domain.TenantID, categoryID domain.Cat
 	return s.repo.DeleteCategory(tenantID, categoryID)
 }
 
+// MoveCategory 将分类连同子分类移动到新的父分类下 parentID 为空表示移动为顶级分类
+// 分类的存储前缀不随层级变化 因此无需迁移图片
+func (s *service) MoveCategory(tenantID domain.TenantID, categoryID, parentID domain.CategoryID) error {
+	category, err := s.repo.FindCategoryByID(tenantID, categoryID)
+	if err != nil {
+		return err
+	}
+	if category.ParentID == parentID {
+		return nil
+	}
+
+	// 1.计算新的物化路径与层级 不能移动到自身或子孙分类下
+	treePath, depth := "/", 0
+	if parentID != "" {
+		if parentID == categoryID {
+			return codes.ErrImgCategoryMoveCycle
+		}
+		parent, err := s.repo.FindCategoryByID(tenantID, parentID)
+		if err != nil {
+			return err
+		}
+		if category.IsAncestorOf(parent) {
+			return codes.ErrImgCategoryMoveCycle
+		}
+		treePath, depth = parent.SubtreePath(), parent.Depth+1
+	}
+
+	// 2.子树最深处不能超过层级限制
+	maxDepth, err := s.repo.MaxSubtreeDepth(category)
+	if err != nil {
+		return err
+	}
+	depthDelta := depth - category.Depth
+	if maxDepth+depthDelta > maxCategoryDepth {
+		return codes.ErrImgCategoryTooDeep
+	}
+
+	// 3.新的同级分类中名称不能重复
+	exist, err := s.repo.CategoryExistByTitle(tenantID, parentID, category.Title)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return codes.ErrImgCategoryTitleRepeat
+	}
+
+	oldSubtreePath := category.SubtreePath()
+	category.ParentID = parentID
+	category.TreePath = treePath
+	category.Depth = depth
+
+	return s.repo.MoveCategory(category, oldSubtreePath, depthDelta)
+}
+
+func (s *service) checkCategoryNoChildren(tenantID domain.TenantID, categoryID domain.CategoryID) error {
+	exist, err := s.repo.CategoryExistChildren(tenantID, categoryID)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return codes.ErrImgCategoryHasChildren
+	}
+	return nil
+}
+
 func (s *service) AllCategories(tenantID domain.TenantID) (categories []*domain.Category, err error) {
 	return s.repo.AllCategories(tenantID)
 }
 
-func (s *service) SetR2Config(config *domain.R2Config) error {
-	if err := s.repo.SetTenantR2Config(config); err != nil {
+func (s *service) SetStorageConfig(config *domain.StorageConfig) error {
+	if err := checkStorageConfig(config); err != nil {
+		return err
+	}
+	if err := s.checkStorageMigrationIdle(config.TenantID); err != nil {
 		return err
 	}
 
-	// 删除缓存中的旧配置，强制下次重新加载
-	s.tenantR2.Delete(config.TenantID)
+	if err := s.repo.SetTenantStorageConfig(config); err != nil {
+		return err
+	}
+
+	// 删除所有实例缓存中的旧配置，强制下次重新加载
+	s.invalidateTenantStorage(config.TenantID)
 
 	return nil
 }
 
-func (s *service) GetR2Config(tenantID domain.TenantID) (*domain.R2Config, error) {
-	return s.repo.GetTenantR2Config(tenantID)
+// checkStorageConfig 校验各存储类型的必填项
+func checkStorageConfig(config *domain.StorageConfig) error {
+	missing := func(field string) error {
+		return codes.ErrImgStorageConfigInvalid.WithDetail(map[string]any{
+			"provider": config.Provider,
+			"field":    field,
+		})
+	}
+
+	switch config.Provider {
+	case domain.StorageProviderR2:
+		if config.AccountID == "" {
+			return missing("account_id")
+		}
+		if config.PublicURLPrefix == "" {
+			return missing("public_url_prefix")
+		}
+		// R2 端点由 AccountID 推导
+		config.Endpoint, config.Region, config.UsePathStyle = "", "", false
+	case domain.StorageProviderS3:
+		if config.Region == "" {
+			return missing("region")
+		}
+		if config.PublicURLPrefix == "" {
+			return missing("public_url_prefix")
+		}
+		config.AccountID = ""
+	case domain.StorageProviderLocal:
+		// 本地存储的公共访问前缀由服务端生成
+		config.AccountID, config.Endpoint, config.Region, config.AccessKeyID = "", "", "", ""
+		config.UsePathStyle = false
+		config.PublicURLPrefix = ""
+	default:
+		return missing("provider")
+	}
+
+	if config.NeedCredentials() && config.AccessKeyID == "" {
+		return missing("access_key_id")
+	}
+
+	if config.PublicBucket == config.DeleteBucket {
+		return missing("delete_bucket")
+	}
+
+	return nil
+}
+
+func (s *service) GetStorageConfig(tenantID domain.TenantID) (*domain.StorageConfig, error) {
+	return s.repo.GetTenantStorageConfig(tenantID)
 }
 
-func (s *service) SetR2SecretKey(tenantID domain.TenantID, secretAccessKey domain.R2SecretAccessKey) error {
-	exist, err := s.repo.ExistTenantR2Config(tenantID)
+func (s *service) SetStorageSecretKey(tenantID domain.TenantID, secretAccessKey domain.StorageSecretAccessKey) error {
+	exist, err := s.repo.ExistTenantStorageConfig(tenantID)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
 	if !exist {
-		return codes.ErrImgR2ConfigNotFound
+		return codes.ErrImgStorageConfigNotFound
+	}
+	if err := s.checkStorageMigrationIdle(tenantID); err != nil {
+		return err
 	}
 
-	encryptSecret, err := s.ace256Encryptor.Encrypt(string(secretAccessKey))
+	encryptSecret, err := s.secretEncryptor.Encrypt(string(secretAccessKey))
 	if err != nil {
 		return err
 	}
 
-	return s.repo.SetR2SecretKey(tenantID, domain.R2SecretAccessKey(encryptSecret))
+	if err := s.repo.SetStorageSecretKey(tenantID, domain.StorageSecretAccessKey(encryptSecret)); err != nil {
+		return err
+	}
+
+	s.invalidateTenantStorage(tenantID)
+
+	return nil
+}
+
+func (s *service) IsSetStorageSecretKey(tenantID domain.TenantID) (bool, error) {
+	return s.repo.IsSetStorageSecretKey(tenantID)
+}
+
+func (s *service) GetImgSetting(tenantID domain.TenantID) (*domain.ImgSetting, error) {
+	setting, err := s.repo.GetTenantImgSetting(tenantID)
+	if err != nil {
+		if errors.Is(err, codes.ErrImgSettingNotFound) {
+			return domain.DefaultImgSetting(tenantID), nil
+		}
+		return nil, err
+	}
+	return setting, nil
+}
 
+func (s *service) SetImgSetting(setting *domain.ImgSetting) error {
+	return s.repo.SetTenantImgSetting(setting)
 }
 
-func (s *service) IsSetR2SecretKey(tenantID domain.TenantID) (bool, error) {
-	return s.repo.IsSetR2SecretKey(tenantID)
+func (s *service) ReadLocalObject(tenantID domain.TenantID, bucket, key string, expires int64, signature string) (io.ReadCloser, error) {
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	selfServed, ok := storage.storage.(domain.SelfServedStorage)
+	if !ok {
+		return nil, codes.ErrImgStorageObjectNotFound
+	}
+
+	// 公共桶直接访问 其余桶(回收站)需校验预签名
+	if bucket != storage.publicBucket {
+		if err := selfServed.VerifyPresign(bucket, key, expires, signature); err != nil {
+			return nil, err
+		}
+	}
+
+	return storage.storage.Get(bucket, key)
 }
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"slices"
+)
+
+const maxTag = 100
+
+func (s *service) CreateTag(tag *domain.Tag) error {
+	exist, err := s.repo.TagExistByName(tag.TenantID, tag.Name)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return codes.ErrImgTagNameRepeat
+	}
+
+	count, err := s.repo.CountTags(tag.TenantID)
+	if err != nil {
+		return err
+	}
+	if count >= maxTag {
+		return codes.ErrImgTagTooMany
+	}
+
+	return s.repo.CreateTag(tag)
+}
+
+// DeleteTag 删除标签时一并解除与图片的关联
+func (s *service) DeleteTag(tenantID domain.TenantID, tagID domain.TagID) error {
+	return s.repo.DeleteTag(tenantID, tagID)
+}
+
+func (s *service) AllTags(tenantID domain.TenantID) ([]*domain.Tag, error) {
+	return s.repo.AllTags(tenantID)
+}
+
+// SetImgTags 覆盖图片的全部标签 标签须属于同一租户
+func (s *service) SetImgTags(tenantID domain.TenantID, imgID domain.ImgID, tagIDs []domain.TagID) error {
+	if _, err := s.repo.FindByID(tenantID, imgID); err != nil {
+		return err
+	}
+
+	tagIDs = slices.Clone(tagIDs)
+	slices.Sort(tagIDs)
+	tagIDs = slices.Compact(tagIDs)
+
+	if len(tagIDs) > 0 {
+		count, err := s.repo.CountTags(tenantID, tagIDs...)
+		if err != nil {
+			return err
+		}
+		if count != int64(len(tagIDs)) {
+			return codes.ErrImgTagNotFound
+		}
+	}
+
+	return s.repo.SetImgTags(imgID, tagIDs)
+}
+
+// attachTags 批量加载图片的标签
+func (s *service) attachTags(imgs ...*domain.Img) error {
+	if len(imgs) == 0 {
+		return nil
+	}
+
+	ids := make([]domain.ImgID, 0, len(imgs))
+	for _, img := range imgs {
+		ids = append(ids, img.ID)
+	}
+
+	grouped, err := s.repo.ListImgTags(ids...)
+	if err != nil {
+		return err
+	}
+	for _, img := range imgs {
+		img.Tags = grouped[img.ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/url"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// bucketTransformCache 未配置本地缓存目录时 变换结果缓存到租户公共桶的 _transform/ 前缀下
+type bucketTransformCache struct {
+	storage *tenantStorage
+}
+
+func (c *bucketTransformCache) objectKey(imgPath, key string) string {
+	return domain.TransformCachePrefix + imgPath + "/" + key
+}
+
+func (c *bucketTransformCache) Get(_ domain.TenantID, imgPath, key string) ([]byte, error) {
+	body, err := c.storage.storage.Get(c.storage.publicBucket, c.objectKey(imgPath, key))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+func (c *bucketTransformCache) Put(_ domain.TenantID, imgPath, key string, data []byte) error {
+	format := domain.DetectImageFormat(data)
+	return c.storage.storage.Put(c.storage.publicBucket, c.objectKey(imgPath, key), bytes.NewReader(data), format.ContentType())
+}
+
+func (c *bucketTransformCache) Purge(_ domain.TenantID, imgPath string) error {
+	prefix := domain.TransformCachePrefix + imgPath + "/"
+	token := ""
+	for {
+		list, err := c.storage.storage.List(c.storage.publicBucket, prefix, token, 1000)
+		if err != nil {
+			return err
+		}
+		for _, object := range list.Objects {
+			if err := c.storage.storage.Delete(c.storage.publicBucket, object.Key); err != nil {
+				return err
+			}
+		}
+		if list.NextToken == "" {
+			return nil
+		}
+		token = list.NextToken
+	}
+}
+
+func (s *service) transformCacheOf(storage *tenantStorage) domain.TransformCache {
+	if s.transformCache != nil {
+		return s.transformCache
+	}
+	return &bucketTransformCache{storage: storage}
+}
+
+// purgeTransformCache 存储对象从公共桶删除时清理变换缓存 失败仅记录日志
+func (s *service) purgeTransformCache(storage *tenantStorage, img *domain.Img) {
+	if err := s.transformCacheOf(storage).Purge(img.TenantID, img.ObjectPath); err != nil {
+		zap.L().Error("清理图片变换缓存失败",
+			zap.String("img_id", img.ID.String()),
+			zap.String("path", img.Path),
+			zap.Error(err),
+		)
+	}
+}
+
+func normalizeTransformOptions(opts *domain.TransformOptions) error {
+	if opts.Fit == "" {
+		opts.Fit = domain.TransformFitContain
+	}
+
+	switch {
+	case opts.Width < 0 || opts.Width > domain.MaxTransformDimension:
+		return codes.ErrImgTransformInvalid.WithDetail(map[string]any{"w": opts.Width})
+	case opts.Height < 0 || opts.Height > domain.MaxTransformDimension:
+		return codes.ErrImgTransformInvalid.WithDetail(map[string]any{"h": opts.Height})
+	case opts.Width == 0 && opts.Height == 0 && opts.Format == domain.ImageFormatUnknown:
+		return codes.ErrImgTransformInvalid.WithDetail(map[string]any{"reason": "empty transform"})
+	case !opts.Fit.IsValid():
+		return codes.ErrImgTransformInvalid.WithDetail(map[string]any{"fit": opts.Fit})
+	case opts.Format != domain.ImageFormatUnknown && !opts.Format.IsTransformable():
+		return codes.ErrImgTransformInvalid.WithDetail(map[string]any{"format": opts.Format})
+	case opts.Quality < 0 || opts.Quality > 100:
+		return codes.ErrImgTransformInvalid.WithDetail(map[string]any{"q": opts.Quality})
+	case opts.Format == domain.ImageFormatWebP && opts.Quality > 0:
+		// WebP 仅支持无损编码 拒绝不会生效的 q
+		return codes.ErrImgTransformInvalid.WithDetail(map[string]any{"q": opts.Quality, "reason": "webp output is lossless"})
+	}
+
+	return nil
+}
+
+// signTransform 签名覆盖租户、图片路径与规范化后的参数 防止匿名用户生成任意变换
+func (s *service) signTransform(tenantID domain.TenantID, imgPath string, opts *domain.TransformOptions) string {
+	mac := hmac.New(sha256.New, s.transformSignKey)
+	mac.Write([]byte(tenantID.String() + "/" + imgPath + "?" + opts.Query().Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *service) TransformURL(tenantID domain.TenantID, imgID domain.ImgID, opts *domain.TransformOptions) (string, error) {
+	if err := normalizeTransformOptions(opts); err != nil {
+		return "", err
+	}
+
+	img, err := s.repo.FindByID(tenantID, imgID)
+	if err != nil {
+		return "", err
+	}
+	if img.IsDeleted() {
+		return "", codes.ErrImgIllegalOperation
+	}
+
+	// 变换读取的是存储对象 去重关联的图片使用共享对象的路径
+	segments := strings.Split(img.ObjectPath, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+
+	query := opts.Query()
+	query.Set("s", s.signTransform(tenantID, img.ObjectPath, opts))
+
+	return s.transformBaseURL + "/" + tenantID.String() + "/" + strings.Join(segments, "/") + "?" + query.Encode(), nil
+}
+
+func (s *service) Transform(tenantID domain.TenantID, imgPath string, opts *domain.TransformOptions, signature string) (*domain.ProcessedImage, error) {
+	if err := normalizeTransformOptions(opts); err != nil {
+		return nil, err
+	}
+	if imgPath == "" || strings.HasPrefix(imgPath, domain.TransformCachePrefix) {
+		return nil, codes.ErrImgStorageObjectKeyInvalid
+	}
+	if !hmac.Equal([]byte(s.signTransform(tenantID, imgPath, opts)), []byte(signature)) {
+		return nil, codes.ErrImgTransformSignature
+	}
+
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := s.transformCacheOf(storage)
+	key := opts.CacheKey()
+
+	// 1.命中缓存直接返回
+	cached, err := cache.Get(tenantID, imgPath, key)
+	if err == nil {
+		return &domain.ProcessedImage{
+			Data:   cached,
+			Format: domain.DetectImageFormat(cached),
+		}, nil
+	}
+	if !errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+		zap.L().Error("读取图片变换缓存失败",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("path", imgPath),
+			zap.Error(err),
+		)
+	}
+
+	// 2.读取原图 已移入回收站的图片不在公共桶中
+	body, err := storage.storage.Get(storage.publicBucket, imgPath)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	src, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 3.变换并写入缓存
+	res, err := s.processor.Transform(src, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.Put(tenantID, imgPath, key, res.Data); err != nil {
+		zap.L().Error("写入图片变换缓存失败",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("path", imgPath),
+			zap.Error(err),
+		)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"path"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// uploadPresignExpire 直传链接有效期
+	uploadPresignExpire = 15 * time.Minute
+	// uploadSlotExpire 凭证有效期长于直传链接 留出上传完成后调用确认接口的时间
+	uploadSlotExpire = 1 * time.Hour
+	// uploadStagingSweepInterval 清理未确认暂存对象的周期
+	uploadStagingSweepInterval = 1 * time.Hour
+)
+
+func genUploadSlotID() (domain.UploadSlotID, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return domain.UploadSlotID(hex.EncodeToString(bytes)), nil
+}
+
+// CreateUploadSlot 校验路径、分类、大小与格式后生成直传链接
+// 直传对象不经过服务端转码 也不生成缩放版本 按需使用变换接口
+func (s *service) CreateUploadSlot(slot *domain.UploadSlot) (*domain.PresignedUpload, error) {
+	if slot.Format == domain.ImageFormatUnknown {
+		return nil, codes.ErrImgUnsupportedFormat
+	}
+	// 直传内容不经过服务端 无法清理 SVG 中的脚本
+	if slot.Format == domain.ImageFormatSVG {
+		return nil, codes.ErrImgUnsupportedFormat.WithDetail(map[string]any{
+			"format": slot.Format,
+			"reason": "svg must be uploaded through the server",
+		})
+	}
+	slot.Path = strings.TrimSuffix(slot.Path, path.Ext(slot.Path)) + slot.Format.Ext()
+
+	// 1.检查分类、水印与路径
+	var category *domain.Category
+	fullPath := slot.Path
+	if slot.CategoryID != "" {
+		var err error
+		category, err = s.repo.FindCategoryByID(slot.TenantID, slot.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		fullPath = category.Prefix + "/" + slot.Path
+	}
+	if err := s.checkDirectUpload(slot.TenantID, category); err != nil {
+		return nil, err
+	}
+
+	exist, err := s.repo.ExistByPath(slot.TenantID, fullPath)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, codes.ErrImgPathRepeat
+	}
+
+	// 加载配置
+	storage, err := s.getTenantStorage(slot.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	slot.ID, err = genUploadSlotID()
+	if err != nil {
+		return nil, err
+	}
+	slot.ExpiresAt = time.Now().Add(uploadPresignExpire)
+
+	// 2.暂存到不可公共访问的回收站桶 确认前不会被外部访问
+	contentType := slot.Format.ContentType()
+	presignURL, err := storage.storage.PresignPut(storage.deleteBucket, slot.StagingKey(), contentType, slot.Size, uploadPresignExpire)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3.保存凭证
+	if err := s.msgQueue.SaveUploadSlot(slot, uploadSlotExpire); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.PresignedUpload{
+		Slot: slot,
+		URL:  presignURL,
+		Headers: map[string]string{
+			"Content-Type": contentType,
+		},
+	}, nil
+}
+
+// ConfirmUpload 校验暂存对象的大小与文件头 计算内容哈希与尺寸后入库并复制到公共桶
+func (s *service) ConfirmUpload(tenantID domain.TenantID, slotID domain.UploadSlotID) (*domain.Img, error) {
+	// 防止同一凭证被并发确认
+	unlock, err := s.lock("upload_slot:" + slotID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	slot, err := s.msgQueue.GetUploadSlot(tenantID, slotID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 加载配置
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1.检查对象是否已上传且大小一致
+	info, err := storage.storage.Stat(storage.deleteBucket, slot.StagingKey())
+	if err != nil {
+		if errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+			return nil, codes.ErrImgUploadIncomplete
+		}
+		return nil, err
+	}
+	if info.Size != slot.Size {
+		s.discardUpload(storage, slot)
+		return nil, codes.ErrImgUploadIncomplete.WithDetail(map[string]any{
+			"expected": slot.Size,
+			"actual":   info.Size,
+		})
+	}
+
+	// 2.文件头须与申请的格式一致 防止借直传上传非图片文件
+	data, err := s.readStagingObject(storage, slot)
+	if err != nil {
+		return nil, err
+	}
+	probed, err := s.processor.Probe(data)
+	if err != nil && !errors.Is(err, codes.ErrImgUnsupportedFormat) {
+		s.discardUpload(storage, slot)
+		return nil, err
+	}
+	if probed == nil || probed.Format != slot.Format {
+		s.discardUpload(storage, slot)
+		actual := domain.ImageFormatUnknown
+		if probed != nil {
+			actual = probed.Format
+		}
+		return nil, codes.ErrImgUnsupportedFormat.WithDetail(map[string]any{
+			"expected": slot.Format,
+			"actual":   actual,
+		})
+	}
+
+	img := &domain.Img{
+		TenantID:         tenantID,
+		Path:             slot.Path,
+		Description:      slot.Description,
+		Size:             slot.Size,
+		MimeType:         slot.Format.ContentType(),
+		OriginalFilename: slot.OriginalFilename,
+		Width:            probed.Width,
+		Height:           probed.Height,
+		ContentHash:      contentHash(data),
+	}
+
+	// 3.内容与已有图片重复时按租户配置处理
+	setting, err := s.GetImgSetting(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := s.findDuplicate(tenantID, img.ContentHash, setting.DedupMode)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate != nil && setting.DedupMode == domain.DedupModeReuse {
+		s.discardUpload(storage, slot)
+		duplicate.Deduplicated = true
+		duplicate.SetPublicPreURL(storage.publicURLPrefix)
+		return duplicate, nil
+	}
+
+	// 4.入库 申请后可能已有同名图片 水印配置也可能已变更
+	var category *domain.Category
+	fullPath := slot.Path
+	if slot.CategoryID != "" {
+		category, err = s.repo.FindCategoryByID(tenantID, slot.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		fullPath = category.Prefix + "/" + slot.Path
+	}
+	if err := s.checkDirectUpload(tenantID, category); err != nil {
+		s.discardUpload(storage, slot)
+		return nil, err
+	}
+	exist, err := s.repo.ExistByPath(tenantID, fullPath)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, codes.ErrImgPathRepeat
+	}
+
+	// 关联已有对象 无需复制 已有图片期间被删除时按普通直传处理
+	if duplicate != nil {
+		res, err := s.linkDuplicate(img, slot.CategoryID, duplicate)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			s.discardUpload(storage, slot)
+			res.Deduplicated = true
+			res.SetPublicPreURL(storage.publicURLPrefix)
+			return res, nil
+		}
+		img.ObjectPath = ""
+	}
+
+	res, err := s.repo.Create(img, slot.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 5.复制到公共桶 失败则回滚记录
+	if err := storage.storage.Copy(storage.deleteBucket, slot.StagingKey(), storage.publicBucket, res.Path); err != nil {
+		if err := s.repo.Delete(tenantID, res.ID, true, 0); err != nil {
+			zap.L().Error("直传确认：复制对象失败，尝试回滚删除数据库记录时出错",
+				zap.String("tenant_id", tenantID.String()),
+				zap.String("id", res.ID.String()),
+				zap.String("path", res.Path),
+				zap.Error(err),
+			)
+		}
+		return nil, codes.ErrImgUploadToStorageFailed.WithCause(err)
+	}
+
+	s.recordUsage(res, domain.StorageBucketPublic, 1)
+
+	// 6.清理暂存对象与凭证
+	s.discardUpload(storage, slot)
+
+	res.SetPublicPreURL(storage.publicURLPrefix)
+
+	return res, nil
+}
+
+// readStagingObject 读取暂存对象 大小已在确认时与凭证比对
+func (s *service) readStagingObject(storage *tenantStorage, slot *domain.UploadSlot) ([]byte, error) {
+	body, err := storage.storage.Get(storage.deleteBucket, slot.StagingKey())
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, slot.Size))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return data, nil
+}
+
+// discardUpload 删除暂存对象与凭证 失败仅记录日志
+func (s *service) discardUpload(storage *tenantStorage, slot *domain.UploadSlot) {
+	if err := storage.storage.Delete(storage.deleteBucket, slot.StagingKey()); err != nil {
+		zap.L().Error("删除直传暂存对象失败",
+			zap.String("slot_id", slot.ID.String()),
+			zap.Error(err),
+		)
+	}
+	if err := s.msgQueue.RemoveUploadSlot(slot.TenantID, slot.ID); err != nil {
+		zap.L().Error("删除直传凭证失败",
+			zap.String("slot_id", slot.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// sweepUploadStagingPeriodically 定期删除凭证已过期仍未确认的暂存对象
+// 暂存对象不计入用量 也不参与对象比对 不清理会一直占用存储
+func (s *service) sweepUploadStagingPeriodically() {
+	ticker := time.NewTicker(uploadStagingSweepInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		tenantIDs, err := s.repo.AllStorageConfiguredTenants()
+		if err != nil {
+			zap.L().Error("清理直传暂存对象：查询租户失败", zap.Error(err))
+			continue
+		}
+
+		for _, tenantID := range tenantIDs {
+			if err := s.sweepUploadStaging(tenantID); err != nil {
+				zap.L().Error("清理直传暂存对象失败",
+					zap.String("tenant_id", tenantID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// sweepUploadStaging 凭证在申请时开始计时 早于申请的对象不存在 修改时间早于凭证有效期的对象其凭证必然已过期
+func (s *service) sweepUploadStaging(tenantID domain.TenantID) error {
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return err
+	}
+
+	staleBefore := time.Now().Add(-uploadSlotExpire)
+	var stale []string
+	token := ""
+	for {
+		list, err := storage.storage.List(storage.deleteBucket, domain.UploadStagingPrefix, token, 1000)
+		if err != nil {
+			return err
+		}
+		for _, object := range list.Objects {
+			if object.LastModified.Before(staleBefore) {
+				stale = append(stale, object.Key)
+			}
+		}
+		if list.NextToken == "" {
+			break
+		}
+		token = list.NextToken
+	}
+
+	return deleteObjects(storage, storage.deleteBucket, stale)
+}
+
+// WriteLocalObject 接收本地存储的直传 须携带有效的上传签名
+func (s *service) WriteLocalObject(tenantID domain.TenantID, bucket, key, contentType string, size int64, expires int64, signature string, body io.Reader) error {
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return err
+	}
+
+	selfServed, ok := storage.storage.(domain.SelfServedStorage)
+	if !ok {
+		return codes.ErrImgStorageObjectNotFound
+	}
+
+	if err := selfServed.VerifyPresignPut(bucket, key, contentType, size, expires, signature); err != nil {
+		return err
+	}
+
+	// 调用方已校验 Content-Length 此处仍限制读取量 防止请求体超出签名大小
+	return storage.storage.Put(bucket, key, io.LimitReader(body, size), contentType)
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"saas/internal/img/domain"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// usageReconcileInterval 用量校准周期 增量记录失败或并发操作造成的偏差在校准后修正
+const usageReconcileInterval = 6 * time.Hour
+
+// objectUsage 图片原图及缩放版本占用的字节数与对象数
+func objectUsage(img *domain.Img) (bytes int64, objects int64) {
+	bytes = img.Size
+	for _, variant := range img.Variants {
+		bytes += variant.Size
+	}
+	return bytes, int64(len(img.ObjectPaths()))
+}
+
+// recordUsage 记录图片存储对象在指定桶中的增减 sign 为 1 表示写入 -1 表示删除
+// 失败仅记录日志 由定期校准修正
+func (s *service) recordUsage(img *domain.Img, bucket domain.StorageBucketKind, sign int64) {
+	bytes, objects := objectUsage(img)
+	err := s.repo.AddStorageUsage(&domain.StorageUsage{
+		TenantID:   img.TenantID,
+		CategoryID: img.CategoryID,
+		Bucket:     bucket,
+		Bytes:      sign * bytes,
+		Objects:    sign * objects,
+	})
+	if err != nil {
+		zap.L().Error("记录租户存储用量失败",
+			zap.String("tenant_id", img.TenantID.String()),
+			zap.String("img_id", img.ID.String()),
+			zap.String("bucket", string(bucket)),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *service) GetStorageUsage(tenantID domain.TenantID) (*domain.StorageUsageReport, error) {
+	usages, err := s.repo.ListStorageUsages(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.StorageUsageReport{Items: usages}, nil
+}
+
+// ReconcileStorageUsage 列举租户两个桶中的实际对象 按分类前缀汇总后更新用量记录
+// 列举前记录快照 列举期间其他操作累加的增量在写入时保留
+func (s *service) ReconcileStorageUsage(tenantID domain.TenantID) (*domain.StorageUsageReport, error) {
+	// 各实例都会定期校准 同一租户的校准互斥 避免快照相同的两次校准重复叠加增量
+	unlock, err := s.lock("img_usage_reconcile:" + tenantID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := s.repo.ListStorageUsages(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.repo.AllCategories(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	categoryByPrefix := make(map[string]domain.CategoryID, len(categories))
+	for _, category := range categories {
+		categoryByPrefix[category.Prefix] = category.ID
+	}
+
+	now := time.Now()
+	var usages []*domain.StorageUsage
+	for _, bucket := range []struct {
+		kind domain.StorageBucketKind
+		name string
+	}{
+		{domain.StorageBucketPublic, storage.publicBucket},
+		{domain.StorageBucketDelete, storage.deleteBucket},
+	} {
+		grouped := make(map[domain.CategoryID]*domain.StorageUsage)
+		err := listAllObjects(storage, bucket.name, func(object *domain.ObjectInfo) {
+			if domain.IsInternalObjectKey(object.Key) {
+				return
+			}
+
+			// 分类下的图片路径为 {prefix}/{path}
+			var categoryID domain.CategoryID
+			if prefix, _, ok := strings.Cut(object.Key, "/"); ok {
+				categoryID = categoryByPrefix[prefix]
+			}
+
+			usage, ok := grouped[categoryID]
+			if !ok {
+				usage = &domain.StorageUsage{
+					TenantID:     tenantID,
+					CategoryID:   categoryID,
+					Bucket:       bucket.kind,
+					ReconciledAt: now,
+				}
+				grouped[categoryID] = usage
+				usages = append(usages, usage)
+			}
+			usage.Bytes += object.Size
+			usage.Objects++
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.ApplyReconciledStorageUsages(tenantID, usages, snapshot); err != nil {
+		return nil, err
+	}
+
+	return s.GetStorageUsage(tenantID)
+}
+
+// reconcileStorageUsages 定期校准全部已配置存储的租户
+func (s *service) reconcileStorageUsages() {
+	ticker := time.NewTicker(usageReconcileInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		tenantIDs, err := s.repo.AllStorageConfiguredTenants()
+		if err != nil {
+			zap.L().Error("存储用量校准：查询租户失败", zap.Error(err))
+			continue
+		}
+
+		for _, tenantID := range tenantIDs {
+			if _, err := s.ReconcileStorageUsage(tenantID); err != nil {
+				zap.L().Error("存储用量校准失败",
+					zap.String("tenant_id", tenantID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+func listAllObjects(storage *tenantStorage, bucket string, fn func(object *domain.ObjectInfo)) error {
+	token := ""
+	for {
+		list, err := storage.storage.List(bucket, "", token, 1000)
+		if err != nil {
+			return err
+		}
+		for _, object := range list.Objects {
+			fn(object)
+		}
+		if list.NextToken == "" {
+			return nil
+		}
+		token = list.NextToken
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"bytes"
+	"saas/internal/img/domain"
+	"slices"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// uploadVariants 按租户配置生成并上传缩放版本 返回上传成功的版本
+// 缩放版本失败不影响原图上传 仅记录日志
+func (s *service) uploadVariants(storage *tenantStorage, img *domain.Img, processed *domain.ProcessedImage, setting *domain.ImgSetting) []*domain.ImgVariant {
+	if !processed.Resizable() || len(setting.VariantWidths) == 0 {
+		return nil
+	}
+
+	widths := slices.Clone(setting.VariantWidths)
+	slices.Sort(widths)
+	widths = slices.Compact(widths)
+
+	variants := make([]*domain.ImgVariant, 0, len(widths))
+	for _, width := range widths {
+		// 不放大图片
+		if width >= processed.Width {
+			break
+		}
+
+		resized, err := s.processor.Resize(processed, width, setting.Quality)
+		if err != nil {
+			zap.L().Error("生成图片缩放版本失败",
+				zap.String("img_id", img.ID.String()),
+				zap.Int("width", width),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		variant := &domain.ImgVariant{
+			ImgID:  img.ID,
+			Width:  resized.Width,
+			Height: resized.Height,
+			Path:   domain.VariantPath(img.Path, width, resized.Format.Ext()),
+			Size:   int64(len(resized.Data)),
+		}
+		if err := storage.storage.Put(storage.publicBucket, variant.Path, bytes.NewReader(resized.Data), resized.Format.ContentType()); err != nil {
+			zap.L().Error("上传图片缩放版本失败",
+				zap.String("img_id", img.ID.String()),
+				zap.String("path", variant.Path),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		variants = append(variants, variant)
+	}
+
+	if err := s.repo.CreateVariants(variants); err != nil {
+		zap.L().Error("记录图片缩放版本失败 清理已上传的对象",
+			zap.String("img_id", img.ID.String()),
+			zap.Error(err),
+		)
+		for _, variant := range variants {
+			if err := storage.storage.Delete(storage.publicBucket, variant.Path); err != nil {
+				zap.L().Error("清理图片缩放版本失败", zap.String("path", variant.Path), zap.Error(err))
+			}
+		}
+		return nil
+	}
+
+	return variants
+}
+
+// attachVariants 批量加载图片的缩放版本
+func (s *service) attachVariants(imgs ...*domain.Img) error {
+	if len(imgs) == 0 {
+		return nil
+	}
+
+	ids := make([]domain.ImgID, 0, len(imgs))
+	for _, img := range imgs {
+		ids = append(ids, img.ID)
+	}
+
+	variants, err := s.repo.ListVariants(ids...)
+	if err != nil {
+		return err
+	}
+
+	grouped := make(map[domain.ImgID][]*domain.ImgVariant, len(imgs))
+	for _, variant := range variants {
+		grouped[variant.ImgID] = append(grouped[variant.ImgID], variant)
+	}
+	for _, img := range imgs {
+		img.Variants = grouped[img.ID]
+	}
+
+	return nil
+}
+
+// copyObjects 将原图及缩放版本复制到另一个桶 源对象是否删除由调用方根据引用情况决定
+func copyObjects(storage *tenantStorage, srcBucket, dstBucket string, paths []string) error {
+	for _, p := range paths {
+		if err := storage.storage.Copy(srcBucket, p, dstBucket, p); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func deleteObjects(storage *tenantStorage, bucket string, paths []string) error {
+	for _, p := range paths {
+		if err := storage.storage.Delete(bucket, p); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
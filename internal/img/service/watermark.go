@@ -0,0 +1,268 @@
+package service
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"path"
+	"saas/internal/common/reskit/codes"
+	"saas/internal/img/domain"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// GetWatermarkSetting 未配置时返回默认配置
+func (s *service) GetWatermarkSetting(tenantID domain.TenantID) (*domain.WatermarkSetting, error) {
+	setting, err := s.repo.GetWatermarkSetting(tenantID)
+	if err != nil {
+		if errors.Is(err, codes.ErrImgWatermarkNotFound) {
+			return domain.DefaultWatermarkSetting(tenantID), nil
+		}
+		return nil, err
+	}
+	return setting, nil
+}
+
+// SetWatermarkSetting 水印图片通过 SetWatermarkImage 设置 此处沿用已保存的图片
+func (s *service) SetWatermarkSetting(setting *domain.WatermarkSetting) error {
+	unlock, err := s.lock(watermarkLockKey(setting.TenantID))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, err := s.GetWatermarkSetting(setting.TenantID)
+	if err != nil {
+		return err
+	}
+	setting.ImageKey = current.ImageKey
+
+	if err := s.checkWatermarkSetting(setting); err != nil {
+		return err
+	}
+
+	return s.repo.SetWatermarkSetting(setting)
+}
+
+func (s *service) checkWatermarkSetting(setting *domain.WatermarkSetting) error {
+	if _, ok := domain.ParseWatermarkColor(setting.TextColor); !ok {
+		return codes.ErrImgWatermarkInvalid.WithDetail(map[string]any{"text_color": setting.TextColor})
+	}
+
+	// 未启用时允许保存不完整的配置
+	if setting.Enabled {
+		switch setting.Kind {
+		case domain.WatermarkKindText:
+			if strings.TrimSpace(setting.Text) == "" {
+				return codes.ErrImgWatermarkInvalid.WithDetail(map[string]any{"field": "text"})
+			}
+		case domain.WatermarkKindImage:
+			if setting.ImageKey == "" {
+				return codes.ErrImgWatermarkInvalid.WithDetail(map[string]any{"field": "image"})
+			}
+		}
+	}
+
+	categoryIDs := make([]domain.CategoryID, 0, len(setting.CategoryIDs))
+	seen := make(map[domain.CategoryID]bool, len(setting.CategoryIDs))
+	for _, id := range setting.CategoryIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		categoryIDs = append(categoryIDs, id)
+	}
+	if len(categoryIDs) > domain.MaxWatermarkCategories {
+		return codes.ErrImgWatermarkInvalid.WithDetail(map[string]any{"category_ids": len(categoryIDs)})
+	}
+	for _, id := range categoryIDs {
+		if _, err := s.repo.FindCategoryByID(setting.TenantID, id); err != nil {
+			return err
+		}
+	}
+	setting.CategoryIDs = categoryIDs
+
+	return nil
+}
+
+// SetWatermarkImage 保存水印图片到 delete_bucket 并替换配置中的图片 旧图片随后删除
+func (s *service) SetWatermarkImage(tenantID domain.TenantID, src io.Reader) (*domain.WatermarkSetting, error) {
+	processed, err := s.processor.Process(src, &domain.ProcessOptions{Target: domain.OutputFormatOriginal})
+	if err != nil {
+		return nil, err
+	}
+	if !processed.Resizable() {
+		return nil, codes.ErrImgUnsupportedFormat.WithDetail(map[string]any{"format": processed.Format})
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	key := domain.WatermarkImagePrefix + hex.EncodeToString(id) + processed.Format.Ext()
+
+	storage, err := s.getTenantStorage(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := storage.storage.Put(storage.deleteBucket, key, bytes.NewReader(processed.Data), processed.Format.ContentType()); err != nil {
+		return nil, codes.ErrImgUploadToStorageFailed.WithCause(err)
+	}
+
+	unlock, err := s.lock(watermarkLockKey(tenantID))
+	if err != nil {
+		s.deleteWatermarkImage(storage, tenantID, key)
+		return nil, err
+	}
+	defer unlock()
+
+	setting, err := s.GetWatermarkSetting(tenantID)
+	if err != nil {
+		s.deleteWatermarkImage(storage, tenantID, key)
+		return nil, err
+	}
+	oldKey := setting.ImageKey
+	setting.ImageKey = key
+	if err := s.repo.SetWatermarkSetting(setting); err != nil {
+		s.deleteWatermarkImage(storage, tenantID, key)
+		return nil, err
+	}
+
+	if oldKey != "" {
+		s.deleteWatermarkImage(storage, tenantID, oldKey)
+	}
+
+	return setting, nil
+}
+
+func watermarkLockKey(tenantID domain.TenantID) string {
+	return "watermark:" + tenantID.String()
+}
+
+// deleteWatermarkImage 删除水印图片及本实例的缓存 失败仅记录日志
+// 图片路径每次上传都不同 其他实例的缓存不会被误用
+func (s *service) deleteWatermarkImage(storage *tenantStorage, tenantID domain.TenantID, key string) {
+	s.watermarkImages.Delete(tenantID.String() + ":" + key)
+	if err := storage.storage.Delete(storage.deleteBucket, key); err != nil {
+		zap.L().Error("删除水印图片失败",
+			zap.String("tenant_id", tenantID.String()),
+			zap.String("key", key),
+			zap.Error(err),
+		)
+	}
+}
+
+// loadWatermarkImage 读取水印图片 按路径缓存在本实例
+func (s *service) loadWatermarkImage(storage *tenantStorage, tenantID domain.TenantID, key string) ([]byte, error) {
+	cacheKey := tenantID.String() + ":" + key
+	if data, ok := s.watermarkImages.Load(cacheKey); ok {
+		return data.([]byte), nil
+	}
+
+	body, err := storage.storage.Get(storage.deleteBucket, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, domain.MaxWatermarkImageSize+1))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(data) > domain.MaxWatermarkImageSize {
+		return nil, errors.Errorf("watermark image %s exceeds %d bytes", key, domain.MaxWatermarkImageSize)
+	}
+
+	s.watermarkImages.Store(cacheKey, data)
+	return data, nil
+}
+
+// applyWatermark 按租户水印配置处理图片 并同步路径扩展名
+// 不需要加水印时原样返回 watermark 为空
+// 格式不支持或加水印失败时返回错误 避免未加水印的图片被公开
+func (s *service) applyWatermark(img *domain.Img, processed *domain.ProcessedImage, setting *domain.ImgSetting, category *domain.Category) (*domain.ProcessedImage, *domain.WatermarkSetting, error) {
+	watermark, err := s.GetWatermarkSetting(img.TenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !watermark.AppliesTo(category) {
+		return processed, nil, nil
+	}
+	if !processed.Resizable() {
+		return nil, nil, codes.ErrImgUnsupportedFormat.WithDetail(map[string]any{
+			"format": processed.Format,
+			"reason": "watermark cannot be applied to this format",
+		})
+	}
+
+	textColor, _ := domain.ParseWatermarkColor(watermark.TextColor)
+	opts := &domain.WatermarkOptions{
+		Kind:      watermark.Kind,
+		Text:      watermark.Text,
+		TextColor: textColor,
+		Position:  watermark.Position,
+		Opacity:   watermark.Opacity,
+		Scale:     watermark.Scale,
+		Quality:   setting.Quality,
+	}
+	if watermark.Kind == domain.WatermarkKindImage {
+		storage, err := s.getTenantStorage(img.TenantID)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts.Image, err = s.loadWatermarkImage(storage, img.TenantID, watermark.ImageKey)
+		if err != nil {
+			return nil, nil, codes.ErrImgProcessFailed.WithCause(err)
+		}
+	}
+
+	marked, err := s.processor.Watermark(processed, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	img.Path = strings.TrimSuffix(img.Path, path.Ext(img.Path)) + marked.Format.Ext()
+
+	return marked, watermark, nil
+}
+
+// checkDirectUpload 直传内容不经过服务端 分类需要加水印时拒绝直传
+func (s *service) checkDirectUpload(tenantID domain.TenantID, category *domain.Category) error {
+	watermark, err := s.GetWatermarkSetting(tenantID)
+	if err != nil {
+		return err
+	}
+	if watermark.AppliesTo(category) {
+		return codes.ErrImgIllegalOperation.WithDetail(map[string]any{
+			"reason": "watermark is enabled, upload through the server",
+		})
+	}
+	return nil
+}
+
+// keepOriginal 将未加水印的图片保存到 delete_bucket 失败仅记录日志
+func (s *service) keepOriginal(storage *tenantStorage, img *domain.Img, original *domain.ProcessedImage) {
+	err := storage.storage.Put(storage.deleteBucket, domain.OriginalKey(img.ID), bytes.NewReader(original.Data), original.Format.ContentType())
+	if err != nil {
+		zap.L().Error("保存未加水印的原图失败",
+			zap.String("img_id", img.ID.String()),
+			zap.String("path", img.Path),
+			zap.Error(err),
+		)
+	}
+}
+
+// deleteOriginal 图片彻底删除时清理未加水印的原图 不存在时忽略 失败仅记录日志
+func (s *service) deleteOriginal(storage *tenantStorage, img *domain.Img) {
+	err := storage.storage.Delete(storage.deleteBucket, domain.OriginalKey(img.ID))
+	if err != nil && !errors.Is(err, codes.ErrImgStorageObjectNotFound) {
+		zap.L().Error("删除未加水印的原图失败",
+			zap.String("img_id", img.ID.String()),
+			zap.String("path", img.Path),
+			zap.Error(err),
+		)
+	}
+}
@@ -18,6 +18,11 @@ func InitV1(r *gin.RouterGroup) func() {
 		service.NewImgService,
 		adapters.NewImgPSQLRepository,
 		adapters.NewImgRedisCache,
+		adapters.NewObjectStorageFactory,
+		adapters.NewImageProcessor,
+		adapters.NewTransformCache,
+		adapters.NewImageFetcher,
+		adapters.NewImgLocker,
 	)
 
 	return nil
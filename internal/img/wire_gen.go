@@ -18,7 +18,12 @@ import (
 func InitV1(r *gin.RouterGroup) func() {
 	imgRepository := adapters.NewImgPSQLRepository()
 	imgMsgQueue := adapters.NewImgRedisCache()
-	imgService := service.NewImgService(imgRepository, imgMsgQueue)
+	objectStorageFactory := adapters.NewObjectStorageFactory()
+	imageProcessor := adapters.NewImageProcessor()
+	transformCache := adapters.NewTransformCache()
+	imageFetcher := adapters.NewImageFetcher()
+	locker := adapters.NewImgLocker()
+	imgService := service.NewImgService(imgRepository, imgMsgQueue, objectStorageFactory, imageProcessor, transformCache, imageFetcher, locker)
 	httpHandler := handler.NewHttpHandler(imgService)
 	v := RegisterV1(r, httpHandler)
 	return v
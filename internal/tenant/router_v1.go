@@ -11,7 +11,7 @@ import (
 func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 	g := r.Group("/v1/tenant")
 
-	protect := g.Use(auth.JWTValidate())
+	protect := g.Use(auth.JWTValidate(), auth.RequireScope("tenant"))
 	{
 		// todo 创建租户 目前未接入交易中间件
 		protect.POST("", handler.Create)
@@ -20,7 +20,7 @@ func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 	}
 
 	// 仅租户创建者可访问的路由
-	creatorOnly := g.Group("", auth.JWTValidate(), server.SetTenantID("id"), auth.TenantCreatorValited())
+	creatorOnly := g.Group("", auth.JWTValidate(), auth.RequireScope("tenant"), server.SetTenantID("id"), auth.TenantCreatorValited())
 	{
 		creatorOnly.GET("/:id", handler.Read)
 		creatorOnly.PUT("/:id", handler.Update)
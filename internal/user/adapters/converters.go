@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/types"
 	"saas/internal/common/orm"
 	"saas/internal/user/domain"
 )
@@ -21,10 +22,6 @@ func domainUserToORM(user *domain.User) *orm.User {
 		ormUser.PasswordHash = null.StringFrom(user.PasswordHash)
 	}
 
-	if user.GithubID != "" {
-		ormUser.GithubID = null.StringFrom(user.GithubID)
-	}
-
 	return ormUser
 }
 
@@ -47,9 +44,182 @@ func ormUserToDomain(ormUser *orm.User) *domain.User {
 		user.PasswordHash = ormUser.PasswordHash.String
 	}
 
-	if ormUser.GithubID.Valid {
-		user.GithubID = ormUser.GithubID.String
+	return user
+}
+
+func domainIdentityToORM(identity *domain.UserIdentity) *orm.UserIdentity {
+	if identity == nil {
+		return nil
+	}
+
+	ormIdentity := &orm.UserIdentity{
+		ID:             identity.ID,
+		UserID:         identity.UserID,
+		Provider:       identity.Provider.String(),
+		ProviderUserID: identity.ProviderUserID,
+		EmailVerified:  identity.EmailVerified,
 	}
 
-	return user
+	if identity.Email != "" {
+		ormIdentity.Email = null.StringFrom(identity.Email)
+	}
+
+	return ormIdentity
+}
+
+func ormIdentityToDomain(ormIdentity *orm.UserIdentity) *domain.UserIdentity {
+	if ormIdentity == nil {
+		return nil
+	}
+
+	identity := &domain.UserIdentity{
+		ID:             ormIdentity.ID,
+		UserID:         ormIdentity.UserID,
+		Provider:       domain.OAuthProvider(ormIdentity.Provider),
+		ProviderUserID: ormIdentity.ProviderUserID,
+		EmailVerified:  ormIdentity.EmailVerified,
+		LastUsedAt:     ormIdentity.LastUsedAt,
+		CreatedAt:      ormIdentity.CreatedAt,
+	}
+
+	if ormIdentity.Email.Valid {
+		identity.Email = ormIdentity.Email.String
+	}
+
+	return identity
+}
+
+func ormIdentitiesToDomain(ormIdentities []*orm.UserIdentity) []*domain.UserIdentity {
+	if len(ormIdentities) == 0 {
+		return nil
+	}
+
+	identities := make([]*domain.UserIdentity, 0, len(ormIdentities))
+	for _, ormIdentity := range ormIdentities {
+		if ormIdentity == nil {
+			continue
+		}
+		identities = append(identities, ormIdentityToDomain(ormIdentity))
+	}
+
+	return identities
+}
+
+func domainPATToORM(token *domain.PersonalAccessToken) *orm.PersonalAccessToken {
+	if token == nil {
+		return nil
+	}
+
+	scopes := make(types.StringArray, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, scope.String())
+	}
+
+	ormToken := &orm.PersonalAccessToken{
+		ID:          token.ID,
+		UserID:      token.UserID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		TokenHash:   token.TokenHash,
+		Scopes:      scopes,
+	}
+
+	if !token.ExpiresAt.IsZero() {
+		ormToken.ExpiresAt = null.TimeFrom(token.ExpiresAt)
+	}
+
+	return ormToken
+}
+
+func ormPATToDomain(ormToken *orm.PersonalAccessToken) *domain.PersonalAccessToken {
+	if ormToken == nil {
+		return nil
+	}
+
+	scopes := make([]domain.TokenScope, 0, len(ormToken.Scopes))
+	for _, scope := range ormToken.Scopes {
+		scopes = append(scopes, domain.TokenScope(scope))
+	}
+
+	token := &domain.PersonalAccessToken{
+		ID:          ormToken.ID,
+		UserID:      ormToken.UserID,
+		Name:        ormToken.Name,
+		TokenPrefix: ormToken.TokenPrefix,
+		TokenHash:   ormToken.TokenHash,
+		Scopes:      scopes,
+		CreatedAt:   ormToken.CreatedAt,
+	}
+
+	if ormToken.ExpiresAt.Valid {
+		token.ExpiresAt = ormToken.ExpiresAt.Time
+	}
+	if ormToken.LastUsedAt.Valid {
+		token.LastUsedAt = ormToken.LastUsedAt.Time
+	}
+
+	return token
+}
+
+func ormPATsToDomain(ormTokens orm.PersonalAccessTokenSlice) []*domain.PersonalAccessToken {
+	tokens := make([]*domain.PersonalAccessToken, 0, len(ormTokens))
+	for _, ormToken := range ormTokens {
+		tokens = append(tokens, ormPATToDomain(ormToken))
+	}
+	return tokens
+}
+
+func domainLoginEventToORM(event *domain.LoginEvent) *orm.UserLoginEvent {
+	if event == nil {
+		return nil
+	}
+
+	ormEvent := &orm.UserLoginEvent{
+		ID:         event.ID,
+		UserID:     event.UserID,
+		Method:     event.Method,
+		IP:         event.IP,
+		IPRange:    event.IPRange,
+		UserAgent:  event.UserAgent,
+		DeviceHash: event.DeviceHash,
+		Success:    event.Success,
+	}
+
+	if event.FailureReason != "" {
+		ormEvent.FailureReason = null.StringFrom(event.FailureReason)
+	}
+
+	return ormEvent
+}
+
+func ormLoginEventToDomain(ormEvent *orm.UserLoginEvent) *domain.LoginEvent {
+	if ormEvent == nil {
+		return nil
+	}
+
+	event := &domain.LoginEvent{
+		ID:         ormEvent.ID,
+		UserID:     ormEvent.UserID,
+		Method:     ormEvent.Method,
+		IP:         ormEvent.IP,
+		IPRange:    ormEvent.IPRange,
+		UserAgent:  ormEvent.UserAgent,
+		DeviceHash: ormEvent.DeviceHash,
+		Success:    ormEvent.Success,
+		CreatedAt:  ormEvent.CreatedAt,
+	}
+
+	if ormEvent.FailureReason.Valid {
+		event.FailureReason = ormEvent.FailureReason.String
+	}
+
+	return event
+}
+
+func ormLoginEventsToDomain(ormEvents orm.UserLoginEventSlice) []*domain.LoginEvent {
+	events := make([]*domain.LoginEvent, 0, len(ormEvents))
+	for _, ormEvent := range ormEvents {
+		events = append(events, ormLoginEventToDomain(ormEvent))
+	}
+	return events
 }
@@ -0,0 +1,98 @@
+package adapters
+
+import (
+	"fmt"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"saas/internal/common/utils/dbkit"
+	"time"
+
+	"saas/internal/common/orm"
+	"saas/internal/user/domain"
+)
+
+type LoginEventPSQLRepository struct {
+}
+
+func NewLoginEventPSQLRepository() domain.LoginEventRepository {
+	return &LoginEventPSQLRepository{}
+}
+
+func (r *LoginEventPSQLRepository) Create(event *domain.LoginEvent) error {
+	ormEvent := domainLoginEventToORM(event)
+
+	if err := ormEvent.InsertG(boil.Infer()); err != nil {
+		return fmt.Errorf("failed to create login event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LoginEventPSQLRepository) ListByKeyset(query *domain.LoginEventKeysetQuery) (*domain.LoginEventKeysetResult, error) {
+	baseMods := []qm.QueryMod{
+		orm.UserLoginEventWhere.UserID.EQ(query.UserID),
+	}
+
+	ks := dbkit.NewKeyset[*domain.LoginEvent](
+		orm.UserLoginEventColumns.ID,
+		orm.UserLoginEventColumns.CreatedAt,
+		query.PrevCursor,
+		query.NextCursor,
+		query.PageSize,
+	)
+
+	mods := ks.ApplyKeysetMods(baseMods)
+
+	ormEvents, err := orm.UserLoginEvents(mods...).AllG()
+	if err != nil {
+		return nil, err
+	}
+
+	domains := ormLoginEventsToDomain(ormEvents)
+
+	exists := func(primary time.Time, id string, checkPrev bool) (bool, error) {
+		var cond qm.QueryMod
+		if checkPrev {
+			cond = ks.BeforeWhere(primary, id)
+		} else {
+			cond = ks.AfterWhere(primary, id)
+		}
+		checkMods := append([]qm.QueryMod{}, baseMods...)
+		checkMods = append(checkMods, cond, qm.Limit(1))
+		return orm.UserLoginEvents(checkMods...).ExistsG()
+	}
+
+	result, err := ks.BuildWithExistence(domains, exists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginEventKeysetResult{
+		Items:      result.Items,
+		PrevCursor: result.PrevCursor,
+		NextCursor: result.NextCursor,
+		HasPrev:    result.HasPrev,
+		HasNext:    result.HasNext,
+	}, nil
+}
+
+func (r *LoginEventPSQLRepository) HasSuccessfulLogin(userID, deviceHash, ipRange string) (bool, error) {
+	mods := []qm.QueryMod{
+		orm.UserLoginEventWhere.UserID.EQ(userID),
+		orm.UserLoginEventWhere.Success.EQ(true),
+	}
+
+	if deviceHash != "" {
+		mods = append(mods, orm.UserLoginEventWhere.DeviceHash.EQ(deviceHash))
+	}
+	if ipRange != "" {
+		mods = append(mods, orm.UserLoginEventWhere.IPRange.EQ(ipRange))
+	}
+
+	exist, err := orm.UserLoginEvents(mods...).ExistsG()
+	if err != nil {
+		return false, fmt.Errorf("database error: %w", err)
+	}
+
+	return exist, nil
+}
@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/pkg/errors"
+	"saas/internal/common/reskit/codes"
+	"time"
+
+	"saas/internal/common/orm"
+	"saas/internal/user/domain"
+)
+
+type PATPSQLRepository struct {
+}
+
+func NewPATPSQLRepository() domain.PATRepository {
+	return &PATPSQLRepository{}
+}
+
+func (r *PATPSQLRepository) Create(token *domain.PersonalAccessToken) (*domain.PersonalAccessToken, error) {
+	ormToken := domainPATToORM(token)
+
+	if err := ormToken.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	return ormPATToDomain(ormToken), nil
+}
+
+func (r *PATPSQLRepository) FindByHash(tokenHash string) (*domain.PersonalAccessToken, error) {
+	ormToken, err := orm.PersonalAccessTokens(
+		orm.PersonalAccessTokenWhere.TokenHash.EQ(tokenHash),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrPATNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return ormPATToDomain(ormToken), nil
+}
+
+func (r *PATPSQLRepository) ListByUser(userID string) ([]*domain.PersonalAccessToken, error) {
+	ormTokens, err := orm.PersonalAccessTokens(
+		orm.PersonalAccessTokenWhere.UserID.EQ(userID),
+		qm.OrderBy(orm.PersonalAccessTokenColumns.CreatedAt+" DESC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return ormPATsToDomain(ormTokens), nil
+}
+
+func (r *PATPSQLRepository) CountByUser(userID string) (int64, error) {
+	count, err := orm.PersonalAccessTokens(
+		orm.PersonalAccessTokenWhere.UserID.EQ(userID),
+	).CountG()
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *PATPSQLRepository) Delete(userID, id string) error {
+	rows, err := orm.PersonalAccessTokens(
+		orm.PersonalAccessTokenWhere.ID.EQ(id),
+		orm.PersonalAccessTokenWhere.UserID.EQ(userID),
+	).DeleteAllG()
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	if rows == 0 {
+		return codes.ErrPATNotFound
+	}
+
+	return nil
+}
+
+func (r *PATPSQLRepository) UpdateLastUsed(id string, at time.Time) error {
+	_, err := orm.PersonalAccessTokens(
+		orm.PersonalAccessTokenWhere.ID.EQ(id),
+	).UpdateAllG(orm.M{
+		orm.PersonalAccessTokenColumns.LastUsedAt: null.TimeFrom(at),
+	})
+	return err
+}
@@ -1,10 +1,11 @@
 package adapters
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 	"saas/internal/common/reskit/codes"
@@ -56,7 +57,13 @@ func (r *UserPSQLRepository) Create(user *domain.User) (*domain.User, error) {
 func (r *UserPSQLRepository) Update(user *domain.User) (*domain.User, error) {
 	ormUser := domainUserToORM(user)
 
-	_, err := ormUser.UpdateG(boil.Infer())
+	// 仅更新资料列 避免覆盖 is_platform_admin 等由平台维护的字段
+	_, err := ormUser.UpdateG(boil.Whitelist(
+		orm.UserColumns.Email,
+		orm.UserColumns.Nickname,
+		orm.UserColumns.PasswordHash,
+		orm.UserColumns.UpdatedAt,
+	))
 	if err != nil {
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
@@ -65,17 +72,15 @@ func (r *UserPSQLRepository) Update(user *domain.User) (*domain.User, error) {
 }
 
 func (r *UserPSQLRepository) FindByOAuthID(provider, oauthID string) (*domain.User, error) {
-	var ormUser *orm.User
-	var err error
-
-	switch provider {
-	case "github":
-		ormUser, err = orm.Users(
-			orm.UserWhere.GithubID.EQ(null.StringFrom(oauthID)),
-		).OneG()
-	default:
-		return nil, codes.ErrOAuthInvalidCode
-	}
+	ormUser, err := orm.Users(
+		qm.InnerJoin(fmt.Sprintf("%s on %s = %s",
+			orm.TableNames.UserIdentities,
+			orm.UserIdentityTableColumns.UserID,
+			orm.UserTableColumns.ID,
+		)),
+		orm.UserIdentityWhere.Provider.EQ(provider),
+		orm.UserIdentityWhere.ProviderUserID.EQ(oauthID),
+	).OneG()
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -105,3 +110,136 @@ func (r *UserPSQLRepository) EmailExists(email string) (bool, error) {
 	}
 	return exists, nil
 }
+
+// CreateWithIdentity 在同一事务中创建用户及其第三方身份
+func (r *UserPSQLRepository) CreateWithIdentity(user *domain.User, identity *domain.UserIdentity) (*domain.User, error) {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	ormUser := domainUserToORM(user)
+	if err := ormUser.Insert(tx, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	ormIdentity := domainIdentityToORM(identity)
+	ormIdentity.UserID = ormUser.ID
+	if err := ormIdentity.Insert(tx, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create user identity: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ormUserToDomain(ormUser), nil
+}
+
+func (r *UserPSQLRepository) FindIdentity(provider, providerUserID string) (*domain.UserIdentity, error) {
+	ormIdentity, err := orm.UserIdentities(
+		orm.UserIdentityWhere.Provider.EQ(provider),
+		orm.UserIdentityWhere.ProviderUserID.EQ(providerUserID),
+	).OneG()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, codes.ErrOAuthIdentityNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return ormIdentityToDomain(ormIdentity), nil
+}
+
+func (r *UserPSQLRepository) ListIdentities(userID string) ([]*domain.UserIdentity, error) {
+	ormIdentities, err := orm.UserIdentities(
+		orm.UserIdentityWhere.UserID.EQ(userID),
+		qm.OrderBy(orm.UserIdentityColumns.CreatedAt+" ASC"),
+	).AllG()
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return ormIdentitiesToDomain(ormIdentities), nil
+}
+
+func (r *UserPSQLRepository) CreateIdentity(identity *domain.UserIdentity) (*domain.UserIdentity, error) {
+	ormIdentity := domainIdentityToORM(identity)
+
+	if err := ormIdentity.InsertG(boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to create user identity: %w", err)
+	}
+
+	return ormIdentityToDomain(ormIdentity), nil
+}
+
+// DeleteIdentity 锁定用户行后检查并删除 同一用户的并发解绑依次执行 不会同时移除最后的登录方式
+func (r *UserPSQLRepository) DeleteIdentity(userID string, provider domain.OAuthProvider) error {
+	tx, err := boil.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	ormUser, err := orm.Users(
+		orm.UserWhere.ID.EQ(userID),
+		qm.For("UPDATE"),
+	).One(tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return codes.ErrUserNotFound
+		}
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	count, err := orm.UserIdentities(
+		orm.UserIdentityWhere.UserID.EQ(userID),
+	).Count(tx)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	rows, err := orm.UserIdentities(
+		orm.UserIdentityWhere.UserID.EQ(userID),
+		orm.UserIdentityWhere.Provider.EQ(provider.String()),
+	).DeleteAll(tx)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+
+	if rows == 0 {
+		return codes.ErrOAuthIdentityNotFound
+	}
+
+	// 解绑后至少保留一种登录方式
+	remaining := count - rows
+	if ormUserToDomain(ormUser).HasPassword() {
+		remaining++
+	}
+	if remaining < 1 {
+		return codes.ErrOAuthLastLoginMethod
+	}
+
+	return tx.Commit()
+}
+
+func (r *UserPSQLRepository) CountIdentities(userID string) (int64, error) {
+	count, err := orm.UserIdentities(
+		orm.UserIdentityWhere.UserID.EQ(userID),
+	).CountG()
+	if err != nil {
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *UserPSQLRepository) TouchIdentity(id string) error {
+	_, err := orm.UserIdentities(
+		orm.UserIdentityWhere.ID.EQ(id),
+	).UpdateAllG(orm.M{
+		orm.UserIdentityColumns.LastUsedAt: time.Now(),
+	})
+	return err
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// PATPrefix 个人访问令牌前缀 用于在 Authorization 头中与 JWT 区分
+const PATPrefix = "saas_pat_"
+
+type TokenScope string
+
+func (s TokenScope) String() string {
+	return string(s)
+}
+
+// 个人访问令牌可授予的权限范围
+const (
+	ScopeTenantRead   TokenScope = "tenant:read"
+	ScopeTenantWrite  TokenScope = "tenant:write"
+	ScopeImgRead      TokenScope = "img:read"
+	ScopeImgWrite     TokenScope = "img:write"
+	ScopeCommentRead  TokenScope = "comment:read"
+	ScopeCommentWrite TokenScope = "comment:write"
+)
+
+var AllTokenScopes = []TokenScope{
+	ScopeTenantRead,
+	ScopeTenantWrite,
+	ScopeImgRead,
+	ScopeImgWrite,
+	ScopeCommentRead,
+	ScopeCommentWrite,
+}
+
+func (s TokenScope) IsValid() bool {
+	return slices.Contains(AllTokenScopes, s)
+}
+
+type PersonalAccessToken struct {
+	ID          string
+	UserID      string
+	Name        string
+	TokenPrefix string
+	TokenHash   string
+	Scopes      []TokenScope
+	ExpiresAt   time.Time
+	LastUsedAt  time.Time
+	CreatedAt   time.Time
+}
+
+func (t *PersonalAccessToken) IsExpired() bool {
+	return !t.ExpiresAt.IsZero() && time.Now().After(t.ExpiresAt)
+}
+
+func (t *PersonalAccessToken) HasScope(scope TokenScope) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+// CreatedPAT 创建令牌的结果 明文令牌仅在创建时返回一次
+type CreatedPAT struct {
+	Token     *PersonalAccessToken
+	PlainText string
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"time"
+)
+
+// LoginMeta 登录请求的客户端信息
+type LoginMeta struct {
+	IP        string
+	UserAgent string
+}
+
+// IPRange 登录来源的网段 IPv4 取 /24 IPv6 取 /48
+func (m *LoginMeta) IPRange() string {
+	ip := net.ParseIP(m.IP)
+	if ip == nil {
+		return m.IP
+	}
+
+	if v4 := ip.To4(); v4 != nil {
+		return (&net.IPNet{IP: v4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+	}
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(48, 128)), Mask: net.CIDRMask(48, 128)}).String()
+}
+
+// DeviceHash 以 User-Agent 标识设备
+func (m *LoginMeta) DeviceHash() string {
+	sum := sha256.Sum256([]byte(m.UserAgent))
+	return hex.EncodeToString(sum[:])
+}
+
+type LoginEvent struct {
+	ID            string
+	UserID        string
+	Method        string
+	IP            string
+	IPRange       string
+	UserAgent     string
+	DeviceHash    string
+	Success       bool
+	FailureReason string
+	CreatedAt     time.Time
+}
+
+func (e *LoginEvent) GetCursorPrimary() time.Time {
+	return e.CreatedAt
+}
+
+func (e *LoginEvent) GetID() string {
+	return e.ID
+}
+
+type LoginEventKeysetQuery struct {
+	UserID     string
+	PageSize   int
+	PrevCursor string
+	NextCursor string
+}
+
+type LoginEventKeysetResult struct {
+	Items      []*LoginEvent
+	PrevCursor string
+	NextCursor string
+	HasPrev    bool
+	HasNext    bool
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type UserRepository interface {
 	// 基础 CRUD
 	FindByID(id string) (*User, error)
@@ -11,10 +13,36 @@ type UserRepository interface {
 	FindByOAuthID(provider, oauthID string) (*User, error)
 	UpdateLastLogin(id string) error
 
+	// 第三方身份
+	CreateWithIdentity(user *User, identity *UserIdentity) (*User, error)
+	FindIdentity(provider, providerUserID string) (*UserIdentity, error)
+	ListIdentities(userID string) ([]*UserIdentity, error)
+	CreateIdentity(identity *UserIdentity) (*UserIdentity, error)
+	// DeleteIdentity 解绑后无其他登录方式时返回 codes.ErrOAuthLastLoginMethod
+	DeleteIdentity(userID string, provider OAuthProvider) error
+	CountIdentities(userID string) (int64, error)
+	TouchIdentity(id string) error
+
 	// 辅助方法
 	EmailExists(email string) (bool, error)
 }
 
+type PATRepository interface {
+	Create(token *PersonalAccessToken) (*PersonalAccessToken, error)
+	FindByHash(tokenHash string) (*PersonalAccessToken, error)
+	ListByUser(userID string) ([]*PersonalAccessToken, error)
+	CountByUser(userID string) (int64, error)
+	Delete(userID, id string) error
+	UpdateLastUsed(id string, at time.Time) error
+}
+
+type LoginEventRepository interface {
+	Create(event *LoginEvent) error
+	ListByKeyset(query *LoginEventKeysetQuery) (*LoginEventKeysetResult, error)
+	// HasSuccessfulLogin 是否存在成功登录记录 deviceHash/ipRange 为空时不作为条件
+	HasSuccessfulLogin(userID, deviceHash, ipRange string) (bool, error)
+}
+
 type TokenCache interface {
 	GenRefreshToken(payload *JwtPayload) (string, error)
 	ValidateRefreshToken(refreshToken string) (*JwtPayload, error)
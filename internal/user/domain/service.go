@@ -1,9 +1,19 @@
 package domain
 
+import "time"
+
 type UserService interface {
-	AuthenticateWithOAuth(provider OAuthProvider, userInfo *OAuthUserInfo) (*User2Token, error)
+	AuthenticateWithOAuth(provider OAuthProvider, userInfo *OAuthUserInfo, meta *LoginMeta) (*User2Token, error)
 	RefreshUserToken(refreshToken string) (*User2Token, error)
 	GetUser(id string) (*User, error)
+
+	// 第三方身份管理
+	ListIdentities(userID string) ([]*UserIdentity, error)
+	LinkOAuthIdentity(userID string, provider OAuthProvider, userInfo *OAuthUserInfo) (*UserIdentity, error)
+	UnlinkOAuthIdentity(userID string, provider OAuthProvider) error
+
+	// 登录记录
+	ListLoginEvents(query *LoginEventKeysetQuery) (*LoginEventKeysetResult, error)
 }
 
 type TokenService interface {
@@ -16,3 +26,12 @@ type TokenService interface {
 	GenerateRefreshToken(payload *JwtPayload) (string, error)
 	RemoveRefreshToken(refreshToken string) error
 }
+
+type PATService interface {
+	Create(userID, name string, scopes []TokenScope, expiresAt time.Time) (*CreatedPAT, error)
+	List(userID string) ([]*PersonalAccessToken, error)
+	Revoke(userID, id string) error
+
+	// Validate 校验明文令牌 返回对应的令牌信息
+	Validate(plainText string) (*PersonalAccessToken, error)
+}
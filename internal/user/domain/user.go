@@ -16,14 +16,32 @@ type User struct {
 	Avatar       string
 	PasswordHash string
 	Nickname     string
-	GithubID     string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	LastLoginAt  time.Time
 }
 
+// HasPassword 是否设置了密码登录方式
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
+
+// UserIdentity 用户绑定的第三方登录身份
+type UserIdentity struct {
+	ID             string
+	UserID         string
+	Provider       OAuthProvider
+	ProviderUserID string
+	Email          string
+	EmailVerified  bool
+	LastUsedAt     time.Time
+	CreatedAt      time.Time
+}
+
 type JwtPayload struct {
 	UserID string `json:"user_id"`
+	// ImpersonatorID 平台管理员模拟登录时的管理员id 非空即为只读会话
+	ImpersonatorID string `json:"impersonator_id,omitempty"`
 }
 
 type User2Token struct {
@@ -32,9 +50,10 @@ type User2Token struct {
 }
 
 type OAuthUserInfo struct {
-	Provider string
-	ID       string
-	Login    string
-	Nickname string
-	Email    string
+	Provider      string
+	ID            string
+	Login         string
+	Nickname      string
+	Email         string
+	EmailVerified bool
 }
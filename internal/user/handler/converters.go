@@ -33,3 +33,116 @@ func domainSessionToRefreshResponse(token2 *domain.User2Token) *RefreshTokenResp
 		RefreshToken: token2.RefreshToken,
 	}
 }
+
+func domainIdentityToResponse(identity *domain.UserIdentity) *IdentityResponse {
+	if identity == nil {
+		return nil
+	}
+
+	return &IdentityResponse{
+		Provider:       identity.Provider.String(),
+		ProviderUserID: identity.ProviderUserID,
+		Email:          identity.Email,
+		EmailVerified:  identity.EmailVerified,
+		LastUsedAt:     identity.LastUsedAt.Unix(),
+		CreatedAt:      identity.CreatedAt.Unix(),
+	}
+}
+
+func domainIdentitiesToResponse(identities []*domain.UserIdentity) []*IdentityResponse {
+	if len(identities) == 0 {
+		return []*IdentityResponse{}
+	}
+
+	list := make([]*IdentityResponse, 0, len(identities))
+	for _, identity := range identities {
+		list = append(list, domainIdentityToResponse(identity))
+	}
+	return list
+}
+
+func domainPATToResponse(token *domain.PersonalAccessToken) *PATResponse {
+	if token == nil {
+		return nil
+	}
+
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, scope.String())
+	}
+
+	resp := &PATResponse{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopes,
+		CreatedAt:   token.CreatedAt.Unix(),
+	}
+
+	if !token.ExpiresAt.IsZero() {
+		resp.ExpiresAt = token.ExpiresAt.Unix()
+	}
+	if !token.LastUsedAt.IsZero() {
+		resp.LastUsedAt = token.LastUsedAt.Unix()
+	}
+
+	return resp
+}
+
+func domainPATsToResponse(tokens []*domain.PersonalAccessToken) []*PATResponse {
+	list := make([]*PATResponse, 0, len(tokens))
+	for _, token := range tokens {
+		list = append(list, domainPATToResponse(token))
+	}
+	return list
+}
+
+func domainCreatedPATToResponse(created *domain.CreatedPAT) *CreatePATResponse {
+	return &CreatePATResponse{
+		PATResponse: *domainPATToResponse(created.Token),
+		Token:       created.PlainText,
+	}
+}
+
+func createPATRequestToScopes(req *CreatePATRequest) []domain.TokenScope {
+	scopes := make([]domain.TokenScope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, domain.TokenScope(scope))
+	}
+	return scopes
+}
+
+func domainLoginEventToResponse(event *domain.LoginEvent) *LoginEventResponse {
+	if event == nil {
+		return nil
+	}
+
+	return &LoginEventResponse{
+		ID:            event.ID,
+		Method:        event.Method,
+		IP:            event.IP,
+		UserAgent:     event.UserAgent,
+		Success:       event.Success,
+		FailureReason: event.FailureReason,
+		CreatedAt:     event.CreatedAt.Unix(),
+	}
+}
+
+func domainLoginEventKeysetToResponse(pager *domain.LoginEventKeysetResult) *ListLoginEventsResponse {
+	if pager == nil {
+		return nil
+	}
+
+	items := make([]*LoginEventResponse, 0, len(pager.Items))
+	for _, event := range pager.Items {
+		items = append(items, domainLoginEventToResponse(event))
+	}
+
+	return &ListLoginEventsResponse{
+		Items:      items,
+		PrevCursor: pager.PrevCursor,
+		NextCursor: pager.NextCursor,
+		HasPrev:    pager.HasPrev,
+		HasNext:    pager.HasNext,
+	}
+}
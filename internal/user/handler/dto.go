@@ -34,8 +34,82 @@ type GithubUser struct {
 	AvatarURL string `json:"avatar_url"`
 }
 
+// GithubEmail github 邮箱列表响应 不可修改
+type GithubEmail struct {
+	Email      string `json:"email"`
+	Primary    bool   `json:"primary"`
+	Verified   bool   `json:"verified"`
+	Visibility string `json:"visibility"`
+}
+
 type GithubAccessTokenResponse struct {
 	AccessToken string `json:"access_token"`
 	TokenType   string `json:"token_type"`
 	Scope       string `json:"scope"`
 }
+
+type IdentityResponse struct {
+	Provider       string `json:"provider"`
+	ProviderUserID string `json:"provider_user_id"`
+	Email          string `json:"email,omitempty"`
+	EmailVerified  bool   `json:"email_verified"`
+	LastUsedAt     int64  `json:"last_used_at"`
+	CreatedAt      int64  `json:"created_at"`
+}
+
+type LinkGithubRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type UnlinkIdentityRequest struct {
+	Provider string `uri:"provider" binding:"required,oneof=github"`
+}
+
+type CreatePATRequest struct {
+	Name          string   `json:"name" binding:"required,max=50"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,oneof=tenant:read tenant:write img:read img:write comment:read comment:write"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"omitempty,min=1,max=365"` // 不传表示永不过期
+}
+
+type PATResponse struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	TokenPrefix string   `json:"token_prefix"`
+	Scopes      []string `json:"scopes"`
+	ExpiresAt   int64    `json:"expires_at,omitempty"`
+	LastUsedAt  int64    `json:"last_used_at,omitempty"`
+	CreatedAt   int64    `json:"created_at"`
+}
+
+type CreatePATResponse struct {
+	PATResponse
+	Token string `json:"token"` // 明文令牌 仅在创建时返回一次
+}
+
+type RevokePATRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type ListLoginEventsRequest struct {
+	PageSize   int    `form:"page_size,default=10" binding:"min=5,max=50"`
+	PrevCursor string `form:"prev_cursor"`
+	NextCursor string `form:"next_cursor"`
+}
+
+type LoginEventResponse struct {
+	ID            string `json:"id"`
+	Method        string `json:"method"`
+	IP            string `json:"ip"`
+	UserAgent     string `json:"user_agent"`
+	Success       bool   `json:"success"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	CreatedAt     int64  `json:"created_at"`
+}
+
+type ListLoginEventsResponse struct {
+	Items      []*LoginEventResponse `json:"items"`
+	PrevCursor string                `json:"prev_cursor,omitempty"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+	HasPrev    bool                  `json:"has_prev"`
+	HasNext    bool                  `json:"has_next"`
+}
@@ -1,11 +1,14 @@
 package handler
 
 import (
+	"net/http"
+	"saas/internal/common/reqkit/bind"
 	"saas/internal/common/reskit/codes"
 	"saas/internal/common/reskit/response"
 	"saas/internal/common/server"
 	"saas/internal/common/utils"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -17,16 +20,18 @@ import (
 
 type HttpHandler struct {
 	userService  domain.UserService
+	patService   domain.PATService
 	clientID     string
 	clientSecret string
 }
 
-func NewHttpHandler(userService domain.UserService) *HttpHandler {
+func NewHttpHandler(userService domain.UserService, patService domain.PATService) *HttpHandler {
 	clientID := utils.GetEnv("GITHUB_CLIENT_ID")
 	clientSecret := utils.GetEnv("GITHUB_CLIENT_SECRET")
 
 	return &HttpHandler{
 		userService:  userService,
+		patService:   patService,
 		clientID:     clientID,
 		clientSecret: clientSecret,
 	}
@@ -58,7 +63,10 @@ func (h *HttpHandler) GithubAuth(ctx *gin.Context) {
 	}
 
 	// 2. 调用业务逻辑
-	session, err := h.userService.AuthenticateWithOAuth("github", userInfo)
+	session, err := h.userService.AuthenticateWithOAuth("github", userInfo, &domain.LoginMeta{
+		IP:        ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	})
 	if err != nil {
 		response.Error(ctx, err)
 		return
@@ -113,7 +121,7 @@ func (h *HttpHandler) fetchGithubUserInfo(accessToken string) (*domain.OAuthUser
 	client := resty.New()
 	var githubUser GithubUser
 
-	_, err := client.R().
+	resp, err := client.R().
 		SetHeader("Authorization", "Bearer "+accessToken).
 		SetHeader("Accept", "application/vnd.github+json").
 		SetResult(&githubUser).
@@ -122,14 +130,43 @@ func (h *HttpHandler) fetchGithubUserInfo(accessToken string) (*domain.OAuthUser
 	if err != nil {
 		return nil, err // 这里的错误会在上层被包装
 	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.Errorf("github /user 返回异常状态码: %d", resp.StatusCode())
+	}
 
-	return &domain.OAuthUserInfo{
+	userInfo := &domain.OAuthUserInfo{
 		Provider: "github",
 		ID:       strconv.FormatInt(githubUser.ID, 10),
 		Login:    githubUser.Login,
 		Nickname: githubUser.Name,
 		Email:    githubUser.Email,
-	}, nil
+	}
+
+	// /user 接口不返回邮箱验证状态,需从邮箱列表中取主邮箱
+	var emails []GithubEmail
+	resp, err = client.R().
+		SetHeader("Authorization", "Bearer "+accessToken).
+		SetHeader("Accept", "application/vnd.github+json").
+		SetResult(&emails).
+		Get("https://api.github.com/user/emails")
+
+	if err != nil {
+		return nil, err
+	}
+	// 401/403 的错误体不能当作空邮箱列表处理,否则会丢失邮箱验证状态
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.Errorf("github /user/emails 返回异常状态码: %d", resp.StatusCode())
+	}
+
+	for _, email := range emails {
+		if email.Primary {
+			userInfo.Email = email.Email
+			userInfo.EmailVerified = email.Verified
+			break
+		}
+	}
+
+	return userInfo, nil
 }
 
 // RefreshToken godoc
@@ -208,3 +245,253 @@ func (h *HttpHandler) GetProfile(ctx *gin.Context) {
 
 	response.Success(ctx, domainUserToResponse(user))
 }
+
+// ListIdentities godoc
+// @Summary      获取已绑定的登录方式
+// @Description  获取当前用户已绑定的第三方登录身份列表
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=[]handler.IdentityResponse} "请求成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/identities [get]
+func (h *HttpHandler) ListIdentities(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	identities, err := h.userService.ListIdentities(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainIdentitiesToResponse(identities))
+}
+
+// LinkGithub godoc
+// @Summary      绑定 GitHub
+// @Description  为当前用户绑定 GitHub 登录身份
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.LinkGithubRequest true "GitHub 授权码"
+// @Success      200 {object} response.successResponse{data=handler.IdentityResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      409 {object} response.errorResponse "该身份已被绑定"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/identities/github [post]
+func (h *HttpHandler) LinkGithub(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(LinkGithubRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	userInfo, err := h.getGithubUserInfo(req.Code)
+	if err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	identity, err := h.userService.LinkOAuthIdentity(userID, domain.OAuthProviderGithub, userInfo)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainIdentityToResponse(identity))
+}
+
+// UnlinkIdentity godoc
+// @Summary      解绑登录方式
+// @Description  解绑当前用户的第三方登录身份,至少需保留一种登录方式
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider path string true "第三方提供方" Enums(github)
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      404 {object} response.errorResponse "未绑定该登录方式"
+// @Failure      409 {object} response.errorResponse "不能解绑最后一种登录方式"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/identities/{provider} [delete]
+func (h *HttpHandler) UnlinkIdentity(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(UnlinkIdentityRequest)
+	if err := ctx.ShouldBindUri(req); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	if err := h.userService.UnlinkOAuthIdentity(userID, domain.OAuthProvider(req.Provider)); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListPATs godoc
+// @Summary      获取个人访问令牌列表
+// @Description  获取当前用户的个人访问令牌 不包含明文令牌
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} response.successResponse{data=[]handler.PATResponse} "请求成功"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持访问令牌调用"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/tokens [get]
+func (h *HttpHandler) ListPATs(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	tokens, err := h.patService.List(userID)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainPATsToResponse(tokens))
+}
+
+// CreatePAT godoc
+// @Summary      创建个人访问令牌
+// @Description  创建用于 CLI/CI 的个人访问令牌 明文令牌仅在此接口返回一次 使用方式: Authorization: Bearer saas_pat_xxx
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body handler.CreatePATRequest true "令牌信息"
+// @Success      200 {object} response.successResponse{data=handler.CreatePATResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持访问令牌调用"
+// @Failure      409 {object} response.errorResponse "令牌数量已达上限"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/tokens [post]
+func (h *HttpHandler) CreatePAT(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(CreatePATRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresInDays > 0 {
+		expiresAt = time.Now().AddDate(0, 0, req.ExpiresInDays)
+	}
+
+	created, err := h.patService.Create(userID, req.Name, createPATRequestToScopes(req), expiresAt)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainCreatedPATToResponse(created))
+}
+
+// RevokePAT godoc
+// @Summary      吊销个人访问令牌
+// @Description  吊销后该令牌立即失效
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "令牌ID"
+// @Success      200 {object} response.successResponse "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      403 {object} response.errorResponse "不支持访问令牌调用"
+// @Failure      404 {object} response.errorResponse "令牌不存在"
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/tokens/{id} [delete]
+func (h *HttpHandler) RevokePAT(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(RevokePATRequest)
+	if err := ctx.ShouldBindUri(req); err != nil {
+		response.InvalidParams(ctx, err)
+		return
+	}
+
+	if err := h.patService.Revoke(userID, req.ID); err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx)
+}
+
+// ListLoginEvents godoc
+// @Summary      获取登录记录
+// @Description  分页获取当前用户的登录记录 包含时间、方式、IP、设备及是否成功
+// @Tags         user
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request query handler.ListLoginEventsRequest false "请求参数"
+// @Success      200 {object} response.successResponse{data=handler.ListLoginEventsResponse} "请求成功"
+// @Failure      400 {object} response.invalidParamsResponse "参数错误"
+// @Failure      401 {object} response.errorResponse
+// @Failure      500 {object} response.errorResponse "服务器错误"
+// @Router       /v1/user/login_events [get]
+func (h *HttpHandler) ListLoginEvents(ctx *gin.Context) {
+	userID, err := server.GetUserID(ctx)
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	req := new(ListLoginEventsRequest)
+	if err := bind.BindingRegularAndResponse(ctx, req); err != nil {
+		return
+	}
+
+	data, err := h.userService.ListLoginEvents(&domain.LoginEventKeysetQuery{
+		UserID:     userID,
+		PageSize:   req.PageSize,
+		PrevCursor: req.PrevCursor,
+		NextCursor: req.NextCursor,
+	})
+	if err != nil {
+		response.Error(ctx, err)
+		return
+	}
+
+	response.Success(ctx, domainLoginEventKeysetToResponse(data))
+}
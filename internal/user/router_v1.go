@@ -20,8 +20,25 @@ func RegisterV1(r *gin.RouterGroup, handler *handler.HttpHandler) func() {
 		protected := userGroup.Group("")
 		protected.Use(auth.JWTValidate())
 		{
-			protected.POST("/auth", handler.ValidateAuth)
-			protected.GET("/profile", handler.GetProfile)
+			// 仅允许登录会话访问 访问令牌不能读取或管理账号信息
+			session := protected.Group("", auth.SessionOnly())
+			{
+				session.POST("/auth", handler.ValidateAuth)
+				session.GET("/profile", handler.GetProfile)
+
+				// 登录方式绑定
+				session.GET("/identities", handler.ListIdentities)
+				session.POST("/identities/github", handler.LinkGithub)
+				session.DELETE("/identities/:provider", handler.UnlinkIdentity)
+
+				// 个人访问令牌
+				session.GET("/tokens", handler.ListPATs)
+				session.POST("/tokens", handler.CreatePAT)
+				session.DELETE("/tokens/:id", handler.RevokePAT)
+
+				// 登录记录
+				session.GET("/login_events", handler.ListLoginEvents)
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"saas/internal/user/domain"
+	"saas/internal/user/templates"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const newDeviceLoginSubject = "新设备登录提醒"
+
+func (s *userService) ListLoginEvents(query *domain.LoginEventKeysetQuery) (*domain.LoginEventKeysetResult, error) {
+	return s.loginEventRepo.ListByKeyset(query)
+}
+
+// recordLogin 记录登录事件 登录记录失败不应阻断登录流程
+func (s *userService) recordLogin(user *domain.User, method string, meta *domain.LoginMeta, success bool, reason string, checkNewDevice bool) {
+	if meta == nil {
+		meta = &domain.LoginMeta{}
+	}
+
+	event := &domain.LoginEvent{
+		UserID:        user.ID,
+		Method:        method,
+		IP:            meta.IP,
+		IPRange:       meta.IPRange(),
+		UserAgent:     truncate(meta.UserAgent, 255),
+		DeviceHash:    meta.DeviceHash(),
+		Success:       success,
+		FailureReason: truncate(reason, 100),
+	}
+
+	// 需在写入本次记录前判断是否为新设备
+	alert := false
+	if success && checkNewDevice {
+		var err error
+		alert, err = s.isNewDevice(user.ID, event)
+		if err != nil {
+			zap.L().Error("检测新设备登录失败", zap.String("user_id", user.ID), zap.Error(err))
+		}
+	}
+
+	if err := s.loginEventRepo.Create(event); err != nil {
+		zap.L().Error("记录登录事件失败", zap.String("user_id", user.ID), zap.Error(err))
+		return
+	}
+
+	if alert {
+		go func() {
+			if err := s.sendNewDeviceEmail(user, event); err != nil {
+				zap.L().Error("发送新设备登录提醒失败", zap.String("user_id", user.ID), zap.Error(err))
+			}
+		}()
+	}
+}
+
+// isNewDevice 存在历史成功登录 且设备或 IP 网段未出现过
+func (s *userService) isNewDevice(userID string, event *domain.LoginEvent) (bool, error) {
+	hasHistory, err := s.loginEventRepo.HasSuccessfulLogin(userID, "", "")
+	if err != nil || !hasHistory {
+		return false, err
+	}
+
+	knownDevice, err := s.loginEventRepo.HasSuccessfulLogin(userID, event.DeviceHash, "")
+	if err != nil {
+		return false, err
+	}
+
+	knownRange, err := s.loginEventRepo.HasSuccessfulLogin(userID, "", event.IPRange)
+	if err != nil {
+		return false, err
+	}
+
+	return !knownDevice || !knownRange, nil
+}
+
+func (s *userService) sendNewDeviceEmail(user *domain.User, event *domain.LoginEvent) error {
+	data := struct {
+		Nickname  string
+		LoginTime string
+		Method    string
+		IP        string
+		UserAgent string
+	}{
+		Nickname:  user.Nickname,
+		LoginTime: time.Now().Format(time.DateTime),
+		Method:    event.Method,
+		IP:        event.IP,
+		UserAgent: event.UserAgent,
+	}
+
+	return s.mailer.SendWithTemplate(
+		user.Email,
+		newDeviceLoginSubject,
+		templates.TemplateNewDeviceLogin,
+		data,
+	)
+}
+
+func truncate(str string, max int) string {
+	runes := []rune(str)
+	if len(runes) <= max {
+		return str
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"saas/internal/common/reskit/codes"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"saas/internal/user/domain"
+)
+
+const (
+	// 每个用户可持有的令牌上限
+	maxPATPerUser = 20
+	// 令牌随机部分的字节数
+	patRandomBytes = 32
+	// 列表中展示的令牌前缀长度
+	patDisplayLen = len(domain.PATPrefix) + 8
+	// 最后使用时间的刷新间隔 避免每次请求都写库
+	patTouchInterval = time.Minute
+)
+
+type patService struct {
+	patRepo domain.PATRepository
+}
+
+func NewPATService(patRepo domain.PATRepository) domain.PATService {
+	return &patService{
+		patRepo: patRepo,
+	}
+}
+
+func (s *patService) Create(userID, name string, scopes []domain.TokenScope, expiresAt time.Time) (*domain.CreatedPAT, error) {
+	// 1. 校验参数
+	if len(scopes) == 0 {
+		return nil, codes.ErrPATInvalidScope
+	}
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, codes.ErrPATInvalidScope.WithDetail(map[string]any{
+				"scope": scope,
+			})
+		}
+	}
+
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return nil, codes.ErrPATExpiresAtInvalid
+	}
+
+	count, err := s.patRepo.CountByUser(userID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if count >= maxPATPerUser {
+		return nil, codes.ErrPATLimitExceeded
+	}
+
+	// 2. 生成明文令牌 仅存储哈希
+	plainText, err := genPATPlainText()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	token, err := s.patRepo.Create(&domain.PersonalAccessToken{
+		UserID:      userID,
+		Name:        name,
+		TokenPrefix: plainText[:patDisplayLen],
+		TokenHash:   hashPAT(plainText),
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.CreatedPAT{
+		Token:     token,
+		PlainText: plainText,
+	}, nil
+}
+
+func (s *patService) List(userID string) ([]*domain.PersonalAccessToken, error) {
+	return s.patRepo.ListByUser(userID)
+}
+
+func (s *patService) Revoke(userID, id string) error {
+	return s.patRepo.Delete(userID, id)
+}
+
+func (s *patService) Validate(plainText string) (*domain.PersonalAccessToken, error) {
+	if !strings.HasPrefix(plainText, domain.PATPrefix) {
+		return nil, codes.ErrPATInvalid
+	}
+
+	token, err := s.patRepo.FindByHash(hashPAT(plainText))
+	if err != nil {
+		if errors.Is(err, codes.ErrPATNotFound) {
+			return nil, codes.ErrPATInvalid
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	if token.IsExpired() {
+		return nil, codes.ErrPATExpired
+	}
+
+	now := time.Now()
+	if now.Sub(token.LastUsedAt) > patTouchInterval {
+		if err := s.patRepo.UpdateLastUsed(token.ID, now); err != nil {
+			zap.L().Error("更新访问令牌使用时间失败", zap.String("token_id", token.ID), zap.Error(err))
+		}
+	}
+
+	return token, nil
+}
+
+func genPATPlainText() (string, error) {
+	bytes := make([]byte, patRandomBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return domain.PATPrefix + hex.EncodeToString(bytes), nil
+}
+
+// 令牌本身为高熵随机串 使用 sha256 即可安全存储
+func hashPAT(plainText string) string {
+	sum := sha256.Sum256([]byte(plainText))
+	return hex.EncodeToString(sum[:])
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"saas/internal/common/email"
 	"saas/internal/common/reskit/codes"
 	"saas/internal/common/utils"
 
@@ -12,8 +13,10 @@ import (
 )
 
 type userService struct {
-	userRepo     domain.UserRepository
-	tokenService domain.TokenService
+	userRepo       domain.UserRepository
+	loginEventRepo domain.LoginEventRepository
+	tokenService   domain.TokenService
+	mailer         email.Mailer
 }
 
 var (
@@ -21,22 +24,33 @@ var (
 	githubClientSecret string
 )
 
-func NewUserService(userRepo domain.UserRepository, tokenService domain.TokenService) domain.UserService {
+func NewUserService(
+	userRepo domain.UserRepository,
+	loginEventRepo domain.LoginEventRepository,
+	tokenService domain.TokenService,
+	mailer email.Mailer,
+) domain.UserService {
 	githubClientID = utils.GetEnv("GITHUB_CLIENT_ID")
 	githubClientSecret = utils.GetEnv("GITHUB_CLIENT_SECRET")
 
 	return &userService{
-		userRepo:     userRepo,
-		tokenService: tokenService,
+		userRepo:       userRepo,
+		loginEventRepo: loginEventRepo,
+		tokenService:   tokenService,
+		mailer:         mailer,
 	}
 }
 
-func (s *userService) AuthenticateWithOAuth(provider domain.OAuthProvider, userInfo *domain.OAuthUserInfo) (
+func (s *userService) AuthenticateWithOAuth(provider domain.OAuthProvider, userInfo *domain.OAuthUserInfo, meta *domain.LoginMeta) (
 	*domain.User2Token, error,
 ) {
 	// 1. 查找或创建用户
-	user, _, err := s.findOrCreateUserByOAuth(provider, userInfo)
+	user, isNew, err := s.findOrCreateUserByOAuth(provider, userInfo)
 	if err != nil {
+		// 已定位到用户时记录失败的登录
+		if user != nil {
+			s.recordLogin(user, provider.String(), meta, false, err.Error(), false)
+		}
 		return nil, err
 	}
 
@@ -61,6 +75,9 @@ func (s *userService) AuthenticateWithOAuth(provider domain.OAuthProvider, userI
 		return nil, errors.WithStack(err)
 	}
 
+	// 4. 记录登录 新用户首次登录无需提醒
+	s.recordLogin(user, provider.String(), meta, true, "", !isNew)
+
 	return &domain.User2Token{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -71,14 +88,20 @@ func (s *userService) AuthenticateWithOAuth(provider domain.OAuthProvider, userI
 func (s *userService) findOrCreateUserByOAuth(provider domain.OAuthProvider, userInfo *domain.OAuthUserInfo) (
 	user *domain.User, isNew bool, err error,
 ) {
-	// 1. 先通过 OAuth ID 查找
-	user, err = s.userRepo.FindByOAuthID(provider.String(), userInfo.ID)
+	// 1. 先通过已绑定的身份查找
+	identity, err := s.userRepo.FindIdentity(provider.String(), userInfo.ID)
 	if err == nil {
-		// 找到用户，更新信息
+		if err := s.userRepo.TouchIdentity(identity.ID); err != nil {
+			zap.L().Error("更新第三方身份使用时间失败", zap.String("identity_id", identity.ID), zap.Error(err))
+		}
+		user, err = s.userRepo.FindByID(identity.UserID)
+		if err != nil {
+			return nil, false, errors.WithStack(err)
+		}
 		return user, false, nil
 	}
 
-	if !errors.Is(err, codes.ErrUserNotFound) {
+	if !errors.Is(err, codes.ErrOAuthIdentityNotFound) {
 		return nil, false, errors.WithStack(err)
 	}
 
@@ -86,9 +109,14 @@ func (s *userService) findOrCreateUserByOAuth(provider domain.OAuthProvider, use
 	if userInfo.Email != "" {
 		user, err = s.userRepo.FindByEmail(userInfo.Email)
 		if err == nil {
-			// 绑定 OAuth 到现有用户
-			user, err = s.bindOAuthToUser(user, provider, userInfo)
-			return user, false, err
+			// 仅在第三方确认邮箱已验证时自动绑定,防止通过伪造邮箱接管账号
+			if !userInfo.EmailVerified {
+				return user, false, codes.ErrOAuthEmailNotVerified
+			}
+			if _, err = s.userRepo.CreateIdentity(newIdentity(user.ID, provider, userInfo)); err != nil {
+				return nil, false, errors.WithStack(err)
+			}
+			return user, false, nil
 		}
 
 		if !errors.Is(err, codes.ErrUserNotFound) {
@@ -107,25 +135,55 @@ func (s *userService) createUserFromOAuth(provider domain.OAuthProvider, userInf
 		Nickname: userInfo.Nickname,
 	}
 
-	// 设置 OAuth ID
-	switch provider {
-	case "github":
-		user.GithubID = userInfo.ID
+	return s.userRepo.CreateWithIdentity(user, newIdentity("", provider, userInfo))
+}
+
+func newIdentity(userID string, provider domain.OAuthProvider, userInfo *domain.OAuthUserInfo) *domain.UserIdentity {
+	return &domain.UserIdentity{
+		UserID:         userID,
+		Provider:       provider,
+		ProviderUserID: userInfo.ID,
+		Email:          userInfo.Email,
+		EmailVerified:  userInfo.EmailVerified,
 	}
+}
 
-	return s.userRepo.Create(user)
+func (s *userService) ListIdentities(userID string) ([]*domain.UserIdentity, error) {
+	return s.userRepo.ListIdentities(userID)
 }
 
-func (s *userService) bindOAuthToUser(user *domain.User, provider domain.OAuthProvider, userInfo *domain.OAuthUserInfo) (
-	*domain.User, error,
+func (s *userService) LinkOAuthIdentity(userID string, provider domain.OAuthProvider, userInfo *domain.OAuthUserInfo) (
+	*domain.UserIdentity, error,
 ) {
-	// 设置 OAuth ID
-	switch provider {
-	case domain.OAuthProviderGithub:
-		user.GithubID = userInfo.ID
+	// 1. 该第三方账号是否已被绑定
+	identity, err := s.userRepo.FindIdentity(provider.String(), userInfo.ID)
+	if err == nil {
+		if identity.UserID == userID {
+			return identity, nil
+		}
+		return nil, codes.ErrOAuthIdentityAlreadyLinked
 	}
+	if !errors.Is(err, codes.ErrOAuthIdentityNotFound) {
+		return nil, errors.WithStack(err)
+	}
+
+	// 2. 当前用户是否已绑定同一提供方的其他账号
+	identities, err := s.userRepo.ListIdentities(userID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, item := range identities {
+		if item.Provider == provider {
+			return nil, codes.ErrOAuthProviderAlreadyLinked
+		}
+	}
+
+	return s.userRepo.CreateIdentity(newIdentity(userID, provider, userInfo))
+}
 
-	return s.userRepo.Update(user)
+// UnlinkOAuthIdentity 解绑后至少保留一种登录方式 检查与删除在同一事务中完成
+func (s *userService) UnlinkOAuthIdentity(userID string, provider domain.OAuthProvider) error {
+	return s.userRepo.DeleteIdentity(userID, provider)
 }
 
 func (s *userService) RefreshUserToken(refreshToken string) (*domain.User2Token, error) {
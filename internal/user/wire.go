@@ -7,6 +7,8 @@ import (
 	"saas/internal/user/adapters"
 	"saas/internal/user/handler"
 	"saas/internal/user/service"
+	"saas/internal/user/templates"
+	"saas/internal/common/email"
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 )
@@ -17,8 +19,13 @@ func InitV1(r *gin.RouterGroup) func() {
 		handler.NewHttpHandler,
 		service.NewTokenService,
 		service.NewUserService,
+		service.NewPATService,
 		adapters.NewUserPSQLRepository,
 		adapters.NewTokenRedisCache,
+		adapters.NewPATPSQLRepository,
+		adapters.NewLoginEventPSQLRepository,
+		email.NewMailer,
+		templates.LoadUserTemplates,
 	)
 	return nil
 }
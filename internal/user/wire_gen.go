@@ -8,19 +8,26 @@ package user
 
 import (
 	"github.com/gin-gonic/gin"
+	"saas/internal/common/email"
 	"saas/internal/user/adapters"
 	"saas/internal/user/handler"
 	"saas/internal/user/service"
+	"saas/internal/user/templates"
 )
 
 // Injectors from wire.go:
 
 func InitV1(r *gin.RouterGroup) func() {
 	userRepository := adapters.NewUserPSQLRepository()
+	loginEventRepository := adapters.NewLoginEventPSQLRepository()
 	tokenCache := adapters.NewTokenRedisCache()
 	tokenService := service.NewTokenService(tokenCache, userRepository)
-	userService := service.NewUserService(userRepository, tokenService)
-	httpHandler := handler.NewHttpHandler(userService)
-	v := RegisterV1(r, httpHandler)
-	return v
+	v := templates.LoadUserTemplates()
+	mailer := email.NewMailer(v)
+	userService := service.NewUserService(userRepository, loginEventRepository, tokenService, mailer)
+	patRepository := adapters.NewPATPSQLRepository()
+	patService := service.NewPATService(patRepository)
+	httpHandler := handler.NewHttpHandler(userService, patService)
+	v2 := RegisterV1(r, httpHandler)
+	return v2
 }
@@ -6,10 +6,12 @@ import (
 	"log"
 	"os"
 	_ "saas/api/openapi"
+	"saas/internal/admin"
 	"saas/internal/comment"
 	"saas/internal/common/logger"
 	"saas/internal/common/metrics"
 	"saas/internal/common/middleware/auth"
+	"saas/internal/common/secrets"
 	"saas/internal/common/server"
 	"saas/internal/common/uid"
 	"saas/internal/common/utils"
@@ -112,6 +114,8 @@ func main() {
 
 	uid.Init()
 
+	secrets.Init()
+
 	setGDB()
 
 	auth.Init()
@@ -129,6 +133,9 @@ func main() {
 		panic(err)
 	}
 
+	// 定期将旧密钥加密的密文改用活跃密钥加密
+	secrets.StartReencryptJob()
+
 	// 启动 HTTP 服务器
 	server.RunHttpServer(utils.GetEnv("SERVER_PORT"), metricsClient, func(r *gin.RouterGroup) {
 		r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler,
@@ -139,6 +146,8 @@ func main() {
 
 		tenant.InitV1(r)
 		comment.InitV1(r)
+
+		admin.InitV1(r)
 	},
 		clear,
 	)